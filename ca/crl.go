@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"strings"
 	"time"
 
@@ -124,6 +125,7 @@ func (ci *crlImpl) GenerateCRL(stream grpc.BidiStreamingServer[capb.GenerateCRLR
 		"number":     req.Number.String(),
 		"shard":      req.Shard,
 		"thisUpdate": req.ThisUpdate.Format(time.RFC3339),
+		"deltaBase":  req.DeltaBase.String(),
 		"numEntries": len(rcs),
 	})
 
@@ -185,10 +187,22 @@ func (ci *crlImpl) metadataToRequest(meta *capb.CRLMetadata) (*issuance.CRLReque
 	thisUpdate := meta.ThisUpdate.AsTime()
 	number := bcrl.Number(thisUpdate)
 
+	var deltaBase *big.Int
+	if meta.DeltaBase != nil {
+		deltaBase = bcrl.Number(meta.DeltaBase.AsTime())
+	}
+	var nextUpdate time.Time
+	if meta.NextUpdate != nil {
+		nextUpdate = meta.NextUpdate.AsTime()
+	}
+
 	return &issuance.CRLRequest{
-		Number:     number,
-		Shard:      meta.ShardIdx,
-		ThisUpdate: thisUpdate,
+		Number:      number,
+		Shard:       meta.ShardIdx,
+		ThisUpdate:  thisUpdate,
+		DeltaBase:   deltaBase,
+		FreshestCRL: meta.FreshestCRL,
+		NextUpdate:  nextUpdate,
 	}, nil
 }
 
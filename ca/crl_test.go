@@ -13,6 +13,8 @@ import (
 	capb "github.com/letsencrypt/boulder/ca/proto"
 	"github.com/letsencrypt/boulder/config"
 	corepb "github.com/letsencrypt/boulder/core/proto"
+	bcrl "github.com/letsencrypt/boulder/crl"
+	"github.com/letsencrypt/boulder/crl/delta"
 	"github.com/letsencrypt/boulder/issuance"
 	"github.com/letsencrypt/boulder/test"
 )
@@ -282,3 +284,94 @@ func TestGenerateCRL(t *testing.T) {
 	err = crl.CheckSignatureFrom(cargs.issuers[0].Cert.Certificate)
 	test.AssertNotError(t, err, "CRL signature should validate")
 }
+
+func TestGenerateDeltaCRL(t *testing.T) {
+	t.Parallel()
+	cargs := newCAArgs(t)
+	crli, err := NewCRLImpl(
+		cargs.issuers,
+		issuance.CRLProfileConfig{
+			ValidityInterval: config.Duration{Duration: 216 * time.Hour},
+			MaxBackdate:      config.Duration{Duration: time.Hour},
+			DeltaCRLs:        true,
+		},
+		100,
+		cargs.logger,
+		cargs.metrics,
+	)
+	test.AssertNotError(t, err, "Failed to create crl impl")
+
+	generate := func(meta *capb.CRLMetadata) ([]byte, error) {
+		ins := make(chan *capb.GenerateCRLRequest, 2)
+		outs := make(chan *capb.GenerateCRLResponse)
+		errs := make(chan error, 1)
+		go func() {
+			errs <- crli.GenerateCRL(mockGenerateCRLBidiStream{input: ins, output: outs})
+			close(outs)
+		}()
+		ins <- &capb.GenerateCRLRequest{
+			Payload: &capb.GenerateCRLRequest_Metadata{Metadata: meta},
+		}
+		ins <- &capb.GenerateCRLRequest{
+			Payload: &capb.GenerateCRLRequest_Entry{
+				Entry: &corepb.CRLEntry{
+					Serial:    "111111111111111111111111111111111111",
+					Reason:    1, // keyCompromise
+					RevokedAt: timestamppb.New(cargs.clk.Now()),
+				},
+			},
+		}
+		close(ins)
+		var crlBytes []byte
+		for resp := range outs {
+			crlBytes = append(crlBytes, resp.Chunk...)
+		}
+		return crlBytes, <-errs
+	}
+
+	now := cargs.clk.Now()
+	base := now.Add(-10 * time.Minute)
+
+	// A delta CRL carries its base's CRL Number in a Delta CRL Indicator.
+	crlBytes, err := generate(&capb.CRLMetadata{
+		IssuerNameID: int64(cargs.issuers[0].NameID()),
+		ThisUpdate:   timestamppb.New(now),
+		ShardIdx:     1,
+		DeltaBase:    timestamppb.New(base),
+		NextUpdate:   timestamppb.New(now.Add(time.Hour)),
+	})
+	test.AssertNotError(t, err, "generating delta CRL should work")
+	crl, err := x509.ParseRevocationList(crlBytes)
+	test.AssertNotError(t, err, "should be able to parse delta CRL")
+	test.AssertEquals(t, len(crl.RevokedCertificateEntries), 1)
+	test.AssertEquals(t, crl.NextUpdate, now.Add(time.Hour))
+	baseNumber, err := delta.GetBaseCRLNumber(crl.Extensions)
+	test.AssertNotError(t, err, "should be able to get base CRL Number")
+	test.AssertEquals(t, baseNumber.Cmp(bcrl.Number(base)), 0)
+
+	// A full CRL can point to its shard's delta CRLs.
+	crlBytes, err = generate(&capb.CRLMetadata{
+		IssuerNameID: int64(cargs.issuers[0].NameID()),
+		ThisUpdate:   timestamppb.New(now),
+		ShardIdx:     1,
+		FreshestCRL:  true,
+	})
+	test.AssertNotError(t, err, "generating full CRL with Freshest CRL should work")
+	crl, err = x509.ParseRevocationList(crlBytes)
+	test.AssertNotError(t, err, "should be able to parse full CRL")
+	uris, err := delta.GetFreshestCRLURIs(crl.Extensions)
+	test.AssertNotError(t, err, "should be able to get Freshest CRL URIs")
+	test.AssertEquals(t, len(uris), 1)
+	test.AssertContains(t, uris[0], "1-delta.crl")
+
+	// A delta CRL's base must precede it.
+	_, err = generate(&capb.CRLMetadata{
+		IssuerNameID: int64(cargs.issuers[0].NameID()),
+		ThisUpdate:   timestamppb.New(now),
+		ShardIdx:     1,
+		DeltaBase:    timestamppb.New(now),
+		NextUpdate:   timestamppb.New(now.Add(time.Hour)),
+	})
+	test.AssertError(t, err, "generating delta CRL with current base should fail")
+	test.AssertContains(t, err.Error(), "must precede it")
+}
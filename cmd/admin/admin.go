@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jmhodges/clock"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/letsencrypt/boulder/cmd"
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	blog "github.com/letsencrypt/boulder/log"
@@ -24,6 +26,15 @@ type admin struct {
 	sac   adminSAClient
 	saroc sapb.StorageAuthorityReadOnlyClient
 	saac  saAdminClient
+	cuc   adminCRLUpdaterClient
+
+	// dryRun is true if the clients above are dry-run wrappers, so that
+	// subcommands can avoid other side effects, like writing progress files.
+	dryRun bool
+
+	// maxCertValidity is the longest validity period of any configured
+	// certificate profile, or zero if none are configured.
+	maxCertValidity time.Duration
 
 	clk clock.Clock
 	log blog.Logger
@@ -52,6 +63,12 @@ type saAdminClient interface {
 	UpdateIncident(context.Context, *sapb.UpdateIncidentRequest, ...grpc.CallOption) (*sapb.Incident, error)
 }
 
+// adminCRLUpdaterClient defines the subset of crl-updater methods that the
+// admin tool relies on.
+type adminCRLUpdaterClient interface {
+	RegenerateShards(context.Context, *cupb.RegenerateShardsRequest, ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
 // newAdmin constructs a new admin object on the heap and returns a pointer to
 // it.
 func newAdmin(configFile string, dryRun bool) (*admin, error) {
@@ -99,13 +116,34 @@ func newAdmin(configFile string, dryRun bool) (*admin, error) {
 		saAdmin = sapb.NewStorageAuthorityAdminClient(saConn)
 	}
 
+	// The crl-updater connection is optional, as only the regenerate-crls
+	// subcommand needs it.
+	var cuc adminCRLUpdaterClient
+	if dryRun {
+		cuc = dryRunCUC{log: logger}
+	} else if c.Admin.CRLUpdaterService != nil {
+		cuConn, err := bgrpc.ClientSetup(c.Admin.CRLUpdaterService, tlsConfig, scope, clk)
+		if err != nil {
+			return nil, fmt.Errorf("creating crl-updater gRPC client: %w", err)
+		}
+		cuc = cupb.NewCRLUpdaterClient(cuConn)
+	}
+
+	var maxCertValidity time.Duration
+	for _, profile := range c.Admin.CertProfiles {
+		maxCertValidity = max(maxCertValidity, profile.MaxValidityPeriod.Duration)
+	}
+
 	return &admin{
-		rac:   rac,
-		sac:   sac,
-		saac:  saAdmin,
-		saroc: saroc,
-		clk:   clk,
-		log:   logger,
+		rac:             rac,
+		sac:             sac,
+		saac:            saAdmin,
+		saroc:           saroc,
+		cuc:             cuc,
+		dryRun:          dryRun,
+		maxCertValidity: maxCertValidity,
+		clk:             clk,
+		log:             logger,
 	}, nil
 }
 
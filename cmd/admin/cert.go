@@ -14,6 +14,8 @@ import (
 	"sync/atomic"
 	"unicode"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	core "github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
@@ -32,17 +34,19 @@ import (
 // for shorter database and gRPC query times, so that we don't need massive
 // timeouts when collecting serials to revoke.
 type subcommandRevokeCert struct {
-	parallelism   uint
-	reasonStr     string
-	skipBlock     bool
-	malformed     bool
-	serial        string
-	incidentTable string
-	serialsFile   string
-	privKey       string
-	regID         int64
-	certFile      string
-	crlShard      int64
+	parallelism       uint
+	reasonStr         string
+	skipBlock         bool
+	malformed         bool
+	serial            string
+	incidentTable     string
+	serialsFile       string
+	privKey           string
+	regID             int64
+	certFile          string
+	domain            string
+	includeSubdomains bool
+	crlShard          int64
 }
 
 var _ subcommand = (*subcommandRevokeCert)(nil)
@@ -58,6 +62,7 @@ func (s *subcommandRevokeCert) Flags(flag *flag.FlagSet) {
 	flag.BoolVar(&s.skipBlock, "skip-block-key", false, "Skip blocking the key, if revoked for keyCompromise - use with extreme caution")
 	flag.BoolVar(&s.malformed, "malformed", false, "Indicates that the cert cannot be parsed - use with caution")
 	flag.Int64Var(&s.crlShard, "crl-shard", 0, "For malformed certs, the CRL shard the certificate belongs to")
+	flag.BoolVar(&s.includeSubdomains, "include-subdomains", false, "With -domain, also revoke certificates containing any subdomain of the given name")
 
 	// Flags specifying the input method for the certificates to be revoked.
 	flag.StringVar(&s.serial, "serial", "", "Revoke the certificate with this hex serial")
@@ -66,6 +71,7 @@ func (s *subcommandRevokeCert) Flags(flag *flag.FlagSet) {
 	flag.StringVar(&s.privKey, "private-key", "", "Revoke all certificates whose pubkey matches this private key")
 	flag.Int64Var(&s.regID, "reg-id", 0, "Revoke all certificates issued to this account")
 	flag.StringVar(&s.certFile, "cert-file", "", "Revoke the single PEM-formatted certificate in this file")
+	flag.StringVar(&s.domain, "domain", "", "Revoke all unexpired, unrevoked certificates containing this DNS name")
 }
 
 func (s *subcommandRevokeCert) Run(ctx context.Context, a *admin) error {
@@ -91,6 +97,10 @@ func (s *subcommandRevokeCert) Run(ctx context.Context, a *admin) error {
 		return errors.New("cannot revoke malformed certs for reason keyCompromise")
 	}
 
+	if s.includeSubdomains && s.domain == "" {
+		return errors.New("-include-subdomains only makes sense with -domain")
+	}
+
 	// This is a map of all input-selection flags to whether or not they were set
 	// to a non-default value. We use this to ensure that exactly one input
 	// selection flag was given on the command line.
@@ -101,6 +111,7 @@ func (s *subcommandRevokeCert) Run(ctx context.Context, a *admin) error {
 		"-private-key":    s.privKey != "",
 		"-reg-id":         s.regID != 0,
 		"-cert-file":      s.certFile != "",
+		"-domain":         s.domain != "",
 	}
 	activeFlag, err := findActiveInputMethodFlag(setInputs)
 	if err != nil {
@@ -121,6 +132,8 @@ func (s *subcommandRevokeCert) Run(ctx context.Context, a *admin) error {
 		serials, err = a.serialsFromRegID(ctx, s.regID)
 	case "-cert-file":
 		serials, err = a.serialsFromCertPEM(ctx, s.certFile)
+	case "-domain":
+		serials, err = a.serialsFromDomain(ctx, s.domain, s.includeSubdomains)
 	default:
 		return errors.New("no recognized input method flag set (this shouldn't happen)")
 	}
@@ -276,6 +289,51 @@ func (a *admin) serialsFromRegID(ctx context.Context, regID int64) ([]string, er
 	return serials, nil
 }
 
+// serialsFromDomain returns the serials of all unexpired, unrevoked
+// certificates which contain the given DNS name, and optionally any of its
+// subdomains. Only certificates issued within the longest validity period of
+// the configured certificate profiles are considered. In dry-run mode, each
+// serial found is logged.
+func (a *admin) serialsFromDomain(ctx context.Context, domain string, includeSubdomains bool) ([]string, error) {
+	if a.maxCertValidity == 0 {
+		return nil, errors.New("certProfiles must be configured to revoke certificates by domain")
+	}
+
+	stream, err := a.saroc.GetSerialsByName(ctx, &sapb.GetSerialsByNameRequest{
+		DnsName:           domain,
+		IncludeSubdomains: includeSubdomains,
+		NotBeforeAfter:    timestamppb.New(a.clk.Now().Add(-a.maxCertValidity)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("setting up stream of serials from SA: %s", err)
+	}
+
+	var serials []string
+	for {
+		serial, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("streaming serials from SA: %s", err)
+		}
+		serials = append(serials, serial.Serial)
+	}
+
+	if a.dryRun {
+		for _, serial := range serials {
+			a.log.Infof("dry-run: Found certificate %s for %q", serial, domain)
+		}
+	}
+
+	if includeSubdomains {
+		a.log.Infof("Found %d unexpired, unrevoked certificates for %q and its subdomains", len(serials), domain)
+	} else {
+		a.log.Infof("Found %d unexpired, unrevoked certificates for %q", len(serials), domain)
+	}
+	return serials, nil
+}
+
 func (a *admin) serialsFromCertPEM(_ context.Context, filename string) ([]string, error) {
 	cert, err := core.LoadCert(filename)
 	if err != nil {
@@ -163,6 +163,59 @@ func TestSerialsFromRegID(t *testing.T) {
 	test.AssertDeepEquals(t, res, serials)
 }
 
+// mockSAWithName is a mock which only implements the GetSerialsByName gRPC
+// method. It returns its serials only for requests matching its name and
+// includeSubdomains settings.
+type mockSAWithName struct {
+	sapb.StorageAuthorityReadOnlyClient
+	name              string
+	includeSubdomains bool
+	notBeforeAfter    time.Time
+	serials           []string
+}
+
+func (msa *mockSAWithName) GetSerialsByName(_ context.Context, req *sapb.GetSerialsByNameRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[sapb.Serial], error) {
+	if req.DnsName != msa.name || req.IncludeSubdomains != msa.includeSubdomains || !req.NotBeforeAfter.AsTime().Equal(msa.notBeforeAfter) {
+		return &mocks.ServerStreamClient[sapb.Serial]{}, nil
+	}
+	fakeResults := make([]*sapb.Serial, len(msa.serials))
+	for i, serial := range msa.serials {
+		fakeResults[i] = &sapb.Serial{Serial: serial}
+	}
+	return &mocks.ServerStreamClient[sapb.Serial]{Results: fakeResults}, nil
+}
+
+func TestSerialsFromDomain(t *testing.T) {
+	serials := []string{"foo", "bar", "baz"}
+	fc := clock.NewFake()
+	log := blog.NewMock()
+	a := admin{
+		saroc: &mockSAWithName{name: "example.com", includeSubdomains: true, notBeforeAfter: fc.Now().Add(-90 * 24 * time.Hour), serials: serials},
+		clk:   fc,
+		log:   log,
+	}
+
+	// Without any certificate profiles, there's no bound on the search.
+	_, err := a.serialsFromDomain(context.Background(), "example.com", true)
+	test.AssertError(t, err, "getting serials by name without certificate profiles")
+
+	a.maxCertValidity = 90 * 24 * time.Hour
+	res, err := a.serialsFromDomain(context.Background(), "example.com", true)
+	test.AssertNotError(t, err, "getting serials by name")
+	test.AssertDeepEquals(t, res, serials)
+	test.AssertEquals(t, len(log.GetAllMatching("dry-run")), 0)
+
+	res, err = a.serialsFromDomain(context.Background(), "example.com", false)
+	test.AssertNotError(t, err, "getting serials by name")
+	test.AssertEquals(t, len(res), 0)
+
+	// In dry-run mode, each serial found is logged.
+	a.dryRun = true
+	_, err = a.serialsFromDomain(context.Background(), "example.com", true)
+	test.AssertNotError(t, err, "getting serials by name")
+	test.AssertEquals(t, len(log.GetAllMatching("dry-run: Found certificate (foo|bar|baz)")), 3)
+}
+
 // mockRARecordingRevocations is a mock which only implements the
 // AdministrativelyRevokeCertificate gRPC method. It can be initialized with
 // serials to recognize as already revoked, or to fail.
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
 	blog "github.com/letsencrypt/boulder/log"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
@@ -27,6 +28,19 @@ func (d dryRunRAC) AdministrativelyRevokeCertificate(_ context.Context, req *rap
 	return &emptypb.Empty{}, nil
 }
 
+type dryRunCUC struct {
+	log blog.Logger
+}
+
+var _ adminCRLUpdaterClient = (*dryRunCUC)(nil)
+
+func (d dryRunCUC) RegenerateShards(_ context.Context, req *cupb.RegenerateShardsRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	for _, shard := range req.Shards {
+		d.log.Infof("dry-run: Regenerate CRL shard %d for issuer %d", shard.ShardIdx, shard.IssuerNameID)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 type dryRunSAC struct {
 	log blog.Logger
 }
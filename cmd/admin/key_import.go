@@ -0,0 +1,471 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+
+	"github.com/letsencrypt/boulder/core"
+	berrors "github.com/letsencrypt/boulder/errors"
+)
+
+// subcommandImportBlockedKeys encapsulates the "admin import-blocked-keys"
+// command. It streams a (potentially very large) list of compromised public
+// keys, and blocks each one which isn't already blocked. Certificates using
+// those keys are then revoked by bad-key-revoker.
+//
+// Keys are processed in batches. Once every key in a batch has been handled,
+// the number of list entries processed so far is written to the progress file,
+// if one was given, so that an interrupted import can be resumed from the last
+// completed batch by re-running the same command.
+type subcommandImportBlockedKeys struct {
+	file         string
+	format       string
+	rsaExponent  int
+	comment      string
+	parallelism  uint
+	batchSize    uint
+	rate         float64
+	progressFile string
+}
+
+var _ subcommand = (*subcommandImportBlockedKeys)(nil)
+
+func (s *subcommandImportBlockedKeys) Desc() string {
+	return "Block all keys in a large list of known-compromised public keys"
+}
+
+func (s *subcommandImportBlockedKeys) Flags(flag *flag.FlagSet) {
+	flag.StringVar(&s.file, "file", "", "Path to the list of keys to block (required)")
+	flag.StringVar(&s.format, "format", "", "Format of the list: pem (PEM-encoded public keys or certificates), rsa-modulus (hex RSA moduli, one per line), or spki-hash (hex SHA256 hashes of SPKI, one per line) (required)")
+	flag.IntVar(&s.rsaExponent, "rsa-exponent", 65537, "Public exponent to pair with each modulus, for -format=rsa-modulus")
+	flag.StringVar(&s.comment, "comment", "", "Additional context to add to database comment column")
+	flag.UintVar(&s.parallelism, "parallelism", 10, "Number of concurrent workers to use while blocking keys")
+	flag.UintVar(&s.batchSize, "batch-size", 1000, "Number of keys to process between progress checkpoints")
+	flag.Float64Var(&s.rate, "rate", 100, "Maximum number of keys to check and block per second (0 for unlimited)")
+	flag.StringVar(&s.progressFile, "progress-file", "", "File in which to record progress; if it already exists, the entries it records as processed are skipped")
+}
+
+func (s *subcommandImportBlockedKeys) Run(ctx context.Context, a *admin) error {
+	if s.file == "" || s.format == "" {
+		return errors.New("-file and -format are required")
+	}
+	if s.parallelism == 0 {
+		return errors.New("-parallelism must be > 0")
+	}
+	if s.batchSize == 0 {
+		return errors.New("-batch-size must be > 0")
+	}
+	if s.rate < 0 {
+		return errors.New("-rate must be >= 0")
+	}
+
+	u, err := user.Current()
+	if err != nil {
+		return fmt.Errorf("getting admin username: %w", err)
+	}
+
+	file, err := os.Open(s.file)
+	if err != nil {
+		return fmt.Errorf("opening key list: %w", err)
+	}
+	defer file.Close()
+
+	keys, err := newKeyListReader(file, s.format, s.rsaExponent)
+	if err != nil {
+		return err
+	}
+
+	var skip uint64
+	if s.progressFile != "" {
+		skip, err = readImportProgress(s.progressFile)
+		if err != nil {
+			return err
+		}
+		if skip > 0 {
+			a.log.Infof("Resuming import of %q after %d entries, as recorded in %q", s.file, skip, s.progressFile)
+		}
+	}
+
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	if s.rate > 0 {
+		limiter = rate.NewLimiter(rate.Limit(s.rate), 1)
+	}
+
+	imp := &keyImporter{
+		admin:       a,
+		keys:        keys,
+		limiter:     limiter,
+		user:        u,
+		comment:     s.comment,
+		parallelism: s.parallelism,
+		batchSize:   s.batchSize,
+		seen:        make(map[string]struct{}),
+	}
+	if s.progressFile != "" && !a.dryRun {
+		imp.checkpoint = func(processed uint64) error {
+			return writeImportProgress(s.progressFile, processed)
+		}
+	}
+
+	err = imp.run(ctx, skip)
+	a.log.Infof("Processed %d entries from %q: %d blocked, %d already blocked, %d duplicates",
+		imp.processed, s.file, imp.blocked.Load(), imp.alreadyBlocked.Load(), imp.duplicates)
+	if err != nil {
+		return fmt.Errorf("importing blocked keys: %w", err)
+	}
+	return nil
+}
+
+// keyListReader yields the SPKI hash of each successive key in a key list. It
+// returns io.EOF once the list is exhausted.
+type keyListReader interface {
+	Next() ([]byte, error)
+}
+
+// newKeyListReader returns a keyListReader for the given format.
+func newKeyListReader(r io.Reader, format string, rsaExponent int) (keyListReader, error) {
+	scanner := bufio.NewScanner(r)
+	// RSA moduli for large keys are longer than the default maximum line.
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	switch format {
+	case "pem":
+		return &pemKeyReader{scanner: scanner}, nil
+	case "rsa-modulus":
+		if rsaExponent < 3 || rsaExponent%2 == 0 {
+			return nil, fmt.Errorf("invalid RSA public exponent %d", rsaExponent)
+		}
+		return &rsaModulusReader{lineReader: lineReader{scanner: scanner}, exponent: rsaExponent}, nil
+	case "spki-hash":
+		return &spkiHashReader{lineReader: lineReader{scanner: scanner}}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized key list format %q (must be pem, rsa-modulus, or spki-hash)", format)
+	}
+}
+
+// lineReader returns successive non-empty lines, ignoring '#' comments.
+type lineReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (r *lineReader) nextLine() (string, error) {
+	for r.scanner.Scan() {
+		r.line++
+		text := strings.TrimSpace(r.scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		return text, nil
+	}
+	err := r.scanner.Err()
+	if err != nil {
+		return "", fmt.Errorf("reading line %d: %w", r.line+1, err)
+	}
+	return "", io.EOF
+}
+
+// spkiHashReader reads hex-encoded SHA256 hashes of SPKI, one per line.
+type spkiHashReader struct {
+	lineReader
+}
+
+func (r *spkiHashReader) Next() ([]byte, error) {
+	text, err := r.nextLine()
+	if err != nil {
+		return nil, err
+	}
+	spkiHash, err := hex.DecodeString(text)
+	if err != nil {
+		return nil, fmt.Errorf("decoding hex spki hash on line %d: %w", r.line, err)
+	}
+	if len(spkiHash) != 32 {
+		return nil, fmt.Errorf("got spki hash of unexpected length on line %d: %q (%d)", r.line, text, len(spkiHash))
+	}
+	return spkiHash, nil
+}
+
+// rsaModulusReader reads hex-encoded RSA moduli, one per line, optionally
+// prefixed with "Modulus=" as output by "openssl rsa -modulus".
+type rsaModulusReader struct {
+	lineReader
+	exponent int
+}
+
+func (r *rsaModulusReader) Next() ([]byte, error) {
+	text, err := r.nextLine()
+	if err != nil {
+		return nil, err
+	}
+	text = strings.TrimPrefix(text, "Modulus=")
+	n, ok := new(big.Int).SetString(text, 16)
+	if !ok || n.Sign() <= 0 {
+		return nil, fmt.Errorf("decoding hex RSA modulus on line %d", r.line)
+	}
+	return spkiHashOf(&rsa.PublicKey{N: n, E: r.exponent}, r.line)
+}
+
+// pemKeyReader reads PEM-encoded public keys. Each block may be a PKIX
+// "PUBLIC KEY", a PKCS#1 "RSA PUBLIC KEY", or a "CERTIFICATE" whose public key
+// is used. Text outside of PEM blocks is ignored.
+type pemKeyReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (r *pemKeyReader) Next() ([]byte, error) {
+	var block strings.Builder
+	start := 0
+	for r.scanner.Scan() {
+		r.line++
+		text := r.scanner.Text()
+		if start == 0 {
+			if !strings.HasPrefix(text, "-----BEGIN ") {
+				continue
+			}
+			start = r.line
+		}
+		block.WriteString(text)
+		block.WriteByte('\n')
+		if strings.HasPrefix(text, "-----END ") {
+			return r.parse(block.String(), start)
+		}
+	}
+	err := r.scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("reading line %d: %w", r.line+1, err)
+	}
+	if start != 0 {
+		return nil, fmt.Errorf("unterminated PEM block starting on line %d", start)
+	}
+	return nil, io.EOF
+}
+
+func (r *pemKeyReader) parse(text string, line int) ([]byte, error) {
+	block, _ := pem.Decode([]byte(text))
+	if block == nil {
+		return nil, fmt.Errorf("decoding PEM block starting on line %d", line)
+	}
+
+	var pubKey crypto.PublicKey
+	var err error
+	switch block.Type {
+	case "PUBLIC KEY":
+		pubKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		pubKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		cert, err = x509.ParseCertificate(block.Bytes)
+		if err == nil {
+			pubKey = cert.PublicKey
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q starting on line %d", block.Type, line)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s starting on line %d: %w", block.Type, line, err)
+	}
+	return spkiHashOf(pubKey, line)
+}
+
+func spkiHashOf(pubKey crypto.PublicKey, line int) ([]byte, error) {
+	spkiHash, err := core.KeyDigest(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("computing SPKI hash of key on line %d: %w", line, err)
+	}
+	return spkiHash[:], nil
+}
+
+// readImportProgress returns the number of entries recorded as processed in
+// the given progress file, or zero if it doesn't exist yet.
+func readImportProgress(path string) (uint64, error) {
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("reading progress file: %w", err)
+	}
+	processed, err := strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing progress file %q: %w", path, err)
+	}
+	return processed, nil
+}
+
+// writeImportProgress atomically records the number of entries processed in
+// the given progress file.
+func writeImportProgress(path string, processed uint64) error {
+	tmp := path + ".tmp"
+	err := os.WriteFile(tmp, []byte(strconv.FormatUint(processed, 10)+"\n"), 0644)
+	if err != nil {
+		return fmt.Errorf("writing progress file: %w", err)
+	}
+	err = os.Rename(tmp, path)
+	if err != nil {
+		return fmt.Errorf("writing progress file: %w", err)
+	}
+	return nil
+}
+
+// keyImporter blocks the keys from a keyListReader in batches.
+type keyImporter struct {
+	*admin
+	keys        keyListReader
+	limiter     *rate.Limiter
+	user        *user.User
+	comment     string
+	parallelism uint
+	batchSize   uint
+	// checkpoint, if set, is called with the number of entries processed after
+	// each batch completes.
+	checkpoint func(processed uint64) error
+	// seen holds the SPKI hash of every entry read so far, including those
+	// skipped, so that each key is only checked once per import.
+	seen map[string]struct{}
+
+	processed      uint64
+	duplicates     uint64
+	blocked        atomic.Uint64
+	alreadyBlocked atomic.Uint64
+}
+
+// run skips the first skip entries of the key list, then blocks the rest.
+func (imp *keyImporter) run(ctx context.Context, skip uint64) error {
+	for imp.processed < skip {
+		spkiHash, err := imp.keys.Next()
+		if err == io.EOF {
+			return fmt.Errorf("key list has only %d entries, but progress file records %d processed", imp.processed, skip)
+		}
+		if err != nil {
+			return err
+		}
+		imp.seen[string(spkiHash)] = struct{}{}
+		imp.processed++
+	}
+
+	for {
+		batch, read, err := imp.nextBatch()
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if len(batch) > 0 {
+			batchErr := imp.blockBatch(ctx, batch)
+			if batchErr != nil {
+				return batchErr
+			}
+		}
+		imp.processed += read
+		if imp.checkpoint != nil && read > 0 {
+			cpErr := imp.checkpoint(imp.processed)
+			if cpErr != nil {
+				return cpErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		imp.log.Infof("Processed %d entries: %d blocked, %d already blocked, %d duplicates",
+			imp.processed, imp.blocked.Load(), imp.alreadyBlocked.Load(), imp.duplicates)
+	}
+}
+
+// nextBatch reads up to batchSize entries from the key list, and returns the
+// SPKI hashes among them which haven't been seen earlier in the import, along
+// with the number of entries read. It returns io.EOF alongside the final
+// batch.
+func (imp *keyImporter) nextBatch() ([][]byte, uint64, error) {
+	var batch [][]byte
+	var read uint64
+	for read < uint64(imp.batchSize) {
+		spkiHash, err := imp.keys.Next()
+		if err != nil {
+			return batch, read, err
+		}
+		read++
+		_, dupe := imp.seen[string(spkiHash)]
+		if dupe {
+			imp.duplicates++
+			continue
+		}
+		imp.seen[string(spkiHash)] = struct{}{}
+		batch = append(batch, spkiHash)
+	}
+	return batch, read, nil
+}
+
+// blockBatch blocks every key in the batch which isn't already blocked, using
+// parallelism workers and respecting the rate limit. It returns an error if
+// any key could not be checked or blocked.
+func (imp *keyImporter) blockBatch(ctx context.Context, batch [][]byte) error {
+	var errCount atomic.Uint64
+	work := make(chan []byte, imp.parallelism)
+	g, gctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		defer close(work)
+		for _, spkiHash := range batch {
+			err := imp.limiter.Wait(gctx)
+			if err != nil {
+				return err
+			}
+			work <- spkiHash
+		}
+		return nil
+	})
+
+	for range imp.parallelism {
+		g.Go(func() error {
+			for spkiHash := range work {
+				err := imp.importSPKIHash(gctx, spkiHash)
+				if err != nil {
+					errCount.Add(1)
+					imp.log.Errf("failed to block %x: %s", spkiHash, err)
+				}
+			}
+			return nil
+		})
+	}
+
+	err := g.Wait()
+	if err != nil {
+		return err
+	}
+	if errCount.Load() > 0 {
+		return fmt.Errorf("encountered %d errors while blocking keys; see logs above for details", errCount.Load())
+	}
+	return nil
+}
+
+// importSPKIHash blocks the given key, unless it is already blocked.
+func (imp *keyImporter) importSPKIHash(ctx context.Context, spkiHash []byte) error {
+	err := imp.blockSPKIHash(ctx, spkiHash, imp.user, imp.comment)
+	if errors.Is(err, berrors.AlreadyRevoked) {
+		imp.alreadyBlocked.Add(1)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	imp.blocked.Add(1)
+	return nil
+}
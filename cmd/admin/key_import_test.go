@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jmhodges/clock"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/mocks"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func readAllKeys(t *testing.T, r keyListReader) []string {
+	t.Helper()
+	var hashes []string
+	for {
+		spkiHash, err := r.Next()
+		if err == io.EOF {
+			return hashes
+		}
+		test.AssertNotError(t, err, "reading key list")
+		hashes = append(hashes, hex.EncodeToString(spkiHash))
+	}
+}
+
+func TestKeyListReaders(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "generating RSA key")
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating ECDSA key")
+
+	rsaHash, err := core.KeyDigest(rsaKey.Public())
+	test.AssertNotError(t, err, "hashing RSA key")
+	ecdsaHash, err := core.KeyDigest(ecdsaKey.Public())
+	test.AssertNotError(t, err, "hashing ECDSA key")
+
+	pkixRSA, err := x509.MarshalPKIXPublicKey(rsaKey.Public())
+	test.AssertNotError(t, err, "marshalling RSA key")
+	pkixECDSA, err := x509.MarshalPKIXPublicKey(ecdsaKey.Public())
+	test.AssertNotError(t, err, "marshalling ECDSA key")
+
+	pemList := "leading text is ignored\n" +
+		string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkixRSA})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkixECDSA})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)}))
+
+	modulusList := fmt.Sprintf("# Some weak keys\n%x\n\nModulus=%X\n", rsaKey.N, rsaKey.N)
+
+	spkiList := fmt.Sprintf("%x\n# comment\n%x\n", rsaHash, ecdsaHash)
+
+	testCases := []struct {
+		name     string
+		format   string
+		input    string
+		expected []string
+		wantErr  string
+	}{
+		{
+			name:     "pem",
+			format:   "pem",
+			input:    pemList,
+			expected: []string{hex.EncodeToString(rsaHash[:]), hex.EncodeToString(ecdsaHash[:]), hex.EncodeToString(rsaHash[:])},
+		},
+		{
+			name:     "rsa-modulus",
+			format:   "rsa-modulus",
+			input:    modulusList,
+			expected: []string{hex.EncodeToString(rsaHash[:]), hex.EncodeToString(rsaHash[:])},
+		},
+		{
+			name:     "spki-hash",
+			format:   "spki-hash",
+			input:    spkiList,
+			expected: []string{hex.EncodeToString(rsaHash[:]), hex.EncodeToString(ecdsaHash[:])},
+		},
+		{
+			name:    "pem with unsupported block",
+			format:  "pem",
+			input:   string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte{1}})),
+			wantErr: "unsupported PEM block type",
+		},
+		{
+			name:    "pem with unterminated block",
+			format:  "pem",
+			input:   "-----BEGIN PUBLIC KEY-----\nAAAA\n",
+			wantErr: "unterminated PEM block starting on line 1",
+		},
+		{
+			name:    "malformed modulus",
+			format:  "rsa-modulus",
+			input:   "\nnot hex\n",
+			wantErr: "line 2",
+		},
+		{
+			name:    "short spki hash",
+			format:  "spki-hash",
+			input:   "abcd\n",
+			wantErr: "unexpected length",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := newKeyListReader(strings.NewReader(tc.input), tc.format, 65537)
+			test.AssertNotError(t, err, "creating key list reader")
+			if tc.wantErr == "" {
+				test.AssertDeepEquals(t, readAllKeys(t, r), tc.expected)
+				return
+			}
+			for err == nil {
+				_, err = r.Next()
+			}
+			test.AssertContains(t, err.Error(), tc.wantErr)
+		})
+	}
+
+	_, err = newKeyListReader(strings.NewReader(""), "der", 65537)
+	test.AssertError(t, err, "unknown format should be rejected")
+	_, err = newKeyListReader(strings.NewReader(""), "rsa-modulus", 4)
+	test.AssertError(t, err, "even exponent should be rejected")
+}
+
+// mockSAForImport implements the KeyBlocked, GetSerialsByKey, and
+// AddBlockedKey methods used by the importer, recording every key blocked.
+// Keys in failing cause AddBlockedKey to return an error.
+type mockSAForImport struct {
+	sapb.StorageAuthorityReadOnlyClient
+	adminSAClient
+	sync.Mutex
+	blocked map[string]bool
+	failing map[string]bool
+	adds    int
+}
+
+func (msa *mockSAForImport) KeyBlocked(_ context.Context, req *sapb.SPKIHash, _ ...grpc.CallOption) (*sapb.Exists, error) {
+	msa.Lock()
+	defer msa.Unlock()
+	return &sapb.Exists{Exists: msa.blocked[string(req.KeyHash)]}, nil
+}
+
+func (msa *mockSAForImport) GetSerialsByKey(_ context.Context, _ *sapb.SPKIHash, _ ...grpc.CallOption) (grpc.ServerStreamingClient[sapb.Serial], error) {
+	return &mocks.ServerStreamClient[sapb.Serial]{}, nil
+}
+
+func (msa *mockSAForImport) AddBlockedKey(_ context.Context, req *sapb.AddBlockedKeyRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	msa.Lock()
+	defer msa.Unlock()
+	if msa.failing[string(req.KeyHash)] {
+		return nil, errors.New("oops")
+	}
+	msa.adds++
+	msa.blocked[string(req.KeyHash)] = true
+	return &emptypb.Empty{}, nil
+}
+
+func TestKeyImporter(t *testing.T) {
+	t.Parallel()
+
+	// Build a list of 10 distinct hashes, with the first repeated within the
+	// first batch of 4, and again at the end of the list.
+	var hashes [][]byte
+	var lines []string
+	for i := range 10 {
+		h := sha256.Sum256([]byte{byte(i)})
+		hashes = append(hashes, h[:])
+		lines = append(lines, hex.EncodeToString(h[:]))
+		if i == 1 {
+			lines = append(lines, hex.EncodeToString(hashes[0]))
+		}
+	}
+	lines = append(lines, hex.EncodeToString(hashes[0]))
+	list := strings.Join(lines, "\n")
+
+	msa := &mockSAForImport{
+		blocked: map[string]bool{string(hashes[2]): true},
+		failing: map[string]bool{string(hashes[6]): true},
+	}
+	a := &admin{saroc: msa, sac: msa, clk: clock.NewFake(), log: blog.NewMock()}
+
+	var checkpoints []uint64
+	newImporter := func() *keyImporter {
+		keys, err := newKeyListReader(strings.NewReader(list), "spki-hash", 65537)
+		test.AssertNotError(t, err, "creating key list reader")
+		return &keyImporter{
+			admin:       a,
+			keys:        keys,
+			limiter:     rate.NewLimiter(rate.Inf, 1),
+			user:        &user.User{Username: "test"},
+			comment:     "test",
+			parallelism: 3,
+			batchSize:   4,
+			seen:        make(map[string]struct{}),
+			checkpoint: func(processed uint64) error {
+				checkpoints = append(checkpoints, processed)
+				return nil
+			},
+		}
+	}
+
+	// The second batch, entries 5 through 8, contains the failing key. The
+	// first batch is checkpointed, and the import stops.
+	imp := newImporter()
+	err := imp.run(context.Background(), 0)
+	test.AssertError(t, err, "import should fail")
+	test.AssertDeepEquals(t, checkpoints, []uint64{4})
+	test.AssertEquals(t, imp.duplicates, uint64(1))
+	test.AssertEquals(t, imp.alreadyBlocked.Load(), uint64(1))
+
+	// Once the failure is fixed, resuming from the checkpoint completes the
+	// import, without re-checking the first batch. The repeat of the first
+	// key at the end of the list is recognized as a duplicate, even though it
+	// was skipped.
+	delete(msa.failing, string(hashes[6]))
+	addsBefore := msa.adds
+	checkpoints = nil
+	imp = newImporter()
+	err = imp.run(context.Background(), 4)
+	test.AssertNotError(t, err, "resumed import should succeed")
+	test.AssertDeepEquals(t, checkpoints, []uint64{8, 12})
+	test.AssertEquals(t, imp.processed, uint64(12))
+	test.AssertEquals(t, imp.duplicates, uint64(1))
+	for _, h := range hashes {
+		test.Assert(t, msa.blocked[string(h)], fmt.Sprintf("expected %x to be blocked", h))
+	}
+	// Keys from the second batch which were blocked before the failure are
+	// reported as already blocked rather than blocked again.
+	test.AssertEquals(t, msa.adds-addsBefore+int(imp.alreadyBlocked.Load()), 7)
+
+	// Resuming past the end of the list is an error.
+	imp = newImporter()
+	err = imp.run(context.Background(), 20)
+	test.AssertError(t, err, "resuming past the end should fail")
+}
+
+func TestImportProgress(t *testing.T) {
+	t.Parallel()
+	progressFile := path.Join(t.TempDir(), "progress")
+
+	processed, err := readImportProgress(progressFile)
+	test.AssertNotError(t, err, "reading missing progress file")
+	test.AssertEquals(t, processed, uint64(0))
+
+	err = writeImportProgress(progressFile, 12345)
+	test.AssertNotError(t, err, "writing progress file")
+	processed, err = readImportProgress(progressFile)
+	test.AssertNotError(t, err, "reading progress file")
+	test.AssertEquals(t, processed, uint64(12345))
+
+	err = os.WriteFile(progressFile, []byte("garbage"), 0644)
+	test.AssertNotError(t, err, "writing garbage progress file")
+	_, err = readImportProgress(progressFile)
+	test.AssertError(t, err, "garbage progress file should be rejected")
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/features"
+	"github.com/letsencrypt/boulder/issuance"
 )
 
 type Config struct {
@@ -29,6 +30,15 @@ type Config struct {
 		RAService *cmd.GRPCClientConfig
 		SAService *cmd.GRPCClientConfig
 
+		// CRLUpdaterService is only required by the regenerate-crls subcommand.
+		CRLUpdaterService *cmd.GRPCClientConfig
+
+		// CertProfiles should match the certProfiles configured for the CA. Only
+		// their maxValidityPeriods are used, to bound the search for unexpired
+		// certificates by name. It is only required by the -domain flag of the
+		// revoke-cert subcommand.
+		CertProfiles map[string]issuance.ProfileConfig `validate:"omitempty,dive,keys,alphanum,min=1,max=32,endkeys"`
+
 		Features features.Config
 	}
 
@@ -68,6 +78,7 @@ func main() {
 	subcommands := map[string]subcommand{
 		"revoke-cert":            &subcommandRevokeCert{},
 		"block-key":              &subcommandBlockKey{},
+		"import-blocked-keys":    &subcommandImportBlockedKeys{},
 		"pause-identifier":       &subcommandPauseIdentifier{},
 		"unpause-account":        &subcommandUnpauseAccount{},
 		"import-limit-overrides": &subcommandImportOverrides{},
@@ -78,6 +89,10 @@ func main() {
 		"list-incidents":         &subcommandListIncidents{},
 		"update-incident":        &subcommandUpdateIncident{},
 		"load-incident-serials":  &subcommandLoadIncidentSerials{},
+		"show-cert":              &subcommandShowCert{},
+		"show-account":           &subcommandShowAccount{},
+		"show-order":             &subcommandShowOrder{},
+		"regenerate-crls":        &subcommandRegenerateCRLs{},
 	}
 
 	defaultUsage := flag.Usage
@@ -15,6 +15,7 @@ import (
 	rl "github.com/letsencrypt/boulder/ratelimits"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type subcommandAddOverride struct {
@@ -27,6 +28,8 @@ type subcommandAddOverride struct {
 	burst            int64
 	period           string
 	comment          string
+	expiresIn        time.Duration
+	neverExpires     bool
 	force            bool
 }
 
@@ -45,6 +48,8 @@ func (c *subcommandAddOverride) Flags(f *flag.FlagSet) {
 	f.Int64Var(&c.burst, "burst", 0, "burst size (required)")
 	f.StringVar(&c.period, "period", "", "period duration (e.g. 1h, 168h) (required)")
 	f.StringVar(&c.comment, "comment", "", "comment for the override (required)")
+	f.DurationVar(&c.expiresIn, "expiresIn", 0, "how long until the override expires (e.g. 720h); if unset, a new override never expires and an existing override keeps its expiry")
+	f.BoolVar(&c.neverExpires, "neverExpires", false, "removes an existing override's expiry, so that it never expires")
 	f.BoolVar(&c.force, "force", false, "forces an update even if the new override is lower than the existing one")
 }
 
@@ -85,6 +90,13 @@ func (c *subcommandAddOverride) Run(ctx context.Context, a *admin) error {
 		return fmt.Errorf("invalid period value: %s", err)
 	}
 
+	if c.expiresIn < 0 {
+		return fmt.Errorf("invalid expiresIn value %s: must be positive", c.expiresIn)
+	}
+	if c.expiresIn > 0 && c.neverExpires {
+		return errors.New("--expiresIn and --neverExpires are mutually exclusive")
+	}
+
 	var subscriberIP netip.Addr
 	if c.subscriberIP != "" {
 		subscriberIP, err = netip.ParseAddr(c.subscriberIP)
@@ -127,16 +139,22 @@ func (c *subcommandAddOverride) Run(ctx context.Context, a *admin) error {
 		return fmt.Errorf("validating override for limit %s key %q: %s", name, bucketKey, err)
 	}
 
+	override := &sapb.RateLimitOverride{
+		LimitEnum: int64(name),
+		BucketKey: bucketKey,
+		Count:     c.count,
+		Burst:     c.burst,
+		Period:    durationpb.New(dur),
+		Comment:   c.comment,
+	}
+	if c.expiresIn > 0 {
+		override.ExpiresAt = timestamppb.New(a.clk.Now().Add(c.expiresIn))
+	}
+
 	resp, err := a.sac.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{
-		Override: &sapb.RateLimitOverride{
-			LimitEnum: int64(name),
-			BucketKey: bucketKey,
-			Count:     c.count,
-			Burst:     c.burst,
-			Period:    durationpb.New(dur),
-			Comment:   c.comment,
-		},
-		Force: c.force,
+		Override:    override,
+		Force:       c.force,
+		ClearExpiry: c.neverExpires,
 	})
 	if err != nil {
 		return fmt.Errorf("adding override for limit %s key %q: %s", name, bucketKey, err)
@@ -158,10 +176,15 @@ func (c *subcommandAddOverride) Run(ctx context.Context, a *admin) error {
 		status = "enabled"
 	}
 
+	expiry := "never"
+	if override.ExpiresAt != nil {
+		expiry = override.ExpiresAt.AsTime().Format(time.RFC3339)
+	}
+
 	if resp.Inserted {
-		a.log.Infof("Added new override for limit %s key %q, status=[%s]\n", name, bucketKey, status)
+		a.log.Infof("Added new override for limit %s key %q, status=[%s] expires=[%s]\n", name, bucketKey, status, expiry)
 	} else {
-		a.log.Infof("Updated existing override for limit %s key %q, status=[%s]\n", name, bucketKey, status)
+		a.log.Infof("Updated existing override for limit %s key %q, status=[%s] expires=[%s]\n", name, bucketKey, status, expiry)
 	}
 	return nil
 }
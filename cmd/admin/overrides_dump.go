@@ -45,15 +45,20 @@ func (c *subcommandDumpEnabledOverrides) Run(ctx context.Context, a *admin) erro
 			return fmt.Errorf("reading overrides stream: %w", err)
 		}
 
+		comment := fmt.Sprintf("Last Updated: %s - %s",
+			r.UpdatedAt.AsTime().Format("2006-01-02"),
+			r.Override.Comment,
+		)
+		if r.Override.ExpiresAt != nil {
+			comment = fmt.Sprintf("Expires: %s - %s", r.Override.ExpiresAt.AsTime().Format("2006-01-02"), comment)
+		}
+
 		overrides[r.Override.BucketKey] = &ratelimits.Limit{
-			Burst:  r.Override.Burst,
-			Count:  r.Override.Count,
-			Period: config.Duration{Duration: r.Override.Period.AsDuration()},
-			Name:   ratelimits.Name(r.Override.LimitEnum),
-			Comment: fmt.Sprintf("Last Updated: %s - %s",
-				r.UpdatedAt.AsTime().Format("2006-01-02"),
-				r.Override.Comment,
-			),
+			Burst:   r.Override.Burst,
+			Count:   r.Override.Count,
+			Period:  config.Duration{Duration: r.Override.Period.AsDuration()},
+			Name:    ratelimits.Name(r.Override.LimitEnum),
+			Comment: comment,
 		}
 	}
 
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
+)
+
+// subcommandRegenerateCRLs encapsulates the "admin regenerate-crls" command.
+type subcommandRegenerateCRLs struct {
+	issuerNameID int64
+	shards       string
+}
+
+var _ subcommand = (*subcommandRegenerateCRLs)(nil)
+
+func (s *subcommandRegenerateCRLs) Desc() string {
+	return "Immediately regenerate and publish specific CRL shards"
+}
+
+func (s *subcommandRegenerateCRLs) Flags(flag *flag.FlagSet) {
+	flag.Int64Var(&s.issuerNameID, "issuer", 0, "The NameID of the issuer whose CRL shards should be regenerated (required)")
+	flag.StringVar(&s.shards, "shards", "", "Comma-separated list of shard indices to regenerate (required)")
+}
+
+func (s *subcommandRegenerateCRLs) Run(ctx context.Context, a *admin) error {
+	if s.issuerNameID == 0 {
+		return errors.New("the -issuer flag is required")
+	}
+	shards, err := parseShards(s.shards)
+	if err != nil {
+		return err
+	}
+
+	req := &cupb.RegenerateShardsRequest{}
+	for _, shard := range shards {
+		req.Shards = append(req.Shards, &cupb.Shard{IssuerNameID: s.issuerNameID, ShardIdx: shard})
+	}
+
+	if a.cuc == nil {
+		return errors.New("no crlUpdaterService configured")
+	}
+	_, err = a.cuc.RegenerateShards(ctx, req)
+	if err != nil {
+		return fmt.Errorf("regenerating CRL shards: %w", err)
+	}
+	return nil
+}
+
+// parseShards parses a comma-separated list of positive shard indices.
+func parseShards(list string) ([]int64, error) {
+	if list == "" {
+		return nil, errors.New("the -shards flag is required")
+	}
+	var shards []int64
+	for field := range strings.SplitSeq(list, ",") {
+		shard, err := strconv.ParseInt(strings.TrimSpace(field), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing shard %q: %w", field, err)
+		}
+		if shard < 1 {
+			return nil, fmt.Errorf("shard %d must be positive", shard)
+		}
+		shards = append(shards, shard)
+	}
+	return shards, nil
+}
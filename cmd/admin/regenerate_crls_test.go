@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockCUCRecordingRegenerates is a mock which only implements the
+// RegenerateShards method, and records the requests it receives.
+type mockCUCRecordingRegenerates struct {
+	reqs []*cupb.RegenerateShardsRequest
+}
+
+func (m *mockCUCRecordingRegenerates) RegenerateShards(_ context.Context, req *cupb.RegenerateShardsRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.reqs = append(m.reqs, req)
+	return &emptypb.Empty{}, nil
+}
+
+func TestParseShards(t *testing.T) {
+	t.Parallel()
+
+	shards, err := parseShards("1, 3,12")
+	test.AssertNotError(t, err, "parsing valid shard list")
+	test.AssertDeepEquals(t, shards, []int64{1, 3, 12})
+
+	for _, list := range []string{"", "1,,2", "a", "0", "-4"} {
+		_, err = parseShards(list)
+		test.AssertError(t, err, list)
+	}
+}
+
+func TestRegenerateCRLs(t *testing.T) {
+	t.Parallel()
+
+	cuc := &mockCUCRecordingRegenerates{}
+	a := &admin{cuc: cuc}
+
+	s := subcommandRegenerateCRLs{shards: "2"}
+	err := s.Run(context.Background(), a)
+	test.AssertError(t, err, "missing issuer should fail")
+
+	s = subcommandRegenerateCRLs{issuerNameID: 1234, shards: "2,5"}
+	err = s.Run(context.Background(), a)
+	test.AssertNotError(t, err, "regenerating CRLs")
+	test.AssertEquals(t, len(cuc.reqs), 1)
+	test.AssertEquals(t, len(cuc.reqs[0].Shards), 2)
+	test.AssertEquals(t, cuc.reqs[0].Shards[0].IssuerNameID, int64(1234))
+	test.AssertEquals(t, cuc.reqs[0].Shards[1].ShardIdx, int64(5))
+
+	// Without a configured crl-updater, the subcommand fails rather than
+	// silently doing nothing.
+	err = s.Run(context.Background(), &admin{})
+	test.AssertError(t, err, "no crl-updater configured")
+}
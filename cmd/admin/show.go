@@ -0,0 +1,540 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/ratelimits"
+	"github.com/letsencrypt/boulder/revocation"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// tabularView is implemented by the results of the show-* subcommands, so
+// that they can be printed as either JSON or a human-readable table.
+type tabularView interface {
+	printTable(w io.Writer) error
+}
+
+// printView writes v to w in the given format, either "json" or "table".
+func printView(w io.Writer, format string, v tabularView) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case "table":
+		return v.printTable(w)
+	default:
+		return fmt.Errorf("unrecognized output format %q (must be json or table)", format)
+	}
+}
+
+// formatFlag registers the -format flag shared by all show-* subcommands.
+func formatFlag(f *flag.FlagSet, format *string) {
+	f.StringVar(format, "format", "table", "Output format (json or table)")
+}
+
+// formatTime formats t for table output, or returns "-" if it is unset.
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// timePtr converts a protobuf timestamp to a *time.Time, which is nil if the
+// timestamp is unset, so that it is omitted from JSON output.
+func timePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil || ts.AsTime().IsZero() {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// orEmpty returns "-" for empty strings, for table output.
+func orEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+type incidentView struct {
+	Name    string     `json:"name"`
+	URL     string     `json:"url"`
+	RenewBy *time.Time `json:"renewBy,omitempty"`
+	Enabled bool       `json:"enabled"`
+}
+
+// certView is the result of the "admin show-cert" command.
+type certView struct {
+	Serial         string         `json:"serial"`
+	RegistrationID int64          `json:"registrationID"`
+	Precertificate bool           `json:"precertificate"`
+	Subject        string         `json:"subject"`
+	Issuer         string         `json:"issuer"`
+	DNSNames       []string       `json:"dnsNames,omitempty"`
+	IPAddresses    []string       `json:"ipAddresses,omitempty"`
+	KeyAlgorithm   string         `json:"keyAlgorithm"`
+	NotBefore      time.Time      `json:"notBefore"`
+	NotAfter       time.Time      `json:"notAfter"`
+	Status         string         `json:"status"`
+	RevokedDate    *time.Time     `json:"revokedDate,omitempty"`
+	RevokedReason  string         `json:"revokedReason,omitempty"`
+	Incidents      []incidentView `json:"incidents"`
+}
+
+func (v *certView) printTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Serial\t%s\n", v.Serial)
+	fmt.Fprintf(tw, "Account\t%d\n", v.RegistrationID)
+	fmt.Fprintf(tw, "Precertificate\t%t\n", v.Precertificate)
+	fmt.Fprintf(tw, "Subject\t%s\n", orEmpty(v.Subject))
+	fmt.Fprintf(tw, "Issuer\t%s\n", v.Issuer)
+	fmt.Fprintf(tw, "DNS names\t%s\n", orEmpty(strings.Join(v.DNSNames, ", ")))
+	fmt.Fprintf(tw, "IP addresses\t%s\n", orEmpty(strings.Join(v.IPAddresses, ", ")))
+	fmt.Fprintf(tw, "Key algorithm\t%s\n", v.KeyAlgorithm)
+	fmt.Fprintf(tw, "Not before\t%s\n", formatTime(&v.NotBefore))
+	fmt.Fprintf(tw, "Not after\t%s\n", formatTime(&v.NotAfter))
+	fmt.Fprintf(tw, "Status\t%s\n", v.Status)
+	fmt.Fprintf(tw, "Revoked date\t%s\n", formatTime(v.RevokedDate))
+	fmt.Fprintf(tw, "Revoked reason\t%s\n", orEmpty(v.RevokedReason))
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nIncidents (%d):\n", len(v.Incidents))
+	if len(v.Incidents) == 0 {
+		return nil
+	}
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tENABLED\tRENEW BY\tURL")
+	for _, inc := range v.Incidents {
+		fmt.Fprintf(tw, "%s\t%t\t%s\t%s\n", inc.Name, inc.Enabled, formatTime(inc.RenewBy), inc.URL)
+	}
+	return tw.Flush()
+}
+
+type subcommandShowCert struct {
+	serial string
+	format string
+}
+
+var _ subcommand = (*subcommandShowCert)(nil)
+
+func (*subcommandShowCert) Desc() string {
+	return "Show a certificate's details, revocation status, and related incidents"
+}
+
+func (s *subcommandShowCert) Flags(f *flag.FlagSet) {
+	f.StringVar(&s.serial, "serial", "", "Hex serial of the certificate to show (required)")
+	formatFlag(f, &s.format)
+}
+
+func (s *subcommandShowCert) Run(ctx context.Context, a *admin) error {
+	if s.serial == "" {
+		return errors.New("-serial is required")
+	}
+	serials, err := cleanSerials([]string{s.serial})
+	if err != nil {
+		return err
+	}
+
+	v, err := a.showCert(ctx, serials[0])
+	if err != nil {
+		return err
+	}
+	return printView(os.Stdout, s.format, v)
+}
+
+// showCert gathers the details of the certificate with the given serial. If
+// no final certificate exists, the precertificate is shown instead.
+func (a *admin) showCert(ctx context.Context, serial string) (*certView, error) {
+	precert := false
+	cert, err := a.saroc.GetCertificate(ctx, &sapb.Serial{Serial: serial})
+	if errors.Is(err, berrors.NotFound) {
+		precert = true
+		cert, err = a.saroc.GetLintPrecertificate(ctx, &sapb.Serial{Serial: serial})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting certificate %q: %w", serial, err)
+	}
+
+	parsed, err := x509.ParseCertificate(cert.Der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate %q: %w", serial, err)
+	}
+
+	status, err := a.saroc.GetCertificateStatus(ctx, &sapb.Serial{Serial: serial})
+	if err != nil {
+		return nil, fmt.Errorf("getting status of certificate %q: %w", serial, err)
+	}
+
+	incidents, err := a.saroc.IncidentsForSerial(ctx, &sapb.Serial{Serial: serial})
+	if err != nil {
+		return nil, fmt.Errorf("getting incidents for certificate %q: %w", serial, err)
+	}
+
+	v := &certView{
+		Serial:         serial,
+		RegistrationID: cert.RegistrationID,
+		Precertificate: precert,
+		Subject:        parsed.Subject.CommonName,
+		Issuer:         parsed.Issuer.CommonName,
+		DNSNames:       parsed.DNSNames,
+		KeyAlgorithm:   parsed.PublicKeyAlgorithm.String(),
+		NotBefore:      parsed.NotBefore,
+		NotAfter:       parsed.NotAfter,
+		Status:         status.Status,
+		Incidents:      []incidentView{},
+	}
+	for _, ip := range parsed.IPAddresses {
+		v.IPAddresses = append(v.IPAddresses, ip.String())
+	}
+	if core.OCSPStatus(status.Status) == core.OCSPStatusRevoked {
+		v.RevokedDate = timePtr(status.RevokedDate)
+		v.RevokedReason = revocation.Reason(status.RevokedReason).String()
+	}
+	for _, inc := range incidents.Incidents {
+		v.Incidents = append(v.Incidents, incidentView{
+			Name:    inc.SerialTable,
+			URL:     inc.Url,
+			RenewBy: timePtr(inc.RenewBy),
+			Enabled: inc.Enabled,
+		})
+	}
+	return v, nil
+}
+
+type overrideView struct {
+	Limit     string        `json:"limit"`
+	BucketKey string        `json:"bucketKey"`
+	Count     int64         `json:"count"`
+	Burst     int64         `json:"burst"`
+	Period    time.Duration `json:"period"`
+	Comment   string        `json:"comment"`
+	ExpiresAt *time.Time    `json:"expiresAt,omitempty"`
+}
+
+type orderSummaryView struct {
+	ID                int64      `json:"id"`
+	Created           *time.Time `json:"created,omitempty"`
+	Expires           *time.Time `json:"expires,omitempty"`
+	Profile           string     `json:"profile,omitempty"`
+	CertificateSerial string     `json:"certificateSerial,omitempty"`
+	Error             string     `json:"error,omitempty"`
+}
+
+// accountView is the result of the "admin show-account" command.
+type accountView struct {
+	ID                int64              `json:"id"`
+	Status            string             `json:"status"`
+	CreatedAt         *time.Time         `json:"createdAt,omitempty"`
+	KeyThumbprint     string             `json:"keyThumbprint"`
+	PausedIdentifiers []string           `json:"pausedIdentifiers"`
+	Overrides         []overrideView     `json:"overrides"`
+	RecentOrders      []orderSummaryView `json:"recentOrders"`
+}
+
+func (v *accountView) printTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Account\t%d\n", v.ID)
+	fmt.Fprintf(tw, "Status\t%s\n", v.Status)
+	fmt.Fprintf(tw, "Created\t%s\n", formatTime(v.CreatedAt))
+	fmt.Fprintf(tw, "Key thumbprint\t%s\n", orEmpty(v.KeyThumbprint))
+	fmt.Fprintf(tw, "Paused identifiers\t%s\n", orEmpty(strings.Join(v.PausedIdentifiers, ", ")))
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nEnabled overrides (%d):\n", len(v.Overrides))
+	if len(v.Overrides) > 0 {
+		tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "LIMIT\tBUCKET KEY\tCOUNT\tBURST\tPERIOD\tEXPIRES\tCOMMENT")
+		for _, o := range v.Overrides {
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\t%s\t%s\n",
+				o.Limit, o.BucketKey, o.Count, o.Burst, o.Period, formatTime(o.ExpiresAt), o.Comment)
+		}
+		err = tw.Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(w, "\nRecent orders (%d):\n", len(v.RecentOrders))
+	if len(v.RecentOrders) == 0 {
+		return nil
+	}
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tCREATED\tEXPIRES\tPROFILE\tSERIAL\tERROR")
+	for _, o := range v.RecentOrders {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			o.ID, formatTime(o.Created), formatTime(o.Expires), orEmpty(o.Profile), orEmpty(o.CertificateSerial), orEmpty(o.Error))
+	}
+	return tw.Flush()
+}
+
+type subcommandShowAccount struct {
+	regID       int64
+	ordersSince time.Duration
+	maxOrders   int64
+	format      string
+}
+
+var _ subcommand = (*subcommandShowAccount)(nil)
+
+func (*subcommandShowAccount) Desc() string {
+	return "Show an account's status, paused identifiers, overrides, and recent orders"
+}
+
+func (s *subcommandShowAccount) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.regID, "account", 0, "ID of the account to show (required)")
+	f.DurationVar(&s.ordersSince, "orders-since", 7*24*time.Hour, "Show orders created within this long ago")
+	f.Int64Var(&s.maxOrders, "max-orders", 20, "Maximum number of recent orders to show")
+	formatFlag(f, &s.format)
+}
+
+func (s *subcommandShowAccount) Run(ctx context.Context, a *admin) error {
+	if s.regID == 0 {
+		return errors.New("-account is required")
+	}
+	if s.maxOrders <= 0 {
+		return errors.New("-max-orders must be > 0")
+	}
+
+	v, err := a.showAccount(ctx, s.regID, a.clk.Now().Add(-s.ordersSince), s.maxOrders)
+	if err != nil {
+		return err
+	}
+	return printView(os.Stdout, s.format, v)
+}
+
+// showAccount gathers the details of the given account, including any of its
+// orders created after ordersSince, up to maxOrders of them.
+func (a *admin) showAccount(ctx context.Context, regID int64, ordersSince time.Time, maxOrders int64) (*accountView, error) {
+	reg, err := a.saroc.GetRegistration(ctx, &sapb.RegistrationID{Id: regID})
+	if err != nil {
+		return nil, fmt.Errorf("getting account %d: %w", regID, err)
+	}
+
+	v := &accountView{
+		ID:                reg.Id,
+		Status:            reg.Status,
+		CreatedAt:         timePtr(reg.CreatedAt),
+		PausedIdentifiers: []string{},
+		Overrides:         []overrideView{},
+		RecentOrders:      []orderSummaryView{},
+	}
+
+	var jwk jose.JSONWebKey
+	err = jwk.UnmarshalJSON(reg.Key)
+	if err != nil {
+		return nil, fmt.Errorf("parsing key of account %d: %w", regID, err)
+	}
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("computing key thumbprint of account %d: %w", regID, err)
+	}
+	v.KeyThumbprint = base64.RawURLEncoding.EncodeToString(thumbprint)
+
+	paused, err := a.saroc.GetPausedIdentifiers(ctx, &sapb.RegistrationID{Id: regID})
+	if err != nil {
+		return nil, fmt.Errorf("getting paused identifiers for account %d: %w", regID, err)
+	}
+	for _, ident := range paused.Identifiers {
+		v.PausedIdentifiers = append(v.PausedIdentifiers, ident.Value)
+	}
+
+	v.Overrides, err = a.overridesForAccount(ctx, regID)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := a.saroc.GetOrdersByAccount(ctx, &sapb.GetOrdersByAccountRequest{
+		RegistrationID: regID,
+		CreatedAfter:   timestamppb.New(ordersSince),
+		Limit:          maxOrders,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("setting up stream of orders from SA: %s", err)
+	}
+	for {
+		order, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("streaming orders from SA: %s", err)
+		}
+		v.RecentOrders = append(v.RecentOrders, orderSummaryView{
+			ID:                order.Id,
+			Created:           timePtr(order.Created),
+			Expires:           timePtr(order.Expires),
+			Profile:           order.CertificateProfileName,
+			CertificateSerial: order.CertificateSerial,
+			Error:             problemString(order.Error),
+		})
+	}
+
+	return v, nil
+}
+
+// overridesForAccount returns all enabled rate limit overrides whose bucket key
+// identifies the given account, i.e. keys of the form 'enum:regId' or
+// 'enum:regId:...'.
+func (a *admin) overridesForAccount(ctx context.Context, regID int64) ([]overrideView, error) {
+	stream, err := a.saroc.GetEnabledRateLimitOverrides(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("fetching enabled overrides: %w", err)
+	}
+
+	id := strconv.FormatInt(regID, 10)
+	overrides := []overrideView{}
+	for {
+		r, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("reading overrides stream: %w", err)
+		}
+
+		_, rest, ok := strings.Cut(r.Override.BucketKey, ":")
+		if !ok {
+			continue
+		}
+		if rest != id && !strings.HasPrefix(rest, id+":") {
+			continue
+		}
+		overrides = append(overrides, overrideView{
+			Limit:     ratelimits.Name(r.Override.LimitEnum).String(),
+			BucketKey: r.Override.BucketKey,
+			Count:     r.Override.Count,
+			Burst:     r.Override.Burst,
+			Period:    r.Override.Period.AsDuration(),
+			Comment:   r.Override.Comment,
+			ExpiresAt: timePtr(r.Override.ExpiresAt),
+		})
+	}
+	return overrides, nil
+}
+
+// problemString summarizes an order's error, if it has one.
+func problemString(prob *corepb.ProblemDetails) string {
+	if prob == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s", prob.ProblemType, prob.Detail)
+}
+
+// orderView is the result of the "admin show-order" command.
+type orderView struct {
+	ID                int64      `json:"id"`
+	RegistrationID    int64      `json:"registrationID"`
+	Status            string     `json:"status"`
+	Identifiers       []string   `json:"identifiers"`
+	Authorizations    []int64    `json:"authorizations"`
+	Profile           string     `json:"profile,omitempty"`
+	Replaces          string     `json:"replaces,omitempty"`
+	Created           *time.Time `json:"created,omitempty"`
+	Expires           *time.Time `json:"expires,omitempty"`
+	CertificateSerial string     `json:"certificateSerial,omitempty"`
+	Error             string     `json:"error,omitempty"`
+}
+
+func (v *orderView) printTable(w io.Writer) error {
+	authzs := make([]string, 0, len(v.Authorizations))
+	for _, id := range v.Authorizations {
+		authzs = append(authzs, strconv.FormatInt(id, 10))
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Order\t%d\n", v.ID)
+	fmt.Fprintf(tw, "Account\t%d\n", v.RegistrationID)
+	fmt.Fprintf(tw, "Status\t%s\n", v.Status)
+	fmt.Fprintf(tw, "Identifiers\t%s\n", orEmpty(strings.Join(v.Identifiers, ", ")))
+	fmt.Fprintf(tw, "Authorizations\t%s\n", orEmpty(strings.Join(authzs, ", ")))
+	fmt.Fprintf(tw, "Profile\t%s\n", orEmpty(v.Profile))
+	fmt.Fprintf(tw, "Replaces\t%s\n", orEmpty(v.Replaces))
+	fmt.Fprintf(tw, "Created\t%s\n", formatTime(v.Created))
+	fmt.Fprintf(tw, "Expires\t%s\n", formatTime(v.Expires))
+	fmt.Fprintf(tw, "Certificate serial\t%s\n", orEmpty(v.CertificateSerial))
+	fmt.Fprintf(tw, "Error\t%s\n", orEmpty(v.Error))
+	return tw.Flush()
+}
+
+type subcommandShowOrder struct {
+	orderID int64
+	format  string
+}
+
+var _ subcommand = (*subcommandShowOrder)(nil)
+
+func (*subcommandShowOrder) Desc() string {
+	return "Show an unexpired order's status, identifiers, and authorizations"
+}
+
+func (s *subcommandShowOrder) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.orderID, "order", 0, "ID of the order to show (required)")
+	formatFlag(f, &s.format)
+}
+
+func (s *subcommandShowOrder) Run(ctx context.Context, a *admin) error {
+	if s.orderID == 0 {
+		return errors.New("-order is required")
+	}
+
+	v, err := a.showOrder(ctx, s.orderID)
+	if err != nil {
+		return err
+	}
+	return printView(os.Stdout, s.format, v)
+}
+
+// showOrder gathers the details of the given order.
+func (a *admin) showOrder(ctx context.Context, orderID int64) (*orderView, error) {
+	order, err := a.saroc.GetOrder(ctx, &sapb.OrderRequest{Id: orderID})
+	if err != nil {
+		return nil, fmt.Errorf("getting order %d: %w", orderID, err)
+	}
+
+	v := &orderView{
+		ID:                order.Id,
+		RegistrationID:    order.RegistrationID,
+		Status:            order.Status,
+		Identifiers:       []string{},
+		Authorizations:    order.V2Authorizations,
+		Profile:           order.CertificateProfileName,
+		Replaces:          order.Replaces,
+		Created:           timePtr(order.Created),
+		Expires:           timePtr(order.Expires),
+		CertificateSerial: order.CertificateSerial,
+		Error:             problemString(order.Error),
+	}
+	for _, ident := range order.Identifiers {
+		v.Identifiers = append(v.Identifiers, ident.Value)
+	}
+	return v, nil
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/jmhodges/clock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/mocks"
+	"github.com/letsencrypt/boulder/ratelimits"
+	"github.com/letsencrypt/boulder/revocation"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockSAForShow is a mock which implements the read-only gRPC methods used by
+// the show-* subcommands, returning canned results for a single certificate,
+// account, and order.
+type mockSAForShow struct {
+	sapb.StorageAuthorityReadOnlyClient
+	clk     clock.Clock
+	certDER []byte
+	key     []byte
+	precert bool
+}
+
+func (msa *mockSAForShow) GetCertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	if msa.precert || req.Serial != "000000000000000000000000000000000001" {
+		return nil, berrors.NotFoundError("no certificate")
+	}
+	return &corepb.Certificate{RegistrationID: 1, Serial: req.Serial, Der: msa.certDER}, nil
+}
+
+func (msa *mockSAForShow) GetLintPrecertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	if req.Serial != "000000000000000000000000000000000001" {
+		return nil, berrors.NotFoundError("no precertificate")
+	}
+	return &corepb.Certificate{RegistrationID: 1, Serial: req.Serial, Der: msa.certDER}, nil
+}
+
+func (msa *mockSAForShow) GetCertificateStatus(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.CertificateStatus, error) {
+	return &corepb.CertificateStatus{
+		Serial:        req.Serial,
+		Status:        string(core.OCSPStatusRevoked),
+		RevokedDate:   timestamppb.New(msa.clk.Now()),
+		RevokedReason: int64(revocation.KeyCompromise),
+	}, nil
+}
+
+func (msa *mockSAForShow) IncidentsForSerial(_ context.Context, _ *sapb.Serial, _ ...grpc.CallOption) (*sapb.Incidents, error) {
+	return &sapb.Incidents{Incidents: []*sapb.Incident{
+		{SerialTable: "incident_foo", Url: "https://example.com/foo", RenewBy: timestamppb.New(msa.clk.Now()), Enabled: true},
+	}}, nil
+}
+
+func (msa *mockSAForShow) GetRegistration(_ context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*corepb.Registration, error) {
+	if req.Id != 1 {
+		return nil, berrors.NotFoundError("no registration")
+	}
+	return &corepb.Registration{Id: 1, Key: msa.key, Status: string(core.StatusValid), CreatedAt: timestamppb.New(msa.clk.Now())}, nil
+}
+
+func (msa *mockSAForShow) GetPausedIdentifiers(_ context.Context, _ *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.Identifiers, error) {
+	return &sapb.Identifiers{Identifiers: []*corepb.Identifier{{Type: "dns", Value: "paused.example.com"}}}, nil
+}
+
+func (msa *mockSAForShow) GetEnabledRateLimitOverrides(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (grpc.ServerStreamingClient[sapb.RateLimitOverrideResponse], error) {
+	override := func(name ratelimits.Name, bucketKey string) *sapb.RateLimitOverrideResponse {
+		return &sapb.RateLimitOverrideResponse{Override: &sapb.RateLimitOverride{
+			LimitEnum: int64(name),
+			BucketKey: bucketKey,
+			Count:     10,
+			Burst:     10,
+			Period:    durationpb.New(time.Hour),
+			Comment:   "test",
+		}}
+	}
+	return &mocks.ServerStreamClient[sapb.RateLimitOverrideResponse]{Results: []*sapb.RateLimitOverrideResponse{
+		override(ratelimits.NewOrdersPerAccount, "6:1"),
+		override(ratelimits.NewOrdersPerAccount, "6:12"),
+		override(ratelimits.CertificatesPerDomainPerAccount, "8:1"),
+		override(ratelimits.CertificatesPerDomain, "7:example.com"),
+	}}, nil
+}
+
+func (msa *mockSAForShow) GetOrdersByAccount(_ context.Context, req *sapb.GetOrdersByAccountRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[corepb.Order], error) {
+	if req.RegistrationID != 1 || req.Limit != 5 {
+		return &mocks.ServerStreamClient[corepb.Order]{}, nil
+	}
+	return &mocks.ServerStreamClient[corepb.Order]{Results: []*corepb.Order{
+		{Id: 2, RegistrationID: 1, Created: timestamppb.New(msa.clk.Now())},
+		{Id: 1, RegistrationID: 1, Created: timestamppb.New(msa.clk.Now()), CertificateSerial: "000000000000000000000000000000000001"},
+	}}, nil
+}
+
+func (msa *mockSAForShow) GetOrder(_ context.Context, req *sapb.OrderRequest, _ ...grpc.CallOption) (*corepb.Order, error) {
+	if req.Id != 1 {
+		return nil, berrors.NotFoundError("no order")
+	}
+	return &corepb.Order{
+		Id:               1,
+		RegistrationID:   1,
+		Status:           string(core.StatusInvalid),
+		Identifiers:      []*corepb.Identifier{{Type: "dns", Value: "example.com"}},
+		V2Authorizations: []int64{3, 4},
+		Error:            &corepb.ProblemDetails{ProblemType: "unauthorized", Detail: "oops"},
+	}, nil
+}
+
+func newMockSAForShow(t *testing.T) *mockSAForShow {
+	t.Helper()
+	fc := clock.NewFake()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating test key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com", "www.example.com"},
+		NotBefore:    fc.Now(),
+		NotAfter:     fc.Now().Add(90 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	test.AssertNotError(t, err, "creating test certificate")
+
+	jwk, err := jose.JSONWebKey{Key: key.Public()}.MarshalJSON()
+	test.AssertNotError(t, err, "marshalling test JWK")
+
+	return &mockSAForShow{clk: fc, certDER: der, key: jwk}
+}
+
+func TestShowCert(t *testing.T) {
+	t.Parallel()
+	msa := newMockSAForShow(t)
+	a := admin{saroc: msa}
+
+	v, err := a.showCert(context.Background(), "000000000000000000000000000000000001")
+	test.AssertNotError(t, err, "showing certificate")
+	test.AssertEquals(t, v.RegistrationID, int64(1))
+	test.Assert(t, !v.Precertificate, "expected a final certificate")
+	test.AssertEquals(t, v.Subject, "example.com")
+	test.AssertDeepEquals(t, v.DNSNames, []string{"example.com", "www.example.com"})
+	test.AssertEquals(t, v.Status, string(core.OCSPStatusRevoked))
+	test.AssertEquals(t, v.RevokedReason, "keyCompromise")
+	test.AssertEquals(t, len(v.Incidents), 1)
+	test.AssertEquals(t, v.Incidents[0].Name, "incident_foo")
+
+	// Falls back to the precertificate when there is no final certificate.
+	msa.precert = true
+	v, err = a.showCert(context.Background(), "000000000000000000000000000000000001")
+	test.AssertNotError(t, err, "showing precertificate")
+	test.Assert(t, v.Precertificate, "expected a precertificate")
+
+	_, err = a.showCert(context.Background(), "000000000000000000000000000000000002")
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	var buf bytes.Buffer
+	err = printView(&buf, "table", v)
+	test.AssertNotError(t, err, "printing table")
+	test.AssertContains(t, buf.String(), "www.example.com")
+	test.AssertContains(t, buf.String(), "Incidents (1):")
+}
+
+func TestShowAccount(t *testing.T) {
+	t.Parallel()
+	msa := newMockSAForShow(t)
+	a := admin{saroc: msa}
+
+	v, err := a.showAccount(context.Background(), 1, msa.clk.Now().Add(-time.Hour), 5)
+	test.AssertNotError(t, err, "showing account")
+	test.AssertEquals(t, v.Status, string(core.StatusValid))
+	test.Assert(t, v.KeyThumbprint != "", "expected a key thumbprint")
+	test.AssertDeepEquals(t, v.PausedIdentifiers, []string{"paused.example.com"})
+
+	// Only the overrides for account 1 are shown, not those for account 12 or
+	// for a domain.
+	test.AssertEquals(t, len(v.Overrides), 2)
+	test.AssertEquals(t, v.Overrides[0].BucketKey, "6:1")
+	test.AssertEquals(t, v.Overrides[0].Limit, ratelimits.NewOrdersPerAccount.String())
+	test.AssertEquals(t, v.Overrides[1].BucketKey, "8:1")
+
+	test.AssertEquals(t, len(v.RecentOrders), 2)
+	test.AssertEquals(t, v.RecentOrders[0].ID, int64(2))
+	test.AssertEquals(t, v.RecentOrders[1].CertificateSerial, "000000000000000000000000000000000001")
+
+	_, err = a.showAccount(context.Background(), 2, msa.clk.Now().Add(-time.Hour), 5)
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	var buf bytes.Buffer
+	err = printView(&buf, "json", v)
+	test.AssertNotError(t, err, "printing JSON")
+	var decoded accountView
+	err = json.Unmarshal(buf.Bytes(), &decoded)
+	test.AssertNotError(t, err, "decoding JSON")
+	test.AssertDeepEquals(t, decoded.PausedIdentifiers, v.PausedIdentifiers)
+	test.AssertEquals(t, len(decoded.RecentOrders), 2)
+}
+
+func TestShowOrder(t *testing.T) {
+	t.Parallel()
+	msa := newMockSAForShow(t)
+	a := admin{saroc: msa}
+
+	v, err := a.showOrder(context.Background(), 1)
+	test.AssertNotError(t, err, "showing order")
+	test.AssertEquals(t, v.Status, string(core.StatusInvalid))
+	test.AssertDeepEquals(t, v.Identifiers, []string{"example.com"})
+	test.AssertDeepEquals(t, v.Authorizations, []int64{3, 4})
+	test.AssertEquals(t, v.Error, "unauthorized: oops")
+
+	_, err = a.showOrder(context.Background(), 2)
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	var buf bytes.Buffer
+	err = printView(&buf, "table", v)
+	test.AssertNotError(t, err, "printing table")
+	test.AssertContains(t, buf.String(), "3, 4")
+
+	err = printView(&buf, "yaml", v)
+	test.AssertError(t, err, "printing an unknown format should fail")
+}
@@ -16,6 +16,7 @@ func main() {
 	defer cmd.AuditPanic()
 
 	debugAddr := flag.String("debug-addr", "", "Debug server address override")
+	statusAddr := flag.String("status-addr", "", "Status API server address override")
 	configPath := flag.String(
 		"config", "config.yml", "Path to boulder-observer configuration file")
 	flag.Parse()
@@ -30,6 +31,9 @@ func main() {
 	if *debugAddr != "" {
 		obsConf.DebugAddr = *debugAddr
 	}
+	if *statusAddr != "" {
+		obsConf.StatusAddr = *statusAddr
+	}
 
 	if err != nil {
 		cmd.FailOnError(err, "failed to parse YAML config")
@@ -2,6 +2,9 @@ package notmain
 
 import (
 	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
 	"flag"
 	"fmt"
 	"os"
@@ -13,6 +16,7 @@ import (
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/config"
 	"github.com/letsencrypt/boulder/core"
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
 	"github.com/letsencrypt/boulder/ctpolicy"
 	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
 	"github.com/letsencrypt/boulder/ctpolicy/loglist"
@@ -28,6 +32,7 @@ import (
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/ratelimits"
 	bredis "github.com/letsencrypt/boulder/redis"
+	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/va"
 	vapb "github.com/letsencrypt/boulder/va/proto"
@@ -50,11 +55,28 @@ type Config struct {
 		CAService        *cmd.GRPCClientConfig
 		PublisherService *cmd.GRPCClientConfig
 
+		// CRLUpdaterService is optional. If set, the RA asks the crl-updater to
+		// regenerate the affected CRL shard immediately after each revocation.
+		CRLUpdaterService *cmd.GRPCClientConfig
+
 		Limiter struct {
 			// Redis contains the configuration necessary to connect to Redis
-			// for rate limiting. This field is required to enable rate
-			// limiting.
-			Redis *bredis.Config `validate:"required_with=Defaults"`
+			// for rate limiting. Either this field or DB is required to enable
+			// rate limiting.
+			Redis *bredis.Config `validate:"excluded_with=DB"`
+
+			// DB, if set, causes rate limit buckets to be stored in the
+			// rateLimitBuckets database table instead of in Redis. Either this
+			// field or Redis is required to enable rate limiting.
+			DB *cmd.DBConfig `validate:"excluded_with=Redis"`
+
+			// DBGCInterval is how often expired buckets are deleted from the
+			// rateLimitBuckets table when DB is set. Defaults to 1 minute.
+			DBGCInterval config.Duration `validate:"-"`
+
+			// DBGCBatchSize is the maximum number of expired buckets deleted by
+			// a single query when DB is set. Defaults to 1000.
+			DBGCBatchSize int `validate:"omitempty,min=1"`
 
 			// Defaults is a path to a YAML file containing default rate limits.
 			// See: ratelimits/README.md for details. This field is required to
@@ -64,7 +86,7 @@ type Config struct {
 			//
 			// Note: At this time, only the Failed Authorizations rate limit is
 			// necessary in the RA.
-			Defaults string `validate:"required_with=Redis"`
+			Defaults string `validate:"required_with=Redis DB"`
 
 			// Overrides is a path to a YAML file containing overrides for the
 			// default rate limits. See: ratelimits/README.md for details. If
@@ -79,6 +101,17 @@ type Config struct {
 			// OverridesFromDB causes the WFE and RA to retrieve rate limit overrides
 			// from the database, instead of from a file.
 			OverridesFromDB bool
+
+			// ReloadOnSIGHUP causes the Defaults and Overrides files to be
+			// reloaded, rather than the process exiting, when a SIGHUP is
+			// received. New limits are validated before they replace the
+			// current ones; if they are invalid, the current ones are kept.
+			ReloadOnSIGHUP bool
+
+			// ReloadInterval, if set, is how often the Defaults and Overrides
+			// files are checked for changes. Changed files are reloaded as
+			// though a SIGHUP had been received.
+			ReloadInterval config.Duration `validate:"-"`
 		}
 
 		// ValidationProfiles is a map of validation profiles to their
@@ -128,6 +161,19 @@ type Config struct {
 	OpenTelemetry cmd.OpenTelemetryConfig
 }
 
+// loadPublicKey reads a PEM-encoded PKIX public key.
+func loadPublicKey(filename string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("no PUBLIC KEY PEM block in %s", filename)
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
 func main() {
 	grpcAddr := flag.String("addr", "", "gRPC listen address override")
 	debugAddr := flag.String("debug-addr", "", "Debug server address override")
@@ -235,6 +281,29 @@ func main() {
 
 	ctp = ctpolicy.New(pubc, sctLogs, infoLogs, finalLogs, c.RA.CTLogs.Stagger.Duration, logger, scope)
 
+	if c.RA.CTLogs.LogListRefresh != nil {
+		refresh := c.RA.CTLogs.LogListRefresh
+		src := ctpolicy.LogListSource{
+			Location:          refresh.Source,
+			SignatureLocation: refresh.SignatureSource,
+			SCTLogs:           c.RA.CTLogs.SCTLogs,
+			InfoLogs:          c.RA.CTLogs.InfoLogs,
+			FinalLogs:         c.RA.CTLogs.FinalLogs,
+			SubmitToTestLogs:  c.RA.CTLogs.SubmitToTestLogs,
+			MinOperators:      refresh.MinOperators,
+		}
+		if src.Location == "" {
+			src.Location = c.RA.CTLogs.LogListFile
+		}
+		if refresh.SignatureKeyFile != "" {
+			src.SignatureKey, err = loadPublicKey(refresh.SignatureKeyFile)
+			cmd.FailOnError(err, "Failed to load log list signature key")
+		}
+		cmd.FailOnError(src.Validate(), "Invalid log list refresh config")
+		logListRefresherShutdown := ctp.NewRefresher(src, refresh.Interval.Duration)
+		defer logListRefresherShutdown()
+	}
+
 	if len(c.RA.ValidationProfiles) == 0 {
 		cmd.Fail("At least one profile must be configured")
 	}
@@ -256,14 +325,34 @@ func main() {
 
 	var limiter *ratelimits.Limiter
 	var txnBuilder *ratelimits.TransactionBuilder
-	var limiterRedis *bredis.Ring
+	var limiterRedis *bredis.Client
 	if c.RA.Limiter.Defaults != "" {
 		// Setup rate limiting.
-		limiterRedis, err = bredis.NewRingFromConfig(*c.RA.Limiter.Redis, scope, logger)
-		cmd.FailOnError(err, "Failed to create Redis ring")
-
-		source := ratelimits.NewRedisSource(limiterRedis.Ring, clk, scope)
-		limiter, err = ratelimits.NewLimiter(clk, source, scope)
+		var source ratelimits.Source
+		switch {
+		case c.RA.Limiter.DB != nil:
+			dbMap, err := sa.InitWrappedDb(*c.RA.Limiter.DB, scope, logger)
+			cmd.FailOnError(err, "Failed to create rate limits database map")
+
+			dbSource := ratelimits.NewDBSource(dbMap, clk, scope)
+			if c.RA.Limiter.DBGCInterval.Duration == 0 {
+				c.RA.Limiter.DBGCInterval.Duration = time.Minute
+			}
+			if c.RA.Limiter.DBGCBatchSize == 0 {
+				c.RA.Limiter.DBGCBatchSize = 1000
+			}
+			limitsGCShutdown := dbSource.NewGarbageCollector(c.RA.Limiter.DBGCInterval.Duration, c.RA.Limiter.DBGCBatchSize, logger)
+			defer limitsGCShutdown()
+			source = dbSource
+		case c.RA.Limiter.Redis != nil:
+			limiterRedis, err = bredis.NewClientFromConfig(*c.RA.Limiter.Redis, scope, logger)
+			cmd.FailOnError(err, "Failed to create Redis client")
+
+			source = ratelimits.NewRedisSource(limiterRedis.UniversalClient, clk, scope)
+		default:
+			cmd.Fail("Limiter.Defaults is set, but neither Limiter.Redis nor Limiter.DB is configured")
+		}
+		limiter, err = ratelimits.NewLimiter(clk, source, scope, logger)
 		cmd.FailOnError(err, "Failed to create rate limiter")
 		if c.RA.Limiter.OverridesFromDB {
 			if c.RA.Limiter.Overrides != "" {
@@ -280,6 +369,15 @@ func main() {
 		// (successCommentBody) made to requesters in sfe/overridesimporter.go
 		overrideRefresherShutdown := txnBuilder.NewRefresher(30 * time.Minute)
 		defer overrideRefresherShutdown()
+
+		if c.RA.Limiter.ReloadOnSIGHUP || c.RA.Limiter.ReloadInterval.Duration > 0 {
+			var trigger <-chan os.Signal
+			if c.RA.Limiter.ReloadOnSIGHUP {
+				trigger = cmd.ReloadSignal()
+			}
+			limitsReloaderShutdown := txnBuilder.NewReloader(c.RA.Limiter.ReloadInterval.Duration, trigger)
+			defer limitsReloaderShutdown()
+		}
 	}
 
 	rai := ra.NewRegistrationAuthorityImpl(
@@ -308,6 +406,12 @@ func main() {
 	rai.CA = cac
 	rai.SA = sac
 
+	if c.RA.CRLUpdaterService != nil {
+		cuConn, err := bgrpc.ClientSetup(c.RA.CRLUpdaterService, tlsConfig, scope, clk)
+		cmd.FailOnError(err, "Unable to create CRLUpdater client")
+		rai.CRLUpdater = cupb.NewCRLUpdaterClient(cuConn)
+	}
+
 	start, err := bgrpc.NewServer(c.RA.GRPC, logger).Add(
 		&rapb.RegistrationAuthority_ServiceDesc, rai).Add(
 		&rapb.SCTProvider_ServiceDesc, rai).
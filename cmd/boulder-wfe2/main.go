@@ -26,6 +26,7 @@ import (
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/ratelimits"
 	bredis "github.com/letsencrypt/boulder/redis"
+	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	emailpb "github.com/letsencrypt/boulder/salesforce/email/proto"
 	"github.com/letsencrypt/boulder/strictyaml"
@@ -159,16 +160,29 @@ type Config struct {
 
 		Limiter struct {
 			// Redis contains the configuration necessary to connect to Redis
-			// for rate limiting. This field is required to enable rate
-			// limiting.
-			Redis *bredis.Config `validate:"required_with=Defaults"`
+			// for rate limiting. Either this field or DB is required to enable
+			// rate limiting.
+			Redis *bredis.Config `validate:"excluded_with=DB"`
+
+			// DB, if set, causes rate limit buckets to be stored in the
+			// rateLimitBuckets database table instead of in Redis. Either this
+			// field or Redis is required to enable rate limiting.
+			DB *cmd.DBConfig `validate:"excluded_with=Redis"`
+
+			// DBGCInterval is how often expired buckets are deleted from the
+			// rateLimitBuckets table when DB is set. Defaults to 1 minute.
+			DBGCInterval config.Duration `validate:"-"`
+
+			// DBGCBatchSize is the maximum number of expired buckets deleted by
+			// a single query when DB is set. Defaults to 1000.
+			DBGCBatchSize int `validate:"omitempty,min=1"`
 
 			// Defaults is a path to a YAML file containing default rate limits.
 			// See: ratelimits/README.md for details. This field is required to
 			// enable rate limiting. If any individual rate limit is not set,
 			// that limit will be disabled. Failed Authorizations limits passed
 			// in this file must be identical to those in the RA.
-			Defaults string `validate:"required_with=Redis"`
+			Defaults string `validate:"required_with=Redis DB"`
 
 			// Overrides is a path to a YAML file containing overrides for the
 			// default rate limits. See: ratelimits/README.md for details. If
@@ -181,6 +195,17 @@ type Config struct {
 			// OverridesFromDB causes the WFE and RA to retrieve rate limit
 			// overrides from the database, instead of from a file.
 			OverridesFromDB bool
+
+			// ReloadOnSIGHUP causes the Defaults and Overrides files to be
+			// reloaded, rather than the process exiting, when a SIGHUP is
+			// received. New limits are validated before they replace the
+			// current ones; if they are invalid, the current ones are kept.
+			ReloadOnSIGHUP bool
+
+			// ReloadInterval, if set, is how often the Defaults and Overrides
+			// files are checked for changes. Changed files are reloaded as
+			// though a SIGHUP had been received.
+			ReloadInterval config.Duration `validate:"-"`
 		}
 
 		// CertProfiles is a map of acceptable certificate profile names to
@@ -420,15 +445,36 @@ func main() {
 
 	var limiter *ratelimits.Limiter
 	var txnBuilder *ratelimits.TransactionBuilder
-	var limiterRedis *bredis.Ring
+	var limiterRedis *bredis.Client
 	overridesRefresherShutdown := func() {}
+	limitsReloaderShutdown := func() {}
+	limitsGCShutdown := func() {}
 	if c.WFE.Limiter.Defaults != "" {
 		// Setup rate limiting.
-		limiterRedis, err = bredis.NewRingFromConfig(*c.WFE.Limiter.Redis, stats, logger)
-		cmd.FailOnError(err, "Failed to create Redis ring")
-
-		source := ratelimits.NewRedisSource(limiterRedis.Ring, clk, stats)
-		limiter, err = ratelimits.NewLimiter(clk, source, stats)
+		var source ratelimits.Source
+		switch {
+		case c.WFE.Limiter.DB != nil:
+			dbMap, err := sa.InitWrappedDb(*c.WFE.Limiter.DB, stats, logger)
+			cmd.FailOnError(err, "Failed to create rate limits database map")
+
+			dbSource := ratelimits.NewDBSource(dbMap, clk, stats)
+			if c.WFE.Limiter.DBGCInterval.Duration == 0 {
+				c.WFE.Limiter.DBGCInterval.Duration = time.Minute
+			}
+			if c.WFE.Limiter.DBGCBatchSize == 0 {
+				c.WFE.Limiter.DBGCBatchSize = 1000
+			}
+			limitsGCShutdown = dbSource.NewGarbageCollector(c.WFE.Limiter.DBGCInterval.Duration, c.WFE.Limiter.DBGCBatchSize, logger)
+			source = dbSource
+		case c.WFE.Limiter.Redis != nil:
+			limiterRedis, err = bredis.NewClientFromConfig(*c.WFE.Limiter.Redis, stats, logger)
+			cmd.FailOnError(err, "Failed to create Redis client")
+
+			source = ratelimits.NewRedisSource(limiterRedis.UniversalClient, clk, stats)
+		default:
+			cmd.Fail("Limiter.Defaults is set, but neither Limiter.Redis nor Limiter.DB is configured")
+		}
+		limiter, err = ratelimits.NewLimiter(clk, source, stats, logger)
 		cmd.FailOnError(err, "Failed to create rate limiter")
 		if c.WFE.Limiter.OverridesFromDB {
 			if c.WFE.Limiter.Overrides != "" {
@@ -443,6 +489,14 @@ func main() {
 		// The 30 minute period here must be kept in sync with the promise
 		// (successCommentBody) made to requesters in sfe/overridesimporter.go
 		overridesRefresherShutdown = txnBuilder.NewRefresher(30 * time.Minute)
+
+		if c.WFE.Limiter.ReloadOnSIGHUP || c.WFE.Limiter.ReloadInterval.Duration > 0 {
+			var trigger <-chan os.Signal
+			if c.WFE.Limiter.ReloadOnSIGHUP {
+				trigger = cmd.ReloadSignal()
+			}
+			limitsReloaderShutdown = txnBuilder.NewReloader(c.WFE.Limiter.ReloadInterval.Duration, trigger)
+		}
 	}
 
 	var acctBlocker wfe2.AccountBlocker
@@ -520,6 +574,8 @@ func main() {
 		ctx, cancel := context.WithTimeout(context.Background(), c.WFE.ShutdownStopTimeout.Duration)
 		defer cancel()
 		overridesRefresherShutdown()
+		limitsReloaderShutdown()
+		limitsGCShutdown()
 		_ = srv.Shutdown(ctx)
 		_ = tlsSrv.Shutdown(ctx)
 		limiterRedis.StopLookups()
@@ -0,0 +1,156 @@
+package notmain
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/crl/auditor"
+	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/issuance"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+type Config struct {
+	CRLAuditor struct {
+		DebugAddr string `validate:"omitempty,hostname_port"`
+
+		// TLS client certificate, private key, and trusted root bundle.
+		TLS cmd.TLSConfig
+
+		SAService *cmd.GRPCClientConfig
+
+		// Issuers is the list of issuers whose CRLs will be audited.
+		Issuers []struct {
+			// CertFile is the path to the issuer certificate on disk.
+			CertFile string `validate:"required"`
+			// CRLURLBase is the URL prefix at which the issuer's CRL shards are
+			// published, matching the CA's CRLURLBase for the issuer. It must
+			// end in a slash.
+			CRLURLBase string `validate:"required,url,endswith=/"`
+		} `validate:"min=1,dive"`
+
+		// NumShards is the number of shards into which each issuer's CRL is
+		// split. It must match the crl-updater's NumShards.
+		NumShards int `validate:"min=1"`
+
+		// LookbackPeriod must match the crl-updater's LookbackPeriod. Entries
+		// for certificates which expired longer than this before a CRL's
+		// thisUpdate are reported as extra.
+		LookbackPeriod config.Duration `validate:"-"`
+
+		// MaxLatency is the longest time a revocation may go unpublished
+		// before it's reported as late. Defaults to 24 hours, the maximum
+		// allowed by the Baseline Requirements, Section 4.9.7.
+		MaxLatency config.Duration `validate:"-"`
+
+		// AuditInterval is how often to audit every shard. Defaults to one hour.
+		AuditInterval config.Duration `validate:"-"`
+
+		// MaxCRLSize is the largest CRL, in bytes, which will be downloaded.
+		// Defaults to core.DefaultMaxCRLRead.
+		MaxCRLSize int64 `validate:"omitempty,min=1"`
+
+		Features features.Config
+	}
+
+	Syslog        cmd.SyslogConfig
+	OpenTelemetry cmd.OpenTelemetryConfig
+}
+
+func main() {
+	configFile := flag.String("config", "", "File path to the configuration file for this service")
+	debugAddr := flag.String("debug-addr", "", "Debug server address override")
+	runOnce := flag.Bool("runOnce", false, "If true, audit once immediately, then exit with an error if any problems were found")
+	flag.Parse()
+	if *configFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var c Config
+	err := cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+
+	if *debugAddr != "" {
+		c.CRLAuditor.DebugAddr = *debugAddr
+	}
+
+	features.Set(c.CRLAuditor.Features)
+
+	scope, logger, oTelShutdown := cmd.StatsAndLogging(c.Syslog, c.OpenTelemetry, c.CRLAuditor.DebugAddr)
+	defer oTelShutdown(context.Background())
+	cmd.LogStartup(logger)
+	clk := clock.New()
+
+	tlsConfig, err := c.CRLAuditor.TLS.Load(scope)
+	cmd.FailOnError(err, "TLS config")
+
+	issuers := make([]auditor.Issuer, 0, len(c.CRLAuditor.Issuers))
+	for _, ic := range c.CRLAuditor.Issuers {
+		cert, err := issuance.LoadCertificate(ic.CertFile)
+		cmd.FailOnError(err, "Failed to load issuer cert")
+		issuers = append(issuers, auditor.Issuer{Cert: cert, URLBase: ic.CRLURLBase})
+	}
+
+	if c.CRLAuditor.LookbackPeriod.Duration == 0 {
+		c.CRLAuditor.LookbackPeriod.Duration = 24 * time.Hour
+	}
+	if c.CRLAuditor.MaxLatency.Duration == 0 {
+		c.CRLAuditor.MaxLatency.Duration = 24 * time.Hour
+	}
+	if c.CRLAuditor.AuditInterval.Duration == 0 {
+		c.CRLAuditor.AuditInterval.Duration = time.Hour
+	}
+	if c.CRLAuditor.MaxCRLSize == 0 {
+		c.CRLAuditor.MaxCRLSize = core.DefaultMaxCRLRead
+	}
+
+	saConn, err := bgrpc.ClientSetup(c.CRLAuditor.SAService, tlsConfig, scope, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := sapb.NewStorageAuthorityReadOnlyClient(saConn)
+
+	a, err := auditor.New(
+		issuers,
+		c.CRLAuditor.NumShards,
+		c.CRLAuditor.LookbackPeriod.Duration,
+		c.CRLAuditor.MaxLatency.Duration,
+		c.CRLAuditor.MaxCRLSize,
+		sac,
+		&http.Client{Timeout: time.Minute},
+		scope,
+		logger,
+		clk,
+	)
+	cmd.FailOnError(err, "Failed to create crl-auditor")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go cmd.CatchSignals(cancel)
+
+	if *runOnce {
+		problems, err := a.RunOnce(ctx)
+		cmd.FailOnError(err, "")
+		if problems != 0 {
+			cmd.Fail(fmt.Sprintf("Found %d problematic CRL entries", problems))
+		}
+	} else {
+		err = a.Run(ctx, c.CRLAuditor.AuditInterval.Duration)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			cmd.FailOnError(err, "")
+		}
+	}
+}
+
+func init() {
+	cmd.RegisterCommand("crl-auditor", main, &cmd.ConfigValidator{Config: &Config{}})
+}
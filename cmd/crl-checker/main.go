@@ -15,6 +15,7 @@ import (
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/crl/checker"
+	"github.com/letsencrypt/boulder/linter"
 )
 
 func downloadShard(url string, maxCRLSize int64) (*x509.RevocationList, error) {
@@ -47,6 +48,7 @@ func main() {
 	ageLimitStr := flag.String("ageLimit", "168h", "maximum allowable age of a CRL shard")
 	emitRevoked := flag.Bool("emitRevoked", false, "emit revoked serial numbers on stdout, one per line, hex-encoded")
 	save := flag.Bool("save", false, "save CRLs to files named after the URL")
+	allowDeltas := flag.Bool("allowDeltas", false, "allow delta CRLs and Freshest CRL extensions, which are otherwise lint errors")
 	maxCRLSize := flag.Int64("maxCRLSize", core.DefaultMaxCRLRead, "maximum CRL size. Should match or exceed CRL Storer maxCRLSize")
 	flag.Parse()
 
@@ -75,6 +77,11 @@ func main() {
 	ageLimit, err := time.ParseDuration(*ageLimitStr)
 	cmd.FailOnError(err, "Parsing age limit")
 
+	var skipLints []string
+	if *allowDeltas {
+		skipLints = linter.DeltaCRLLints
+	}
+
 	errCount := 0
 	seenSerials := make(map[string]struct{})
 	totalBytes := 0
@@ -110,7 +117,7 @@ func main() {
 			continue
 		}
 
-		err = checker.Validate(zcrl, issuer, ageLimit)
+		err = checker.Validate(zcrl, issuer, ageLimit, skipLints)
 		if err != nil {
 			errCount += 1
 			logger.Errf("checking CRL %q failed: %s", u, err)
@@ -2,7 +2,9 @@ package notmain
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"os"
 
 	"github.com/jmhodges/clock"
@@ -15,8 +17,28 @@ import (
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
 )
 
+// StorageTarget configures a single location in which CRLs are stored. Exactly
+// one of S3, GCS, and Directory must be set.
+type StorageTarget struct {
+	// Name identifies the target in logs and metrics.
+	Name string `validate:"required"`
+
+	// S3 configures an S3 bucket.
+	S3 *bs3.Config `validate:"required_without_all=GCS Directory,excluded_with=GCS Directory"`
+
+	// GCS configures a Google Cloud Storage bucket, accessed through its
+	// S3-compatible XML API using HMAC credentials. If the S3Endpoint is
+	// omitted, it defaults to GCS's public endpoint.
+	GCS *bs3.Config `validate:"required_without_all=S3 Directory,excluded_with=S3 Directory"`
+
+	// Directory is the path to a local or network-mounted directory, which
+	// must already exist. CRLs are stored at paths matching their S3 keys.
+	Directory string `validate:"required_without_all=S3 GCS,excluded_with=S3 GCS"`
+}
+
 type Config struct {
 	CRLStorer struct {
 		cmd.ServiceConfig
@@ -26,9 +48,14 @@ type Config struct {
 		// them.
 		IssuerCerts []string `validate:"min=1,dive,required"`
 
-		// Storage config. Embedded so the fields can go at the top level.
+		// Storage config. Embedded so the fields can go at the top level. If
+		// S3Bucket is set, this bucket is a storage target named "s3".
 		bs3.Config
 
+		// Targets are additional locations to store every CRL in. A CRL is
+		// only considered stored once it has been stored in every target.
+		Targets []StorageTarget `validate:"omitempty,dive"`
+
 		Features features.Config
 
 		// MaxCRLSize is a count of bytes. Before storing a CRL, the CRLStorer
@@ -45,6 +72,53 @@ type Config struct {
 	OpenTelemetry cmd.OpenTelemetryConfig
 }
 
+// targetsFromConfig constructs the storage targets for the embedded S3 config,
+// if it has a bucket, and for each of the additional targets.
+func targetsFromConfig(s3Config bs3.Config, targetConfigs []StorageTarget, logger blog.Logger) ([]storer.Target, error) {
+	var targets []storer.Target
+	if s3Config.S3Bucket != "" {
+		s3client, err := bs3.FromConfig(s3Config, logger)
+		if err != nil {
+			return nil, fmt.Errorf("initializing S3 client: %w", err)
+		}
+		targets = append(targets, storer.NewS3Target("s3", s3client))
+	}
+
+	for _, tc := range targetConfigs {
+		switch {
+		case tc.S3 != nil:
+			s3client, err := bs3.FromConfig(*tc.S3, logger)
+			if err != nil {
+				return nil, fmt.Errorf("initializing S3 client for %q: %w", tc.Name, err)
+			}
+			targets = append(targets, storer.NewS3Target(tc.Name, s3client))
+		case tc.GCS != nil:
+			gcsConfig := *tc.GCS
+			if gcsConfig.S3Endpoint == "" {
+				gcsConfig.S3Endpoint = storer.DefaultGCSEndpoint
+			}
+			gcsClient, err := bs3.FromConfig(gcsConfig, logger)
+			if err != nil {
+				return nil, fmt.Errorf("initializing GCS client for %q: %w", tc.Name, err)
+			}
+			targets = append(targets, storer.NewGCSTarget(tc.Name, gcsClient))
+		case tc.Directory != "":
+			target, err := storer.NewDirectoryTarget(tc.Name, tc.Directory)
+			if err != nil {
+				return nil, fmt.Errorf("initializing directory target %q: %w", tc.Name, err)
+			}
+			targets = append(targets, target)
+		default:
+			return nil, fmt.Errorf("storage target %q has no S3, GCS, or Directory config", tc.Name)
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, errors.New("no S3Bucket or storage targets configured")
+	}
+	return targets, nil
+}
+
 func main() {
 	grpcAddr := flag.String("addr", "", "gRPC listen address override")
 	debugAddr := flag.String("debug-addr", "", "Debug server address override")
@@ -86,10 +160,10 @@ func main() {
 		issuers = append(issuers, cert)
 	}
 
-	s3client, err := bs3.FromConfig(c.CRLStorer.Config, logger)
-	cmd.FailOnError(err, "Initializing S3 client")
+	targets, err := targetsFromConfig(c.CRLStorer.Config, c.CRLStorer.Targets, logger)
+	cmd.FailOnError(err, "Initializing storage targets")
 
-	csi, err := storer.New(issuers, s3client, c.CRLStorer.MaxCRLSize, scope, logger, clk)
+	csi, err := storer.New(issuers, targets, c.CRLStorer.MaxCRLSize, scope, logger, clk)
 	cmd.FailOnError(err, "Failed to create CRLStorer impl")
 
 	start, err := bgrpc.NewServer(c.CRLStorer.GRPC, logger).Add(
@@ -14,6 +14,7 @@ import (
 	"github.com/letsencrypt/boulder/config"
 	cspb "github.com/letsencrypt/boulder/crl/storer/proto"
 	"github.com/letsencrypt/boulder/crl/updater"
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/issuance"
@@ -31,6 +32,11 @@ type Config struct {
 		CRLGeneratorService *cmd.GRPCClientConfig
 		CRLStorerService    *cmd.GRPCClientConfig
 
+		// GRPC configures the server for the CRLUpdater service, through which
+		// the RA and admin tool can request that specific shards be regenerated
+		// immediately. If nil, no server is started. Ignored in -runOnce mode.
+		GRPC *cmd.GRPCServerConfig
+
 		// IssuerCerts is a list of paths to issuer certificates on disk. This
 		// controls the set of CRLs which will be published by this updater: it will
 		// publish one set of NumShards CRL shards for each issuer in this list.
@@ -64,6 +70,13 @@ type Config struct {
 		// recording a Certificate as revoked."
 		UpdatePeriod config.Duration
 
+		// DeltaPeriod, if non-zero, controls how frequently the crl-updater
+		// publishes a delta CRL for each shard, listing the revocations since the
+		// shard's last full CRL. It must be less than the UpdatePeriod, and is
+		// also the delta CRLs' validity interval. The CA's CRL profile must also
+		// allow delta CRLs. Delta CRLs are not published in -runOnce mode.
+		DeltaPeriod config.Duration `validate:"-"`
+
 		// UpdateTimeout controls how long a single CRL shard is allowed to attempt
 		// to update before being timed out. The total CRL updating process may take
 		// significantly longer, since a full update cycle may consist of updating
@@ -124,6 +137,7 @@ type Config struct {
 
 func main() {
 	configFile := flag.String("config", "", "File path to the configuration file for this service")
+	grpcAddr := flag.String("addr", "", "gRPC listen address override")
 	debugAddr := flag.String("debug-addr", "", "Debug server address override")
 	runOnce := flag.Bool("runOnce", false, "If true, run once immediately and then exit")
 	flag.Parse()
@@ -136,6 +150,9 @@ func main() {
 	err := cmd.ReadConfigFile(*configFile, &c)
 	cmd.FailOnError(err, "Reading JSON config file into config structure")
 
+	if *grpcAddr != "" && c.CRLUpdater.GRPC != nil {
+		c.CRLUpdater.GRPC.Address = *grpcAddr
+	}
 	if *debugAddr != "" {
 		c.CRLUpdater.DebugAddr = *debugAddr
 	}
@@ -185,6 +202,7 @@ func main() {
 		c.CRLUpdater.ShardWidth.Duration,
 		c.CRLUpdater.LookbackPeriod.Duration,
 		c.CRLUpdater.UpdatePeriod.Duration,
+		c.CRLUpdater.DeltaPeriod.Duration,
 		c.CRLUpdater.UpdateTimeout.Duration,
 		c.CRLUpdater.MaxParallelism,
 		c.CRLUpdater.MaxAttempts,
@@ -207,6 +225,19 @@ func main() {
 		if err != nil && !errors.Is(err, context.Canceled) {
 			cmd.FailOnError(err, "")
 		}
+	} else if c.CRLUpdater.GRPC != nil {
+		start, err := bgrpc.NewServer(c.CRLUpdater.GRPC, logger).Add(
+			&cupb.CRLUpdater_ServiceDesc, u).Build(tlsConfig, scope, clk)
+		cmd.FailOnError(err, "Unable to setup CRLUpdater gRPC server")
+
+		go func() {
+			err := u.Run(ctx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				cmd.FailOnError(err, "")
+			}
+		}()
+
+		cmd.FailOnError(start(), "CRLUpdater gRPC service failed")
 	} else {
 		err = u.Run(ctx)
 		if err != nil && !errors.Is(err, context.Canceled) {
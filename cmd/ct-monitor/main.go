@@ -0,0 +1,173 @@
+package notmain
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/ctmonitor"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/issuance"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+type Config struct {
+	CTMonitor struct {
+		DebugAddr string `validate:"omitempty,hostname_port"`
+
+		// TLS client certificate, private key, and trusted root bundle.
+		TLS cmd.TLSConfig
+
+		SAService *cmd.GRPCClientConfig
+
+		// IssuerCerts is a list of paths to issuer certificates on disk. Sampled
+		// certificates must have been issued by one of these issuers.
+		IssuerCerts []string `validate:"min=1,dive,required"`
+
+		// LogListFile is the path to a JSON file containing the CT log list, in
+		// the same format as the RA's. The inclusion of every SCT from a log in
+		// this list is monitored.
+		LogListFile string `validate:"required"`
+
+		// SampleRate is the fraction of newly issued certificates whose SCTs
+		// are monitored, between 0 (exclusive) and 1.
+		SampleRate float64 `validate:"gt=0,lte=1"`
+
+		// BatchSize is the maximum number of issuance events to read from the
+		// SA at once. Defaults to 1000.
+		BatchSize int64 `validate:"omitempty,min=1"`
+
+		// Lookback is how long after newer issuance events an event may become
+		// visible, e.g. because the transaction which recorded it was slow to
+		// commit, and still be read. Defaults to one minute.
+		Lookback config.Duration `validate:"-"`
+
+		// MaxAge is the age beyond which certificates are not sampled, e.g.
+		// when the monitor falls behind the issuance events. Only events
+		// created after the monitor starts are read. Defaults to one hour.
+		MaxAge config.Duration `validate:"-"`
+
+		// AbandonAfter is how long after a log's MMD has elapsed an SCT whose
+		// inclusion still can't be determined, e.g. because the log is
+		// unreachable, is abandoned as unverified. Defaults to 24 hours.
+		AbandonAfter config.Duration `validate:"-"`
+
+		// CheckInterval is how often to sample new certificates and check the
+		// inclusion of pending SCTs. Defaults to ten minutes.
+		CheckInterval config.Duration `validate:"-"`
+
+		// UserAgent is sent with every request to a CT log.
+		UserAgent string
+
+		Features features.Config
+	}
+
+	Syslog        cmd.SyslogConfig
+	OpenTelemetry cmd.OpenTelemetryConfig
+}
+
+func main() {
+	configFile := flag.String("config", "", "File path to the configuration file for this service")
+	debugAddr := flag.String("debug-addr", "", "Debug server address override")
+	runOnce := flag.Bool("runOnce", false, "If true, check once immediately, then exit with an error if any SCT missed its log's MMD")
+	flag.Parse()
+	if *configFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var c Config
+	err := cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+
+	if *debugAddr != "" {
+		c.CTMonitor.DebugAddr = *debugAddr
+	}
+
+	features.Set(c.CTMonitor.Features)
+
+	scope, logger, oTelShutdown := cmd.StatsAndLogging(c.Syslog, c.OpenTelemetry, c.CTMonitor.DebugAddr)
+	defer oTelShutdown(context.Background())
+	cmd.LogStartup(logger)
+	clk := clock.New()
+
+	tlsConfig, err := c.CTMonitor.TLS.Load(scope)
+	cmd.FailOnError(err, "TLS config")
+
+	issuers := make([]*issuance.Certificate, 0, len(c.CTMonitor.IssuerCerts))
+	for _, filepath := range c.CTMonitor.IssuerCerts {
+		cert, err := issuance.LoadCertificate(filepath)
+		cmd.FailOnError(err, "Failed to load issuer cert")
+		issuers = append(issuers, cert)
+	}
+
+	logs, err := loglist.New(c.CTMonitor.LogListFile)
+	cmd.FailOnError(err, "Failed to load log list")
+
+	if c.CTMonitor.BatchSize == 0 {
+		c.CTMonitor.BatchSize = 1000
+	}
+	if c.CTMonitor.Lookback.Duration == 0 {
+		c.CTMonitor.Lookback.Duration = time.Minute
+	}
+	if c.CTMonitor.MaxAge.Duration == 0 {
+		c.CTMonitor.MaxAge.Duration = time.Hour
+	}
+	if c.CTMonitor.AbandonAfter.Duration == 0 {
+		c.CTMonitor.AbandonAfter.Duration = 24 * time.Hour
+	}
+	if c.CTMonitor.CheckInterval.Duration == 0 {
+		c.CTMonitor.CheckInterval.Duration = 10 * time.Minute
+	}
+
+	saConn, err := bgrpc.ClientSetup(c.CTMonitor.SAService, tlsConfig, scope, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := sapb.NewStorageAuthorityReadOnlyClient(saConn)
+
+	m, err := ctmonitor.New(
+		logs,
+		issuers,
+		c.CTMonitor.SampleRate,
+		c.CTMonitor.BatchSize,
+		c.CTMonitor.Lookback.Duration,
+		c.CTMonitor.MaxAge.Duration,
+		c.CTMonitor.AbandonAfter.Duration,
+		sac,
+		&http.Client{Timeout: time.Minute},
+		c.CTMonitor.UserAgent,
+		scope,
+		logger,
+		clk,
+	)
+	cmd.FailOnError(err, "Failed to create ct-monitor")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go cmd.CatchSignals(cancel)
+
+	if *runOnce {
+		missed, err := m.RunOnce(ctx)
+		cmd.FailOnError(err, "")
+		if missed != 0 {
+			cmd.Fail(fmt.Sprintf("Found %d SCTs which missed their log's MMD", missed))
+		}
+	} else {
+		err = m.Run(ctx, c.CTMonitor.CheckInterval.Duration)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			cmd.FailOnError(err, "")
+		}
+	}
+}
+
+func init() {
+	cmd.RegisterCommand("ct-monitor", main, &cmd.ConfigValidator{Config: &Config{}})
+}
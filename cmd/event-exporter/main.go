@@ -0,0 +1,113 @@
+package notmain
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/events"
+	eventspb "github.com/letsencrypt/boulder/events/proto"
+	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// Config holds the configuration for the event-exporter service.
+type Config struct {
+	EventExporter struct {
+		cmd.ServiceConfig
+
+		SAService *cmd.GRPCClientConfig
+
+		// PollInterval is how often each subscriber's stream is checked for
+		// new events, once all existing events have been sent. Defaults to 1s.
+		PollInterval config.Duration `validate:"-"`
+
+		// Lookback is the longest a transaction which records an event is
+		// expected to take to commit, plus the expected replication lag of the
+		// database replicas read by the SA and the clock skew between SAs. Each
+		// poll re-reads the events created within this long of the latest
+		// event sent, so that events which become visible late are not
+		// skipped. Defaults to one minute.
+		Lookback config.Duration `validate:"-"`
+
+		// BatchSize is the maximum number of events fetched from the SA at a
+		// time. Defaults to 1000.
+		BatchSize int64 `validate:"omitempty,min=1"`
+
+		Features features.Config
+	}
+	Syslog        cmd.SyslogConfig
+	OpenTelemetry cmd.OpenTelemetryConfig
+}
+
+func main() {
+	configFile := flag.String("config", "", "Path to configuration file")
+	grpcAddr := flag.String("addr", "", "gRPC listen address override")
+	debugAddr := flag.String("debug-addr", "", "Debug server address override")
+	flag.Parse()
+
+	if *configFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var c Config
+	err := cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+	features.Set(c.EventExporter.Features)
+
+	if *grpcAddr != "" {
+		c.EventExporter.GRPC.Address = *grpcAddr
+	}
+	if *debugAddr != "" {
+		c.EventExporter.DebugAddr = *debugAddr
+	}
+	if c.EventExporter.PollInterval.Duration == 0 {
+		c.EventExporter.PollInterval.Duration = time.Second
+	}
+	if c.EventExporter.Lookback.Duration == 0 {
+		c.EventExporter.Lookback.Duration = time.Minute
+	}
+	if c.EventExporter.BatchSize == 0 {
+		c.EventExporter.BatchSize = 1000
+	}
+
+	scope, logger, oTelShutdown := cmd.StatsAndLogging(c.Syslog, c.OpenTelemetry, c.EventExporter.DebugAddr)
+	defer oTelShutdown(context.Background())
+	cmd.LogStartup(logger)
+
+	clk := clock.New()
+
+	tlsConfig, err := c.EventExporter.TLS.Load(scope)
+	cmd.FailOnError(err, "Loading event-exporter TLS config")
+
+	saConn, err := bgrpc.ClientSetup(c.EventExporter.SAService, tlsConfig, scope, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := sapb.NewStorageAuthorityReadOnlyClient(saConn)
+
+	server := events.NewExporterImpl(
+		sac,
+		c.EventExporter.PollInterval.Duration,
+		c.EventExporter.Lookback.Duration,
+		c.EventExporter.BatchSize,
+		scope,
+		clk,
+		logger,
+	)
+
+	start, err := bgrpc.NewServer(c.EventExporter.GRPC, logger).Add(
+		&eventspb.IssuanceEvents_ServiceDesc, server).Build(tlsConfig, scope, clk)
+	cmd.FailOnError(err, "Configuring event-exporter gRPC server")
+
+	cmd.FailOnError(start(), "event-exporter gRPC service failed")
+}
+
+func init() {
+	cmd.RegisterCommand("event-exporter", main, &cmd.ConfigValidator{Config: &Config{}})
+}
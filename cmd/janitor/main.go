@@ -0,0 +1,436 @@
+package notmain
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/db"
+	"github.com/letsencrypt/boulder/features"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/sa"
+)
+
+// table describes how the janitor finds the expired rows of one table.
+type table struct {
+	// name is the name of the table.
+	name string
+	// expiresColumn is the column holding each row's expiry. A row is deleted
+	// once its expiry is further in the past than the table's retention.
+	expiresColumn string
+	// walkByID is true if the table has an auto-increment "id" primary key,
+	// which roughly follows the order of expiresColumn. Such tables are walked
+	// in order of id, so that no index on expiresColumn is needed. Otherwise
+	// rows are deleted directly by expiresColumn.
+	walkByID bool
+	// minRetention is the shortest retention which may be configured for the
+	// table, to protect rows which are still needed after they expire.
+	minRetention time.Duration
+	// dependents are the tables whose rows, keyed by serial, belong to the
+	// rows of this table and are deleted along with them. Only tables walked
+	// by id, with a serial column, may have dependents.
+	dependents []string
+}
+
+// tables are the tables which the janitor knows how to clean up, by name.
+var tables = map[string]table{
+	"authz2":          {name: "authz2", expiresColumn: "expires", walkByID: true, minRetention: 24 * time.Hour},
+	"orders":          {name: "orders", expiresColumn: "expires", walkByID: true, minRetention: 24 * time.Hour},
+	"orderFqdnSets":   {name: "orderFqdnSets", expiresColumn: "expires", walkByID: true, minRetention: 24 * time.Hour},
+	"fqdnSets":        {name: "fqdnSets", expiresColumn: "expires", walkByID: true, minRetention: 24 * time.Hour},
+	"keyHashToSerial": {name: "keyHashToSerial", expiresColumn: "certNotAfter", walkByID: true, minRetention: 24 * time.Hour},
+	// Unpaused identifiers are only kept for investigations. Deleting them by
+	// unpausedAt relies on the unpausedAt_idx index.
+	"paused":            {name: "paused", expiresColumn: "unpausedAt", walkByID: false, minRetention: 24 * time.Hour},
+	"replacementOrders": {name: "replacementOrders", expiresColumn: "orderExpires", walkByID: true, minRetention: 24 * time.Hour},
+	// issuedNames has no expiry column, so its rows are retained relative to
+	// the notBefore of their certificate. The SA assumes that rows for every
+	// unexpired certificate exist, so they must be retained for at least the
+	// maximum validity of a publicly-trusted certificate.
+	"issuedNames": {name: "issuedNames", expiresColumn: "notBefore", walkByID: true, minRetention: 398 * 24 * time.Hour},
+	// Certificates and their revocation entries are useful for investigations
+	// long after they expire, and revokedCertificates rows are read by the
+	// crl-updater for some time after expiry. The status, precertificate, and
+	// serial of each certificate are deleted along with it. Those of a serial
+	// which never got a final certificate are not deleted.
+	"certificates":        {name: "certificates", expiresColumn: "expires", walkByID: true, minRetention: 30 * 24 * time.Hour, dependents: []string{"certificateStatus", "precertificates", "serials"}},
+	"revokedCertificates": {name: "revokedCertificates", expiresColumn: "notAfterHour", walkByID: true, minRetention: 30 * 24 * time.Hour},
+	// issuanceEvents rows are retained relative to their creation, for as long
+	// as a subscriber of the event-exporter may take to resume. Their IDs
+	// don't follow creation, so they're deleted by creation time.
+	"issuanceEvents": {name: "issuanceEvents", expiresColumn: "created", walkByID: false, minRetention: 24 * time.Hour},
+}
+
+// janitor deletes expired rows from the SA database in bounded batches.
+//
+// Each batch only deletes rows whose expiry is already further in the past
+// than the table's retention, and re-checks that condition at deletion time.
+// The SA never reads such rows, so the janitor is safe to run concurrently
+// with the SA, and with other instances of itself.
+type janitor struct {
+	dbMap      db.SelectExecer
+	clk        clock.Clock
+	log        blog.Logger
+	retention  map[string]time.Duration
+	batchSize  int
+	batchDelay time.Duration
+
+	rowsDeleted   *prometheus.CounterVec
+	passErrors    *prometheus.CounterVec
+	lastCompleted *prometheus.GaugeVec
+}
+
+func newJanitor(
+	dbMap db.SelectExecer,
+	retention map[string]time.Duration,
+	batchSize int,
+	batchDelay time.Duration,
+	stats prometheus.Registerer,
+	logger blog.Logger,
+	clk clock.Clock,
+) (*janitor, error) {
+	if len(retention) == 0 {
+		return nil, fmt.Errorf("no tables configured")
+	}
+	for name, r := range retention {
+		t, ok := tables[name]
+		if !ok {
+			return nil, fmt.Errorf("unrecognized table %q", name)
+		}
+		if r < t.minRetention {
+			return nil, fmt.Errorf("retention for table %q must be at least %s, got %s", name, t.minRetention, r)
+		}
+	}
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("batch size must be positive, got %d", batchSize)
+	}
+
+	rowsDeleted := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "janitor_rows_deleted",
+		Help: "A counter of expired rows deleted, labelled by table",
+	}, []string{"table"})
+	passErrors := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "janitor_errors",
+		Help: "A counter of failed passes over a table, labelled by table",
+	}, []string{"table"})
+	lastCompleted := promauto.With(stats).NewGaugeVec(prometheus.GaugeOpts{
+		Name: "janitor_last_pass_completed_seconds",
+		Help: "The Unix time at which the last successful pass over a table completed, labelled by table",
+	}, []string{"table"})
+
+	return &janitor{
+		dbMap:         dbMap,
+		clk:           clk,
+		log:           logger,
+		retention:     retention,
+		batchSize:     batchSize,
+		batchDelay:    batchDelay,
+		rowsDeleted:   rowsDeleted,
+		passErrors:    passErrors,
+		lastCompleted: lastCompleted,
+	}, nil
+}
+
+// expiringRow is a single row of a table walked by id. Serial is only read
+// for tables with dependents.
+type expiringRow struct {
+	ID      int64
+	Expires time.Time
+	Serial  string
+}
+
+// cleanTable makes a single pass over the given table, deleting all of its
+// rows which have been expired for longer than its retention. It returns the
+// number of rows deleted.
+//
+// Tables walked by id are read in batches in order of id, deleting the
+// expired rows of each batch, until a batch without any expired rows is
+// found. Since ids roughly follow expiry, this visits nearly every expired
+// row while reading only a batch of unexpired ones. Any expired rows beyond
+// that batch are deleted by a later pass.
+//
+// The dependent rows of each batch are deleted before the batch itself, so
+// that if they can't be deleted, the batch is retried by a later pass rather
+// than leaving them orphaned.
+func (j *janitor) cleanTable(ctx context.Context, t table) (int64, error) {
+	cutoff := j.clk.Now().Add(-j.retention[t.name])
+	if !t.walkByID {
+		return j.cleanTableByExpiry(ctx, t, cutoff)
+	}
+
+	columns := "id, " + t.expiresColumn + " AS expires"
+	if len(t.dependents) > 0 {
+		columns += ", serial"
+	}
+
+	var total int64
+	var startID int64
+	for {
+		var rows []expiringRow
+		_, err := j.dbMap.Select(
+			ctx,
+			&rows,
+			fmt.Sprintf(
+				`SELECT %s
+				FROM %s
+				WHERE id > ?
+				ORDER BY id
+				LIMIT ?`,
+				columns, t.name),
+			startID,
+			j.batchSize,
+		)
+		if err != nil {
+			return total, fmt.Errorf("selecting rows from %s: %w", t.name, err)
+		}
+
+		var expired []any
+		var serials []any
+		for _, row := range rows {
+			if !row.Expires.After(cutoff) {
+				expired = append(expired, row.ID)
+				serials = append(serials, row.Serial)
+			}
+		}
+		if len(expired) == 0 {
+			return total, nil
+		}
+
+		for _, dependent := range t.dependents {
+			err = j.deleteDependents(ctx, dependent, serials)
+			if err != nil {
+				return total, err
+			}
+		}
+
+		// Re-check the expiry, in case the row was updated since it was read.
+		res, err := j.dbMap.ExecContext(
+			ctx,
+			fmt.Sprintf(
+				`DELETE FROM %s
+				WHERE id IN (%s)
+				AND %s <= ?`,
+				t.name, db.QuestionMarks(len(expired)), t.expiresColumn),
+			append(expired, cutoff)...,
+		)
+		if err != nil {
+			return total, fmt.Errorf("deleting rows from %s: %w", t.name, err)
+		}
+		deleted, err := res.RowsAffected()
+		if err != nil {
+			return total, fmt.Errorf("deleting rows from %s: %w", t.name, err)
+		}
+		total += deleted
+		j.rowsDeleted.WithLabelValues(t.name).Add(float64(deleted))
+
+		startID = rows[len(rows)-1].ID
+		err = j.pause(ctx)
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// deleteDependents deletes the rows of the given dependent table with the
+// given serials.
+func (j *janitor) deleteDependents(ctx context.Context, dependent string, serials []any) error {
+	res, err := j.dbMap.ExecContext(
+		ctx,
+		fmt.Sprintf(
+			`DELETE FROM %s
+			WHERE serial IN (%s)`,
+			dependent, db.QuestionMarks(len(serials))),
+		serials...,
+	)
+	if err != nil {
+		return fmt.Errorf("deleting rows from %s: %w", dependent, err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("deleting rows from %s: %w", dependent, err)
+	}
+	j.rowsDeleted.WithLabelValues(dependent).Add(float64(deleted))
+	return nil
+}
+
+// cleanTableByExpiry deletes rows from a table which isn't walked by id, in
+// batches, until a batch deletes fewer than batchSize rows.
+func (j *janitor) cleanTableByExpiry(ctx context.Context, t table, cutoff time.Time) (int64, error) {
+	var total int64
+	for {
+		res, err := j.dbMap.ExecContext(
+			ctx,
+			fmt.Sprintf(
+				`DELETE FROM %s
+				WHERE %s IS NOT NULL
+				AND %s <= ?
+				LIMIT ?`,
+				t.name, t.expiresColumn, t.expiresColumn),
+			cutoff,
+			j.batchSize,
+		)
+		if err != nil {
+			return total, fmt.Errorf("deleting rows from %s: %w", t.name, err)
+		}
+		deleted, err := res.RowsAffected()
+		if err != nil {
+			return total, fmt.Errorf("deleting rows from %s: %w", t.name, err)
+		}
+		total += deleted
+		j.rowsDeleted.WithLabelValues(t.name).Add(float64(deleted))
+
+		if deleted < int64(j.batchSize) {
+			return total, nil
+		}
+		err = j.pause(ctx)
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// pause waits batchDelay between batches, to limit the load on the database.
+func (j *janitor) pause(ctx context.Context) error {
+	if j.batchDelay <= 0 {
+		return ctx.Err()
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-j.clk.After(j.batchDelay):
+		return nil
+	}
+}
+
+// runTable cleans the given table every interval until the context is
+// canceled.
+func (j *janitor) runTable(ctx context.Context, t table, interval time.Duration) {
+	for {
+		start := j.clk.Now()
+		deleted, err := j.cleanTable(ctx, t)
+		if err != nil && ctx.Err() == nil {
+			j.passErrors.WithLabelValues(t.name).Inc()
+			j.log.Errf("cleaning table %s after deleting %d rows: %s", t.name, deleted, err)
+		} else if err == nil {
+			j.lastCompleted.WithLabelValues(t.name).Set(float64(j.clk.Now().Unix()))
+			j.log.Infof("cleaned table %s: deleted %d rows in %s", t.name, deleted, j.clk.Since(start))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-j.clk.After(interval):
+		}
+	}
+}
+
+// run cleans each configured table concurrently, every interval, until the
+// context is canceled.
+func (j *janitor) run(ctx context.Context, interval time.Duration) {
+	var wg sync.WaitGroup
+	for _, name := range slices.Sorted(maps.Keys(j.retention)) {
+		wg.Go(func() {
+			j.runTable(ctx, tables[name], interval)
+		})
+	}
+	wg.Wait()
+}
+
+type Config struct {
+	Janitor struct {
+		DB        cmd.DBConfig
+		DebugAddr string `validate:"omitempty,hostname_port"`
+
+		// Tables maps the name of each table to clean up to its retention: how
+		// long after a row's expiry it is deleted. Tables which aren't listed
+		// are never cleaned up. Rows of issuedNames are retained relative to
+		// their notBefore, and rows of issuanceEvents relative to their
+		// creation, rather than an expiry. Cleaning up certificates also
+		// deletes their certificateStatus, precertificates, and serials rows;
+		// those rows are never deleted for a serial which has no certificate.
+		// Cleaning up paused requires the unpausedAt_idx index.
+		Tables map[string]config.Duration `validate:"required,min=1,dive,keys,oneof=authz2 orders orderFqdnSets fqdnSets keyHashToSerial paused replacementOrders issuedNames certificates revokedCertificates issuanceEvents,endkeys"`
+
+		// Interval is how often to clean up each table. Defaults to one hour.
+		Interval config.Duration `validate:"-"`
+
+		// BatchSize is the maximum number of rows read or deleted by a single
+		// query. Defaults to 1000.
+		BatchSize int `validate:"omitempty,min=1"`
+
+		// BatchDelay is how long to wait between batches, to limit the load on
+		// the database. Defaults to no delay.
+		BatchDelay config.Duration `validate:"-"`
+
+		Features features.Config
+	}
+
+	Syslog        cmd.SyslogConfig
+	OpenTelemetry cmd.OpenTelemetryConfig
+}
+
+func main() {
+	debugAddr := flag.String("debug-addr", "", "Debug server address override")
+	configPath := flag.String("config", "", "File path to the configuration file for this service")
+	flag.Parse()
+
+	if *configPath == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+	var c Config
+	err := cmd.ReadConfigFile(*configPath, &c)
+	cmd.FailOnError(err, "Failed reading config file")
+
+	features.Set(c.Janitor.Features)
+
+	if *debugAddr != "" {
+		c.Janitor.DebugAddr = *debugAddr
+	}
+
+	stats, logger, oTelShutdown := cmd.StatsAndLogging(c.Syslog, c.OpenTelemetry, c.Janitor.DebugAddr)
+	defer oTelShutdown(context.Background())
+	cmd.LogStartup(logger)
+	clk := clock.New()
+
+	if c.Janitor.Interval.Duration == 0 {
+		c.Janitor.Interval.Duration = time.Hour
+	}
+	if c.Janitor.BatchSize == 0 {
+		c.Janitor.BatchSize = 1000
+	}
+
+	retention := make(map[string]time.Duration, len(c.Janitor.Tables))
+	for name, r := range c.Janitor.Tables {
+		retention[name] = r.Duration
+	}
+
+	dbMap, err := sa.InitWrappedDb(c.Janitor.DB, stats, logger)
+	cmd.FailOnError(err, "While initializing dbMap")
+
+	j, err := newJanitor(dbMap, retention, c.Janitor.BatchSize, c.Janitor.BatchDelay.Duration, stats, logger, clk)
+	cmd.FailOnError(err, "Failed to create janitor")
+
+	logger.Infof("Cleaning up tables: %s", strings.Join(slices.Sorted(maps.Keys(retention)), ", "))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go cmd.CatchSignals(cancel)
+
+	j.run(ctx, c.Janitor.Interval.Duration)
+}
+
+func init() {
+	cmd.RegisterCommand("janitor", main, &cmd.ConfigValidator{Config: &Config{}})
+}
@@ -0,0 +1,252 @@
+package notmain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeTable is an in-memory table which understands the queries issued by the
+// janitor. Rows are kept in order of id. Deletions from dependent tables are
+// recorded in dependentDeletes, by table.
+type fakeTable struct {
+	rows             []expiringRow
+	selects          int
+	deletes          int
+	dependentDeletes map[string][]string
+}
+
+func (ft *fakeTable) Select(_ context.Context, holder any, query string, args ...any) ([]any, error) {
+	if !strings.HasPrefix(query, "SELECT id,") {
+		return nil, errors.New("unexpected query")
+	}
+	ft.selects++
+	startID := args[0].(int64)
+	limit := args[1].(int)
+	out := holder.(*[]expiringRow)
+	for _, row := range ft.rows {
+		if row.ID > startID && len(*out) < limit {
+			*out = append(*out, row)
+		}
+	}
+	return nil, nil
+}
+
+type fakeResult struct {
+	sql.Result
+	affected int64
+}
+
+func (r fakeResult) RowsAffected() (int64, error) {
+	return r.affected, nil
+}
+
+func (ft *fakeTable) ExecContext(_ context.Context, query string, args ...any) (sql.Result, error) {
+	if !strings.HasPrefix(query, "DELETE FROM") {
+		return nil, errors.New("unexpected query")
+	}
+	if strings.Contains(query, "serial IN") {
+		if ft.dependentDeletes == nil {
+			ft.dependentDeletes = make(map[string][]string)
+		}
+		dependent := strings.Fields(query)[2]
+		for _, arg := range args {
+			ft.dependentDeletes[dependent] = append(ft.dependentDeletes[dependent], arg.(string))
+		}
+		return fakeResult{affected: int64(len(args))}, nil
+	}
+	ft.deletes++
+	var ids []int64
+	var cutoff time.Time
+	limit := len(ft.rows)
+	if strings.Contains(query, "id IN") {
+		for _, arg := range args[:len(args)-1] {
+			ids = append(ids, arg.(int64))
+		}
+		cutoff = args[len(args)-1].(time.Time)
+	} else {
+		cutoff = args[0].(time.Time)
+		limit = args[1].(int)
+	}
+
+	var deleted int64
+	ft.rows = slices.DeleteFunc(ft.rows, func(row expiringRow) bool {
+		if ids != nil && !slices.Contains(ids, row.ID) {
+			return false
+		}
+		if row.Expires.After(cutoff) || deleted >= int64(limit) {
+			return false
+		}
+		deleted++
+		return true
+	})
+	return fakeResult{affected: deleted}, nil
+}
+
+func (ft *fakeTable) ids() []int64 {
+	var ids []int64
+	for _, row := range ft.rows {
+		ids = append(ids, row.ID)
+	}
+	return ids
+}
+
+func TestNewJanitor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		retention map[string]time.Duration
+		batchSize int
+		wantErr   string
+	}{
+		{
+			name:      "valid",
+			retention: map[string]time.Duration{"authz2": 24 * time.Hour, "issuedNames": 400 * 24 * time.Hour},
+			batchSize: 100,
+		},
+		{
+			name:      "no tables",
+			retention: map[string]time.Duration{},
+			batchSize: 100,
+			wantErr:   "no tables configured",
+		},
+		{
+			name:      "unknown table",
+			retention: map[string]time.Duration{"registrations": 24 * time.Hour},
+			batchSize: 100,
+			wantErr:   "unrecognized table",
+		},
+		{
+			name:      "retention too short",
+			retention: map[string]time.Duration{"issuedNames": 90 * 24 * time.Hour},
+			batchSize: 100,
+			wantErr:   "must be at least",
+		},
+		{
+			name:      "bad batch size",
+			retention: map[string]time.Duration{"authz2": 24 * time.Hour},
+			batchSize: 0,
+			wantErr:   "batch size must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := newJanitor(&fakeTable{}, tc.retention, tc.batchSize, 0, metrics.NoopRegisterer, blog.NewMock(), clock.NewFake())
+			if tc.wantErr == "" {
+				test.AssertNotError(t, err, "creating janitor")
+			} else {
+				test.AssertError(t, err, "creating janitor should fail")
+				test.AssertContains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCleanTableByID(t *testing.T) {
+	t.Parallel()
+	fc := clock.NewFake()
+	fc.Set(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	cutoff := fc.Now().Add(-24 * time.Hour)
+
+	// Rows 1 through 7 expired before the cutoff, except for row 4, whose
+	// expiry was extended. Rows 8 through 15 expire after the cutoff, except
+	// for row 14, which is out of order.
+	ft := &fakeTable{}
+	for id := int64(1); id <= 15; id++ {
+		expires := cutoff.Add(-time.Hour)
+		if id == 4 || (id >= 8 && id != 14) {
+			expires = cutoff.Add(time.Hour)
+		}
+		ft.rows = append(ft.rows, expiringRow{ID: id, Expires: expires})
+	}
+
+	j, err := newJanitor(ft, map[string]time.Duration{"authz2": 24 * time.Hour}, 3, 0, metrics.NoopRegisterer, blog.NewMock(), fc)
+	test.AssertNotError(t, err, "creating janitor")
+
+	deleted, err := j.cleanTable(context.Background(), tables["authz2"])
+	test.AssertNotError(t, err, "cleaning table")
+	test.AssertEquals(t, deleted, int64(6))
+	// The walk stops at the first batch without expired rows, so row 14 is
+	// left for a later pass.
+	test.AssertDeepEquals(t, ft.ids(), []int64{4, 8, 9, 10, 11, 12, 13, 14, 15})
+	test.AssertEquals(t, ft.selects, 4)
+	test.AssertMetricWithLabelsEquals(t, j.rowsDeleted, map[string]string{"table": "authz2"}, 6)
+
+	// Once the rows before it have expired too, row 14 is reached.
+	fc.Add(2 * time.Hour)
+	deleted, err = j.cleanTable(context.Background(), tables["authz2"])
+	test.AssertNotError(t, err, "cleaning table")
+	test.AssertEquals(t, deleted, int64(9))
+	test.AssertEquals(t, len(ft.rows), 0)
+}
+
+func TestCleanTableWithDependents(t *testing.T) {
+	t.Parallel()
+	fc := clock.NewFake()
+	fc.Set(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	retention := 30 * 24 * time.Hour
+	cutoff := fc.Now().Add(-retention)
+
+	// Certificates 1 and 2 expired before the cutoff, and 3 after it.
+	ft := &fakeTable{}
+	for id := int64(1); id <= 3; id++ {
+		expires := cutoff.Add(-time.Hour)
+		if id == 3 {
+			expires = cutoff.Add(time.Hour)
+		}
+		ft.rows = append(ft.rows, expiringRow{ID: id, Expires: expires, Serial: fmt.Sprintf("serial%d", id)})
+	}
+
+	j, err := newJanitor(ft, map[string]time.Duration{"certificates": retention}, 10, 0, metrics.NoopRegisterer, blog.NewMock(), fc)
+	test.AssertNotError(t, err, "creating janitor")
+
+	deleted, err := j.cleanTable(context.Background(), tables["certificates"])
+	test.AssertNotError(t, err, "cleaning table")
+	test.AssertEquals(t, deleted, int64(2))
+	test.AssertDeepEquals(t, ft.ids(), []int64{3})
+	for _, dependent := range []string{"certificateStatus", "precertificates", "serials"} {
+		test.AssertDeepEquals(t, ft.dependentDeletes[dependent], []string{"serial1", "serial2"})
+		test.AssertMetricWithLabelsEquals(t, j.rowsDeleted, map[string]string{"table": dependent}, 2)
+	}
+}
+
+func TestCleanTableByExpiry(t *testing.T) {
+	t.Parallel()
+	fc := clock.NewFake()
+	fc.Set(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	cutoff := fc.Now().Add(-24 * time.Hour)
+
+	ft := &fakeTable{}
+	for id := int64(1); id <= 7; id++ {
+		expires := cutoff.Add(-time.Hour)
+		if id == 7 {
+			expires = cutoff.Add(time.Hour)
+		}
+		ft.rows = append(ft.rows, expiringRow{ID: id, Expires: expires})
+	}
+
+	j, err := newJanitor(ft, map[string]time.Duration{"paused": 24 * time.Hour}, 2, 0, metrics.NoopRegisterer, blog.NewMock(), fc)
+	test.AssertNotError(t, err, "creating janitor")
+
+	deleted, err := j.cleanTable(context.Background(), tables["paused"])
+	test.AssertNotError(t, err, "cleaning table")
+	test.AssertEquals(t, deleted, int64(6))
+	test.AssertDeepEquals(t, ft.ids(), []int64{7})
+	// Three full batches, then an empty one.
+	test.AssertEquals(t, ft.deletes, 4)
+	test.AssertEquals(t, ft.selects, 0)
+}
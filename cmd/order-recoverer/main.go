@@ -0,0 +1,451 @@
+package notmain
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/identifier"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/probs"
+	"github.com/letsencrypt/boulder/sa"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// orderStorage is the subset of the SA's gRPC API used by the order-recoverer,
+// which makes testing significantly simpler.
+type orderStorage interface {
+	GetOrder(ctx context.Context, in *sapb.OrderRequest, opts ...grpc.CallOption) (*corepb.Order, error)
+	GetCertificate(ctx context.Context, in *sapb.Serial, opts ...grpc.CallOption) (*corepb.Certificate, error)
+	GetLintPrecertificate(ctx context.Context, in *sapb.Serial, opts ...grpc.CallOption) (*corepb.Certificate, error)
+	FinalizeOrder(ctx context.Context, in *sapb.FinalizeOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetOrderError(ctx context.Context, in *sapb.SetOrderErrorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+// orderRecoverer finds orders which have been left in the processing state,
+// e.g. because the RA performing their asynchronous finalization died, and
+// either completes or fails them.
+//
+// The orders table doesn't record when an order began processing, so an order
+// is only considered stuck once it has been observed processing by two passes
+// at least stuckThreshold apart. This means that, after a restart, the
+// order-recoverer waits at least stuckThreshold before acting on any order.
+type orderRecoverer struct {
+	dbMap          db.Selector
+	sa             orderStorage
+	logger         blog.Logger
+	clk            clock.Clock
+	stuckThreshold time.Duration
+	maxOrderAge    time.Duration
+	batchSize      int
+
+	// firstSeen maps the IDs of orders observed to be processing to the time
+	// at which they were first observed processing.
+	firstSeen map[int64]time.Time
+
+	ordersWatched   prometheus.Gauge
+	ordersRecovered *prometheus.CounterVec
+}
+
+func newOrderRecoverer(
+	dbMap db.Selector,
+	sac orderStorage,
+	stuckThreshold time.Duration,
+	maxOrderAge time.Duration,
+	batchSize int,
+	stats prometheus.Registerer,
+	logger blog.Logger,
+	clk clock.Clock,
+) *orderRecoverer {
+	ordersWatched := promauto.With(stats).NewGauge(prometheus.GaugeOpts{
+		Name: "order_recoverer_orders_watched",
+		Help: "A gauge of processing orders which have been observed but not yet acted upon",
+	})
+	ordersRecovered := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "order_recoverer_orders_recovered",
+		Help: "A counter of stuck orders acted upon, labelled by result=[completed|failed|resolved|error]",
+	}, []string{"result"})
+
+	return &orderRecoverer{
+		dbMap:           dbMap,
+		sa:              sac,
+		logger:          logger,
+		clk:             clk,
+		stuckThreshold:  stuckThreshold,
+		maxOrderAge:     maxOrderAge,
+		batchSize:       batchSize,
+		firstSeen:       make(map[int64]time.Time),
+		ordersWatched:   ordersWatched,
+		ordersRecovered: ordersRecovered,
+	}
+}
+
+// findProcessingOrders returns the IDs of unexpired orders which have begun
+// processing but have neither a certificate serial nor an error. Orders
+// created less than stuckThreshold ago can't have been processing for that
+// long, so they are excluded.
+func (or *orderRecoverer) findProcessingOrders(ctx context.Context) ([]int64, error) {
+	now := or.clk.Now()
+	var rows []struct {
+		ID int64
+	}
+	_, err := or.dbMap.Select(
+		ctx,
+		&rows,
+		`SELECT id
+		FROM orders
+		WHERE beganProcessing = true
+		AND error IS NULL
+		AND (certificateSerial IS NULL OR certificateSerial = '')
+		AND created >= ?
+		AND created < ?
+		AND expires > ?
+		ORDER BY id
+		LIMIT ?`,
+		now.Add(-or.maxOrderAge),
+		now.Add(-or.stuckThreshold),
+		now,
+		or.batchSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("selecting processing orders: %w", err)
+	}
+
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	return ids, nil
+}
+
+// certMatchesOrder returns true if the given DER-encoded certificate contains
+// exactly the identifiers requested by the given order.
+func certMatchesOrder(der []byte, order *corepb.Order) bool {
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return false
+	}
+	return slices.Equal(
+		identifier.Normalize(identifier.FromCert(cert)),
+		identifier.Normalize(identifier.FromProtoSlice(order.Identifiers)),
+	)
+}
+
+// maxIssuanceCandidates bounds the number of certificates findIssuance
+// examines for each order. Rate limits bound how often any one name can be
+// issued for, so an order's certificate is among the most recent issued for
+// each of its identifiers.
+const maxIssuanceCandidates = 100
+
+// findIssuance looks for a certificate, or failing that a precertificate,
+// which was issued to the given order's account after the order was created
+// and which contains exactly the order's identifiers. It returns the serial of
+// the certificate if one was found, otherwise the serial of the
+// precertificate if one was found.
+//
+// Candidates are found by the order's first identifier, which every matching
+// certificate contains, rather than by account, which may have issued any
+// number of certificates since the order was created.
+func (or *orderRecoverer) findIssuance(ctx context.Context, order *corepb.Order) (string, string, error) {
+	idents := identifier.Normalize(identifier.FromProtoSlice(order.Identifiers))
+	if len(idents) == 0 {
+		return "", "", fmt.Errorf("order %d has no identifiers", order.Id)
+	}
+
+	var rows []struct {
+		Serial string
+	}
+	_, err := or.dbMap.Select(
+		ctx,
+		&rows,
+		`SELECT serial
+		FROM issuedNames
+		WHERE reversedName = ?
+		AND notBefore >= ?
+		ORDER BY notBefore DESC
+		LIMIT ?`,
+		sa.EncodeIssuedName(idents[0].Value),
+		// issuedNames truncates notBefore to the day, and certificates are
+		// backdated, so allow an extra day before the order was created.
+		order.Created.AsTime().Truncate(24*time.Hour).Add(-24*time.Hour),
+		maxIssuanceCandidates,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("selecting serials issued for %q: %w", idents[0].Value, err)
+	}
+
+	var precertSerial string
+	for _, row := range rows {
+		cert, err := or.sa.GetCertificate(ctx, &sapb.Serial{Serial: row.Serial})
+		if err == nil {
+			if cert.RegistrationID == order.RegistrationID && certMatchesOrder(cert.Der, order) {
+				return row.Serial, "", nil
+			}
+			continue
+		}
+		if !errors.Is(err, berrors.NotFound) {
+			return "", "", fmt.Errorf("getting certificate %s: %w", row.Serial, err)
+		}
+
+		if precertSerial != "" {
+			continue
+		}
+		precert, err := or.sa.GetLintPrecertificate(ctx, &sapb.Serial{Serial: row.Serial})
+		if err == nil {
+			if precert.RegistrationID == order.RegistrationID && certMatchesOrder(precert.Der, order) {
+				precertSerial = row.Serial
+			}
+			continue
+		}
+		if !errors.Is(err, berrors.NotFound) {
+			return "", "", fmt.Errorf("getting precertificate %s: %w", row.Serial, err)
+		}
+	}
+	return "", precertSerial, nil
+}
+
+// recoveryEvent is logged to the audit log for each stuck order which the
+// order-recoverer completes or fails.
+type recoveryEvent struct {
+	OrderID   int64
+	Requester int64
+	Created   time.Time
+	// Result is "completed" if a matching certificate was found and the order
+	// was completed with it, or "failed" if the order was failed.
+	Result string
+	// CertificateSerial is the serial of the certificate the order was
+	// completed with.
+	CertificateSerial string `json:",omitempty"`
+	// PrecertificateSerial is the serial of a precertificate which was issued
+	// for a failed order without a corresponding final certificate.
+	PrecertificateSerial string `json:",omitempty"`
+}
+
+// recoverOrder completes the given stuck order if a matching certificate was
+// issued for it, and otherwise fails it. It returns a result suitable for use
+// as a metric label.
+func (or *orderRecoverer) recoverOrder(ctx context.Context, id int64) (string, error) {
+	order, err := or.sa.GetOrder(ctx, &sapb.OrderRequest{Id: id})
+	if err != nil {
+		return "", fmt.Errorf("getting order: %w", err)
+	}
+	if core.IsAnyNilOrZero(order.Id, order.RegistrationID, order.Identifiers, order.Created, order.Status) {
+		return "", errors.New("incomplete order returned by SA")
+	}
+	if order.Status != string(core.StatusProcessing) {
+		// The order was completed or failed since the last pass.
+		return "resolved", nil
+	}
+
+	certSerial, precertSerial, err := or.findIssuance(ctx, order)
+	if err != nil {
+		return "", err
+	}
+
+	event := recoveryEvent{
+		OrderID:              order.Id,
+		Requester:            order.RegistrationID,
+		Created:              order.Created.AsTime(),
+		CertificateSerial:    certSerial,
+		PrecertificateSerial: precertSerial,
+	}
+
+	if certSerial != "" {
+		_, err = or.sa.FinalizeOrder(ctx, &sapb.FinalizeOrderRequest{
+			Id:                order.Id,
+			CertificateSerial: certSerial,
+		})
+		if err != nil {
+			return "", fmt.Errorf("completing order with certificate %s: %w", certSerial, err)
+		}
+		event.Result = "completed"
+		or.logger.AuditInfo("Recovered stuck order", event)
+		return event.Result, nil
+	}
+
+	_, err = or.sa.SetOrderError(ctx, &sapb.SetOrderErrorRequest{
+		Id: order.Id,
+		Error: bgrpc.ProblemDetailsToPB(
+			probs.ServerInternal("Error finalizing order: issuance did not complete, please create a new order"),
+		),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failing order: %w", err)
+	}
+	event.Result = "failed"
+	or.logger.AuditInfo("Recovered stuck order", event)
+	return event.Result, nil
+}
+
+// recoverOnce performs a single pass, recording any newly observed processing
+// orders and recovering any which have been processing for at least
+// stuckThreshold.
+func (or *orderRecoverer) recoverOnce(ctx context.Context) error {
+	ids, err := or.findProcessingOrders(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := or.clk.Now()
+	stillWatched := make(map[int64]time.Time, len(ids))
+	for _, id := range ids {
+		firstSeen, ok := or.firstSeen[id]
+		if !ok {
+			stillWatched[id] = now
+			continue
+		}
+		if now.Sub(firstSeen) < or.stuckThreshold {
+			stillWatched[id] = firstSeen
+			continue
+		}
+
+		result, err := or.recoverOrder(ctx, id)
+		if err != nil {
+			or.ordersRecovered.WithLabelValues("error").Inc()
+			or.logger.AuditErr("Recovering stuck order", err, map[string]any{
+				"order": id,
+			})
+			// Try again on the next pass.
+			stillWatched[id] = firstSeen
+			continue
+		}
+		or.ordersRecovered.WithLabelValues(result).Inc()
+	}
+
+	or.firstSeen = stillWatched
+	or.ordersWatched.Set(float64(len(stillWatched)))
+	return nil
+}
+
+// run calls recoverOnce every interval until the context is canceled.
+func (or *orderRecoverer) run(ctx context.Context, interval time.Duration) {
+	for {
+		err := or.recoverOnce(ctx)
+		if err != nil {
+			or.logger.Errf("finding stuck orders: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-or.clk.After(interval):
+		}
+	}
+}
+
+type Config struct {
+	OrderRecoverer struct {
+		DB        cmd.DBConfig
+		DebugAddr string `validate:"omitempty,hostname_port"`
+
+		// TLS client certificate, private key, and trusted root bundle.
+		TLS cmd.TLSConfig
+
+		SAService *cmd.GRPCClientConfig
+
+		// StuckThreshold is how long an order must have been observed in the
+		// processing state before it is considered stuck. This MUST be greater
+		// than the RA's finalizeTimeout, so that orders whose issuance is still
+		// in progress are not acted upon.
+		StuckThreshold config.Duration `validate:"required"`
+
+		// MaxOrderAge is how long after their creation orders are considered
+		// for recovery. This should be at least the longest orderLifetime of
+		// any of the RA's validation profiles.
+		MaxOrderAge config.Duration `validate:"required"`
+
+		// Interval is how often to look for stuck orders. Defaults to one
+		// minute.
+		Interval config.Duration `validate:"-"`
+
+		// BatchSize is the maximum number of processing orders considered on
+		// each pass. Defaults to 1000.
+		BatchSize int `validate:"omitempty,min=1"`
+
+		Features features.Config
+	}
+
+	Syslog        cmd.SyslogConfig
+	OpenTelemetry cmd.OpenTelemetryConfig
+}
+
+func main() {
+	debugAddr := flag.String("debug-addr", "", "Debug server address override")
+	configPath := flag.String("config", "", "File path to the configuration file for this service")
+	flag.Parse()
+
+	if *configPath == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+	var c Config
+	err := cmd.ReadConfigFile(*configPath, &c)
+	cmd.FailOnError(err, "Failed reading config file")
+
+	features.Set(c.OrderRecoverer.Features)
+
+	if *debugAddr != "" {
+		c.OrderRecoverer.DebugAddr = *debugAddr
+	}
+
+	stats, logger, oTelShutdown := cmd.StatsAndLogging(c.Syslog, c.OpenTelemetry, c.OrderRecoverer.DebugAddr)
+	defer oTelShutdown(context.Background())
+	cmd.LogStartup(logger)
+	clk := clock.New()
+
+	if c.OrderRecoverer.Interval.Duration == 0 {
+		c.OrderRecoverer.Interval.Duration = time.Minute
+	}
+	if c.OrderRecoverer.BatchSize == 0 {
+		c.OrderRecoverer.BatchSize = 1000
+	}
+
+	dbMap, err := sa.InitWrappedDb(c.OrderRecoverer.DB, stats, logger)
+	cmd.FailOnError(err, "While initializing dbMap")
+
+	tlsConfig, err := c.OrderRecoverer.TLS.Load(stats)
+	cmd.FailOnError(err, "TLS config")
+
+	conn, err := bgrpc.ClientSetup(c.OrderRecoverer.SAService, tlsConfig, stats, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := sapb.NewStorageAuthorityClient(conn)
+
+	or := newOrderRecoverer(
+		dbMap,
+		sac,
+		c.OrderRecoverer.StuckThreshold.Duration,
+		c.OrderRecoverer.MaxOrderAge.Duration,
+		c.OrderRecoverer.BatchSize,
+		stats,
+		logger,
+		clk,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go cmd.CatchSignals(cancel)
+
+	or.run(ctx, c.OrderRecoverer.Interval.Duration)
+}
+
+func init() {
+	cmd.RegisterCommand("order-recoverer", main, &cmd.ConfigValidator{Config: &Config{}})
+}
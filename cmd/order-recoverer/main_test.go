@@ -0,0 +1,310 @@
+package notmain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/identifier"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/sa"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+	"github.com/letsencrypt/boulder/test/vars"
+)
+
+// fakeSelector returns canned rows for the two queries issued by the
+// order-recoverer.
+type fakeSelector struct {
+	orderIDs []int64
+	serials  []string
+}
+
+func (fs *fakeSelector) Select(_ context.Context, holder any, query string, _ ...any) ([]any, error) {
+	switch {
+	case strings.Contains(query, "FROM orders"):
+		rows := holder.(*[]struct{ ID int64 })
+		for _, id := range fs.orderIDs {
+			*rows = append(*rows, struct{ ID int64 }{id})
+		}
+	case strings.Contains(query, "FROM issuedNames"):
+		rows := holder.(*[]struct{ Serial string })
+		for _, serial := range fs.serials {
+			*rows = append(*rows, struct{ Serial string }{serial})
+		}
+	default:
+		return nil, errors.New("unexpected query")
+	}
+	return nil, nil
+}
+
+type mockSA struct {
+	orders   map[int64]*corepb.Order
+	certs    map[string][]byte
+	precerts map[string][]byte
+	// owners maps serials to the accounts they were issued to, if not
+	// account 1.
+	owners map[string]int64
+
+	finalized map[int64]string
+	errored   map[int64]*corepb.ProblemDetails
+}
+
+func newMockSA() *mockSA {
+	return &mockSA{
+		orders:    make(map[int64]*corepb.Order),
+		certs:     make(map[string][]byte),
+		precerts:  make(map[string][]byte),
+		owners:    make(map[string]int64),
+		finalized: make(map[int64]string),
+		errored:   make(map[int64]*corepb.ProblemDetails),
+	}
+}
+
+func (msa *mockSA) GetOrder(_ context.Context, req *sapb.OrderRequest, _ ...grpc.CallOption) (*corepb.Order, error) {
+	order, ok := msa.orders[req.Id]
+	if !ok {
+		return nil, berrors.NotFoundError("no order with ID %d", req.Id)
+	}
+	return order, nil
+}
+
+func (msa *mockSA) owner(serial string) int64 {
+	owner, ok := msa.owners[serial]
+	if !ok {
+		return 1
+	}
+	return owner
+}
+
+func (msa *mockSA) GetCertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	der, ok := msa.certs[req.Serial]
+	if !ok {
+		return nil, berrors.NotFoundError("no certificate with serial %s", req.Serial)
+	}
+	return &corepb.Certificate{Serial: req.Serial, RegistrationID: msa.owner(req.Serial), Der: der}, nil
+}
+
+func (msa *mockSA) GetLintPrecertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	der, ok := msa.precerts[req.Serial]
+	if !ok {
+		return nil, berrors.NotFoundError("no precertificate with serial %s", req.Serial)
+	}
+	return &corepb.Certificate{Serial: req.Serial, RegistrationID: msa.owner(req.Serial), Der: der}, nil
+}
+
+func (msa *mockSA) FinalizeOrder(_ context.Context, req *sapb.FinalizeOrderRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	msa.finalized[req.Id] = req.CertificateSerial
+	msa.orders[req.Id].Status = string(core.StatusValid)
+	return &emptypb.Empty{}, nil
+}
+
+func (msa *mockSA) SetOrderError(_ context.Context, req *sapb.SetOrderErrorRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	msa.errored[req.Id] = req.Error
+	msa.orders[req.Id].Status = string(core.StatusInvalid)
+	return &emptypb.Empty{}, nil
+}
+
+func setup(t *testing.T) (*orderRecoverer, *fakeSelector, *mockSA, clock.FakeClock) {
+	t.Helper()
+	fc := clock.NewFake()
+	fs := &fakeSelector{}
+	msa := newMockSA()
+	or := newOrderRecoverer(fs, msa, 5*time.Minute, 7*24*time.Hour, 100, metrics.NoopRegisterer, blog.NewMock(), fc)
+	return or, fs, msa, fc
+}
+
+func processingOrder(id int64, idents identifier.ACMEIdentifiers, created time.Time) *corepb.Order {
+	return &corepb.Order{
+		Id:             id,
+		RegistrationID: 1,
+		Identifiers:    idents.ToProtoSlice(),
+		Created:        timestamppb.New(created),
+		Status:         string(core.StatusProcessing),
+	}
+}
+
+func TestRecoverOrderCompleted(t *testing.T) {
+	or, fs, msa, fc := setup(t)
+
+	serial, cert := test.ThrowAwayCert(t, fc)
+	otherSerial, otherCert := test.ThrowAwayCert(t, fc)
+	msa.orders[1] = processingOrder(1, identifier.FromCert(cert), fc.Now())
+	msa.certs[otherSerial] = otherCert.Raw
+	msa.certs[serial] = cert.Raw
+	fs.serials = []string{otherSerial, serial}
+
+	result, err := or.recoverOrder(context.Background(), 1)
+	test.AssertNotError(t, err, "recovering order")
+	test.AssertEquals(t, result, "completed")
+	test.AssertEquals(t, msa.finalized[1], serial)
+	test.AssertEquals(t, len(msa.errored), 0)
+}
+
+func TestRecoverOrderOtherAccount(t *testing.T) {
+	or, fs, msa, fc := setup(t)
+
+	// A certificate for the order's identifiers, issued to another account,
+	// doesn't complete the order.
+	serial, cert := test.ThrowAwayCert(t, fc)
+	msa.orders[1] = processingOrder(1, identifier.FromCert(cert), fc.Now())
+	msa.certs[serial] = cert.Raw
+	msa.owners[serial] = 2
+	fs.serials = []string{serial}
+
+	result, err := or.recoverOrder(context.Background(), 1)
+	test.AssertNotError(t, err, "recovering order")
+	test.AssertEquals(t, result, "failed")
+	test.AssertEquals(t, len(msa.finalized), 0)
+}
+
+func TestRecoverOrderFailed(t *testing.T) {
+	or, fs, msa, fc := setup(t)
+
+	// A precertificate was issued but the final certificate wasn't.
+	serial, cert := test.ThrowAwayCert(t, fc)
+	msa.orders[1] = processingOrder(1, identifier.FromCert(cert), fc.Now())
+	msa.precerts[serial] = cert.Raw
+	fs.serials = []string{serial}
+
+	result, err := or.recoverOrder(context.Background(), 1)
+	test.AssertNotError(t, err, "recovering order")
+	test.AssertEquals(t, result, "failed")
+	test.AssertEquals(t, len(msa.finalized), 0)
+	test.AssertContains(t, msa.errored[1].Detail, "issuance did not complete")
+
+	// A different order, for which nothing at all was issued.
+	msa.orders[2] = processingOrder(2, identifier.NewDNSSlice([]string{"example.com"}), fc.Now())
+	result, err = or.recoverOrder(context.Background(), 2)
+	test.AssertNotError(t, err, "recovering order")
+	test.AssertEquals(t, result, "failed")
+	test.AssertEquals(t, len(msa.finalized), 0)
+	test.AssertNotNil(t, msa.errored[2], "order should have been failed")
+}
+
+func TestRecoverOrderResolved(t *testing.T) {
+	or, _, msa, fc := setup(t)
+
+	msa.orders[1] = processingOrder(1, identifier.NewDNSSlice([]string{"example.com"}), fc.Now())
+	msa.orders[1].Status = string(core.StatusValid)
+
+	result, err := or.recoverOrder(context.Background(), 1)
+	test.AssertNotError(t, err, "recovering order")
+	test.AssertEquals(t, result, "resolved")
+	test.AssertEquals(t, len(msa.finalized), 0)
+	test.AssertEquals(t, len(msa.errored), 0)
+}
+
+func TestRecoverOnce(t *testing.T) {
+	or, fs, msa, fc := setup(t)
+	ctx := context.Background()
+
+	msa.orders[1] = processingOrder(1, identifier.NewDNSSlice([]string{"example.com"}), fc.Now())
+	msa.orders[2] = processingOrder(2, identifier.NewDNSSlice([]string{"example.net"}), fc.Now())
+	fs.orderIDs = []int64{1, 2}
+
+	// The first pass only observes the orders.
+	err := or.recoverOnce(ctx)
+	test.AssertNotError(t, err, "recoverOnce failed")
+	test.AssertEquals(t, len(or.firstSeen), 2)
+	test.AssertMetricWithLabelsEquals(t, or.ordersWatched, prometheus.Labels{}, 2)
+	test.AssertEquals(t, len(msa.errored), 0)
+
+	// Order 2 finishes processing on its own.
+	msa.orders[2].Status = string(core.StatusValid)
+	fs.orderIDs = []int64{1}
+
+	// Not enough time has passed for order 1 to be considered stuck.
+	fc.Add(4 * time.Minute)
+	err = or.recoverOnce(ctx)
+	test.AssertNotError(t, err, "recoverOnce failed")
+	test.AssertEquals(t, len(or.firstSeen), 1)
+	test.AssertEquals(t, len(msa.errored), 0)
+
+	// Now it has.
+	fc.Add(time.Minute)
+	err = or.recoverOnce(ctx)
+	test.AssertNotError(t, err, "recoverOnce failed")
+	test.AssertEquals(t, len(or.firstSeen), 0)
+	test.AssertNotNil(t, msa.errored[1], "order 1 should have been failed")
+	test.AssertMetricWithLabelsEquals(t, or.ordersRecovered, prometheus.Labels{"result": "failed"}, 1)
+	test.AssertMetricWithLabelsEquals(t, or.ordersWatched, prometheus.Labels{}, 0)
+}
+
+func TestRecoverOnceError(t *testing.T) {
+	or, fs, _, fc := setup(t)
+	ctx := context.Background()
+
+	// Order 1 is returned by the query but unknown to the SA.
+	fs.orderIDs = []int64{1}
+	err := or.recoverOnce(ctx)
+	test.AssertNotError(t, err, "recoverOnce failed")
+
+	fc.Add(5 * time.Minute)
+	err = or.recoverOnce(ctx)
+	test.AssertNotError(t, err, "recoverOnce failed")
+	test.AssertMetricWithLabelsEquals(t, or.ordersRecovered, prometheus.Labels{"result": "error"}, 1)
+
+	// The order should continue to be watched so that it's retried.
+	_, ok := or.firstSeen[1]
+	test.Assert(t, ok, "order 1 should still be watched")
+}
+
+func TestFindProcessingOrders(t *testing.T) {
+	ctx := context.Background()
+
+	dbMap, err := sa.DBMapForTest(vars.DBConnSAFullPerms)
+	test.AssertNotError(t, err, "failed setting up db client")
+	defer test.ResetBoulderTestDatabase(t)()
+
+	fc := clock.NewFake()
+	fc.Set(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	or := newOrderRecoverer(dbMap, newMockSA(), 5*time.Minute, 24*time.Hour, 100, metrics.NoopRegisterer, blog.NewMock(), fc)
+
+	insertOrder := func(created time.Time, beganProcessing bool, serial string, orderErr []byte) int64 {
+		t.Helper()
+		res, err := dbMap.ExecContext(ctx, `INSERT INTO orders
+			(registrationID, expires, error, certificateSerial, beganProcessing, created)
+			VALUES
+			(?, ?, ?, ?, ?, ?)`,
+			1,
+			created.Add(7*24*time.Hour),
+			orderErr,
+			serial,
+			beganProcessing,
+			created,
+		)
+		test.AssertNotError(t, err, "failed to insert order")
+		id, err := res.LastInsertId()
+		test.AssertNotError(t, err, "failed to get order ID")
+		return id
+	}
+
+	stuck := insertOrder(fc.Now().Add(-time.Hour), true, "", nil)
+	// Not yet processing.
+	insertOrder(fc.Now().Add(-time.Hour), false, "", nil)
+	// Already completed.
+	insertOrder(fc.Now().Add(-time.Hour), true, "00000000000000000000000000000000000a", nil)
+	// Already failed.
+	insertOrder(fc.Now().Add(-time.Hour), true, "", []byte(`{"type":"serverInternal"}`))
+	// Too new to have been processing for stuckThreshold.
+	insertOrder(fc.Now().Add(-time.Minute), true, "", nil)
+	// Older than maxOrderAge.
+	insertOrder(fc.Now().Add(-48*time.Hour), true, "", nil)
+
+	ids, err := or.findProcessingOrders(ctx)
+	test.AssertNotError(t, err, "findProcessingOrders failed")
+	test.AssertDeepEquals(t, ids, []int64{stuck})
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/jmhodges/clock"
 
@@ -63,6 +64,10 @@ type Config struct {
 				AccountURI       int64 `validate:"required"`
 				RegisteredDomain int64 `validate:"required"`
 				IPAddress        int64 `validate:"required"`
+				// Duration is the ID of the optional field in which support
+				// staff may set how long an approved override lasts. If
+				// unset, imported overrides never expire.
+				Duration int64 `validate:"omitempty"`
 			} `validate:"required,dive"`
 		} `validate:"omitempty,dive"`
 
@@ -95,6 +100,18 @@ type Config struct {
 			Interval config.Duration `validate:"omitempty,required_with=Mode,min=1200s"`
 		} `validate:"omitempty,dive"`
 
+		// OverridesSweeper configures the periodic disabling of expired rate
+		// limit overrides, and reporting of those which will expire soon.
+		OverridesSweeper struct {
+			// Interval is the amount of time between sweeps. If zero or
+			// unspecified, the sweeper is disabled.
+			Interval config.Duration `validate:"-"`
+			// ExpiringSoon is how far ahead of their expiry enabled overrides
+			// are reported, so that support staff can follow up. Defaults to
+			// 168h (7 days).
+			ExpiringSoon config.Duration `validate:"-"`
+		}
+
 		// AutoApproveOverrides enables automatic approval of override requests
 		// for the following limits and tiers:
 		//   - NewOrdersPerAccount: 1000
@@ -170,19 +187,24 @@ func main() {
 		zendeskToken, err := c.SFE.Zendesk.Token.Pass()
 		cmd.FailOnError(err, "Failed to load Zendesk token")
 
+		fieldIDs := map[string]int64{
+			sfe.OrganizationFieldName:     c.SFE.Zendesk.CustomFields.Organization,
+			sfe.TierFieldName:             c.SFE.Zendesk.CustomFields.Tier,
+			sfe.RateLimitFieldName:        c.SFE.Zendesk.CustomFields.RateLimit,
+			sfe.ReviewStatusFieldName:     c.SFE.Zendesk.CustomFields.ReviewStatus,
+			sfe.AccountURIFieldName:       c.SFE.Zendesk.CustomFields.AccountURI,
+			sfe.RegisteredDomainFieldName: c.SFE.Zendesk.CustomFields.RegisteredDomain,
+			sfe.IPAddressFieldName:        c.SFE.Zendesk.CustomFields.IPAddress,
+		}
+		if c.SFE.Zendesk.CustomFields.Duration != 0 {
+			fieldIDs[sfe.DurationFieldName] = c.SFE.Zendesk.CustomFields.Duration
+		}
+
 		zendeskClient, err = zendesk.NewClient(
 			c.SFE.Zendesk.BaseURL,
 			c.SFE.Zendesk.TokenEmail,
 			zendeskToken,
-			map[string]int64{
-				sfe.OrganizationFieldName:     c.SFE.Zendesk.CustomFields.Organization,
-				sfe.TierFieldName:             c.SFE.Zendesk.CustomFields.Tier,
-				sfe.RateLimitFieldName:        c.SFE.Zendesk.CustomFields.RateLimit,
-				sfe.ReviewStatusFieldName:     c.SFE.Zendesk.CustomFields.ReviewStatus,
-				sfe.AccountURIFieldName:       c.SFE.Zendesk.CustomFields.AccountURI,
-				sfe.RegisteredDomainFieldName: c.SFE.Zendesk.CustomFields.RegisteredDomain,
-				sfe.IPAddressFieldName:        c.SFE.Zendesk.CustomFields.IPAddress,
-			},
+			fieldIDs,
 		)
 		if err != nil {
 			cmd.FailOnError(err, "Failed to create Zendesk client")
@@ -213,6 +235,33 @@ func main() {
 		}
 	}
 
+	var overridesSweeperShutdown func()
+	var overridesSweeperWG sync.WaitGroup
+	if c.SFE.OverridesSweeper.Interval.Duration > 0 {
+		expiringSoon := c.SFE.OverridesSweeper.ExpiringSoon.Duration
+		if expiringSoon == 0 {
+			expiringSoon = 7 * 24 * time.Hour
+		}
+
+		sweeper, err := sfe.NewOverridesSweeper(
+			c.SFE.OverridesSweeper.Interval.Duration,
+			expiringSoon,
+			rac,
+			sac,
+			stats,
+			clk,
+			logger,
+		)
+		cmd.FailOnError(err, "Creating overrides sweeper")
+
+		var ctx context.Context
+		ctx, overridesSweeperShutdown = context.WithCancel(context.Background())
+		overridesSweeperWG.Go(func() {
+			sweeper.Start(ctx)
+		})
+		logger.Infof("Overrides sweeper started with interval=%s expiringSoon=%s", c.SFE.OverridesSweeper.Interval.Duration, expiringSoon)
+	}
+
 	var limiter *ratelimits.Limiter
 	var txnBuilder *ratelimits.TransactionBuilder
 	var limiterRedis *bredis.Ring
@@ -221,7 +270,7 @@ func main() {
 		cmd.FailOnError(err, "Failed to create Redis ring")
 
 		source := ratelimits.NewRedisSource(limiterRedis.Ring, clk, stats)
-		limiter, err = ratelimits.NewLimiter(clk, source, stats)
+		limiter, err = ratelimits.NewLimiter(clk, source, stats, logger)
 		cmd.FailOnError(err, "Failed to create rate limiter")
 		txnBuilder, err = ratelimits.NewTransactionBuilderFromFiles(c.SFE.Limiter.Defaults, "", stats, logger)
 		cmd.FailOnError(err, "Failed to create rate limits transaction builder")
@@ -265,6 +314,10 @@ func main() {
 			overridesImporterShutdown()
 			overridesImporterWG.Wait()
 		}
+		if overridesSweeperShutdown != nil {
+			overridesSweeperShutdown()
+			overridesSweeperWG.Wait()
+		}
 		_ = srv.Shutdown(ctx)
 		oTelShutdown(ctx)
 	}()
@@ -17,6 +17,7 @@ import (
 	"runtime"
 	"runtime/debug"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -568,13 +569,34 @@ func CatchSignals(callback func()) {
 // returns, allowing execution to resume, generally allowing a main() function
 // to return and trigger and deferred cleanup functions. This function is
 // intended to be called directly from the main goroutine, while a gRPC or HTTP
-// server runs in a background goroutine.
+// server runs in a background goroutine. If ReloadSignal has been called,
+// SIGHUP is ignored.
 func WaitForSignal() {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGTERM)
 	signal.Notify(sigChan, syscall.SIGINT)
 	signal.Notify(sigChan, syscall.SIGHUP)
-	<-sigChan
+	for sig := range sigChan {
+		if sig == syscall.SIGHUP && reloadOnSIGHUP.Load() {
+			continue
+		}
+		return
+	}
+}
+
+// reloadOnSIGHUP is set by ReloadSignal, and causes WaitForSignal to ignore
+// SIGHUP.
+var reloadOnSIGHUP atomic.Bool
+
+// ReloadSignal returns a channel which receives a value each time a SIGHUP is
+// received. Once it has been called, SIGHUP no longer causes WaitForSignal or
+// CatchSignals to return, so that it can be used to trigger a reload of some
+// part of the configuration instead.
+func ReloadSignal() <-chan os.Signal {
+	reloadOnSIGHUP.Store(true)
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGHUP)
+	return sigChan
 }
 
 // PushMetrics pushes the provided Prometheus metrics to the provided
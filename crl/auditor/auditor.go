@@ -0,0 +1,423 @@
+// Package auditor reconciles the CRLs published by the crl-updater with the
+// revocation records in the SA, to prove that every revoked, unexpired
+// certificate appears in the CRL shard it belongs to.
+package auditor
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/crl"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// Issuer is an issuer whose CRLs are audited.
+type Issuer struct {
+	Cert *issuance.Certificate
+	// URLBase is the prefix of the URLs of the issuer's CRL shards, matching the
+	// CA's CRLURLBase: shard n is published at URLBase + "n.crl".
+	URLBase string
+}
+
+// The kinds of problem which an audit can find with a CRL entry.
+const (
+	// problemMissing is an entry revoked before the CRL's thisUpdate, for a
+	// certificate unexpired at thisUpdate, which is absent from the CRL.
+	problemMissing = "missing"
+	// problemExtra is an entry in the CRL for which the SA has no matching
+	// revocation in that shard.
+	problemExtra = "extra"
+	// problemLate is an entry which has not been published more than maxLatency
+	// after it was revoked.
+	problemLate = "late"
+	// problemWrongShard is an entry which the SA records in a shard other than
+	// the one named by its certificate's CRLDistributionPoint, and so is absent
+	// from the CRL which relying parties will check.
+	problemWrongShard = "wrongShard"
+)
+
+// shardKey identifies a single CRL shard.
+type shardKey struct {
+	issuer issuance.NameID
+	shard  int
+}
+
+// shardResult summarizes the audit of a single CRL shard.
+type shardResult struct {
+	problems   map[string]int
+	maxLatency time.Duration
+}
+
+type crlAuditor struct {
+	sa             sapb.StorageAuthorityReadOnlyClient
+	client         *http.Client
+	issuers        []Issuer
+	numShards      int
+	lookbackPeriod time.Duration
+	maxLatency     time.Duration
+	maxCRLSize     int64
+
+	// lastThisUpdate holds the thisUpdate of the last CRL audited for each
+	// shard, so that entries which first appear in a CRL can be identified,
+	// and the latency of their publication measured.
+	lastThisUpdate map[shardKey]time.Time
+	// certShards holds the shard named by the certificate of each revocation
+	// the SA recorded in each shard as of its last audit, so that each
+	// certificate only needs to be fetched once.
+	certShards map[shardKey]map[string]int64
+
+	problemsGauge  *prometheus.GaugeVec
+	latencyGauge   *prometheus.GaugeVec
+	auditCounter   *prometheus.CounterVec
+	lastAuditGauge *prometheus.GaugeVec
+
+	log blog.Logger
+	clk clock.Clock
+}
+
+func New(
+	issuers []Issuer,
+	numShards int,
+	lookbackPeriod time.Duration,
+	maxLatency time.Duration,
+	maxCRLSize int64,
+	sa sapb.StorageAuthorityReadOnlyClient,
+	client *http.Client,
+	stats prometheus.Registerer,
+	log blog.Logger,
+	clk clock.Clock,
+) (*crlAuditor, error) {
+	if len(issuers) == 0 {
+		return nil, errors.New("must have at least one issuer")
+	}
+
+	if numShards < 1 {
+		return nil, fmt.Errorf("must have positive number of shards, got: %d", numShards)
+	}
+
+	if lookbackPeriod <= 0 {
+		return nil, fmt.Errorf("lookbackPeriod must be positive, got: %s", lookbackPeriod)
+	}
+
+	if maxLatency <= 0 {
+		return nil, fmt.Errorf("maxLatency must be positive, got: %s", maxLatency)
+	}
+
+	problemsGauge := promauto.With(stats).NewGaugeVec(prometheus.GaugeOpts{
+		Name: "crl_auditor_problem_entries",
+		Help: "The number of problematic CRL entries found by the most recent audit, labeled by issuer and problem (missing, extra, late, or wrongShard)",
+	}, []string{"issuer", "problem"})
+
+	latencyGauge := promauto.With(stats).NewGaugeVec(prometheus.GaugeOpts{
+		Name: "crl_auditor_max_publication_latency_seconds",
+		Help: "The longest time between revocation and publication in a CRL, including entries not yet published, found by the most recent audit, labeled by issuer",
+	}, []string{"issuer"})
+
+	auditCounter := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "crl_auditor_audits",
+		Help: "A counter of CRL audits labeled by issuer and result",
+	}, []string{"issuer", "result"})
+
+	lastAuditGauge := promauto.With(stats).NewGaugeVec(prometheus.GaugeOpts{
+		Name: "crl_auditor_last_audit_seconds",
+		Help: "The Unix time at which each issuer's CRLs were last successfully audited, labeled by issuer",
+	}, []string{"issuer"})
+
+	return &crlAuditor{
+		sa:             sa,
+		client:         client,
+		issuers:        issuers,
+		numShards:      numShards,
+		lookbackPeriod: lookbackPeriod,
+		maxLatency:     maxLatency,
+		maxCRLSize:     maxCRLSize,
+		lastThisUpdate: make(map[shardKey]time.Time),
+		certShards:     make(map[shardKey]map[string]int64),
+		problemsGauge:  problemsGauge,
+		latencyGauge:   latencyGauge,
+		auditCounter:   auditCounter,
+		lastAuditGauge: lastAuditGauge,
+		log:            log,
+		clk:            clk,
+	}, nil
+}
+
+// Run audits every issuer's CRLs once per interval, until the context is
+// canceled.
+func (au *crlAuditor) Run(ctx context.Context, interval time.Duration) error {
+	for {
+		_, err := au.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			au.log.Errf("Auditing CRLs: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-au.clk.After(interval):
+		}
+	}
+}
+
+// RunOnce audits every shard of every issuer's CRLs, and updates the metrics
+// for each issuer whose shards could all be audited. It returns the total
+// number of problematic entries found, and an error if any shard couldn't be
+// audited.
+func (au *crlAuditor) RunOnce(ctx context.Context) (int, error) {
+	var total int
+	var errs []error
+	for _, issuer := range au.issuers {
+		problems, maxLatency, err := au.auditIssuer(ctx, issuer)
+		if err != nil {
+			au.auditCounter.WithLabelValues(issuer.Cert.Subject.CommonName, "failed").Inc()
+			errs = append(errs, fmt.Errorf("auditing CRLs of %s: %w", issuer.Cert.Subject.CommonName, err))
+			continue
+		}
+		au.auditCounter.WithLabelValues(issuer.Cert.Subject.CommonName, "success").Inc()
+
+		for _, problem := range []string{problemMissing, problemExtra, problemLate, problemWrongShard} {
+			au.problemsGauge.WithLabelValues(issuer.Cert.Subject.CommonName, problem).Set(float64(problems[problem]))
+			total += problems[problem]
+		}
+		au.latencyGauge.WithLabelValues(issuer.Cert.Subject.CommonName).Set(maxLatency.Seconds())
+		au.lastAuditGauge.WithLabelValues(issuer.Cert.Subject.CommonName).Set(float64(au.clk.Now().Unix()))
+	}
+	return total, errors.Join(errs...)
+}
+
+// auditIssuer audits every shard of the issuer's CRLs, and returns the total
+// number of each kind of problem found, and the maximum publication latency.
+func (au *crlAuditor) auditIssuer(ctx context.Context, issuer Issuer) (map[string]int, time.Duration, error) {
+	problems := make(map[string]int)
+	var maxLatency time.Duration
+	var errs []error
+	for shardIdx := 1; shardIdx <= au.numShards; shardIdx++ {
+		res, err := au.auditShard(ctx, issuer, shardIdx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("shard %d: %w", shardIdx, err))
+			continue
+		}
+		for problem, count := range res.problems {
+			problems[problem] += count
+		}
+		maxLatency = max(maxLatency, res.maxLatency)
+	}
+	return problems, maxLatency, errors.Join(errs...)
+}
+
+// fetchCRL downloads and parses the given shard of the issuer's CRL, and
+// checks its signature.
+func (au *crlAuditor) fetchCRL(ctx context.Context, issuer Issuer, shardIdx int) (*x509.RevocationList, error) {
+	url := issuer.URLBase + strconv.Itoa(shardIdx) + ".crl"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request for %s: %w", url, err)
+	}
+	resp, err := au.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading %s: http status %d", url, resp.StatusCode)
+	}
+
+	crlBytes, err := io.ReadAll(core.ErrOnLimitReader(resp.Body, au.maxCRLSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", url, err)
+	}
+
+	parsed, err := x509.ParseRevocationList(crlBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", url, err)
+	}
+
+	err = parsed.CheckSignatureFrom(issuer.Cert.Certificate)
+	if err != nil {
+		return nil, fmt.Errorf("checking signature of %s: %w", url, err)
+	}
+
+	return parsed, nil
+}
+
+// getRevokedCerts returns the SA's revocation entries for the given shard,
+// keyed by serial.
+func (au *crlAuditor) getRevokedCerts(ctx context.Context, issuer Issuer, shardIdx int, revokedBefore time.Time, expiresAfter time.Time) (map[string]*corepb.CRLEntry, error) {
+	stream, err := au.sa.GetRevokedCertsByShard(ctx, &sapb.GetRevokedCertsByShardRequest{
+		IssuerNameID:  int64(issuer.Cert.NameID()),
+		ShardIdx:      int64(shardIdx),
+		RevokedBefore: timestamppb.New(revokedBefore),
+		ExpiresAfter:  timestamppb.New(expiresAfter),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetRevokedCertsByShard: %w", err)
+	}
+
+	entries := make(map[string]*corepb.CRLEntry)
+	for {
+		entry, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("retrieving entry from SA: %w", err)
+		}
+		entries[entry.Serial] = entry
+	}
+	return entries, nil
+}
+
+// certShard returns the shard which the certificate with the given serial
+// belongs to, according to its CRLDistributionPoint. It returns 0 if the shard
+// can't be found, such as for a malformed certificate revoked by an admin.
+func (au *crlAuditor) certShard(ctx context.Context, serial string) int64 {
+	pb, err := au.sa.GetCertificate(ctx, &sapb.Serial{Serial: serial})
+	if errors.Is(err, berrors.NotFound) {
+		pb, err = au.sa.GetLintPrecertificate(ctx, &sapb.Serial{Serial: serial})
+	}
+	if err != nil {
+		return 0
+	}
+	cert, err := x509.ParseCertificate(pb.Der)
+	if err != nil {
+		return 0
+	}
+	shardIdx, err := crl.Shard(cert)
+	if err != nil {
+		return 0
+	}
+	return shardIdx
+}
+
+// auditShard reconciles a single published CRL shard with the SA.
+//
+// Entries revoked before the CRL's thisUpdate, for certificates unexpired at
+// thisUpdate, must appear in the CRL. Every entry in the CRL must correspond
+// to a revocation in the SA in the same shard, for a certificate which expired
+// no more than lookbackPeriod before thisUpdate. Entries revoked after
+// thisUpdate are awaiting the next CRL, and are late if they have been
+// waiting for longer than maxLatency. Every entry in the SA must be for a
+// certificate which names this shard in its CRLDistributionPoint.
+func (au *crlAuditor) auditShard(ctx context.Context, issuer Issuer, shardIdx int) (*shardResult, error) {
+	published, err := au.fetchCRL(ctx, issuer, shardIdx)
+	if err != nil {
+		return nil, err
+	}
+	now := au.clk.Now()
+	thisUpdate := published.ThisUpdate
+	if thisUpdate.After(now) {
+		return nil, fmt.Errorf("thisUpdate %s is in the future", thisUpdate.Format(time.RFC3339))
+	}
+
+	required, err := au.getRevokedCerts(ctx, issuer, shardIdx, thisUpdate, thisUpdate)
+	if err != nil {
+		return nil, err
+	}
+	known, err := au.getRevokedCerts(ctx, issuer, shardIdx, now, thisUpdate.Add(-au.lookbackPeriod))
+	if err != nil {
+		return nil, err
+	}
+
+	key := shardKey{issuer.Cert.NameID(), shardIdx}
+	prevCertShards := au.certShards[key]
+	certShards := make(map[string]int64, len(known))
+	for serial := range known {
+		certShard, ok := prevCertShards[serial]
+		if !ok {
+			certShard = au.certShard(ctx, serial)
+		}
+		if certShard != 0 {
+			certShards[serial] = certShard
+		}
+	}
+
+	res := &shardResult{problems: make(map[string]int)}
+	report := func(problem string, serial string, revokedAt time.Time) {
+		res.problems[problem]++
+		certShard, ok := certShards[serial]
+		if !ok {
+			certShard = au.certShard(ctx, serial)
+		}
+		fields := map[string]any{
+			"issuer":     issuer.Cert.Subject.CommonName,
+			"shardIdx":   shardIdx,
+			"crlNumber":  published.Number.String(),
+			"thisUpdate": thisUpdate.Format(time.RFC3339),
+			"serial":     serial,
+			"certShard":  certShard,
+		}
+		if !revokedAt.IsZero() {
+			fields["revokedAt"] = revokedAt.Format(time.RFC3339)
+		}
+		au.log.AuditErr(fmt.Sprintf("CRL audit found %s entry", problem), nil, fields)
+	}
+
+	for serial, entry := range known {
+		certShard, ok := certShards[serial]
+		if ok && certShard != int64(shardIdx) {
+			report(problemWrongShard, serial, entry.RevokedAt.AsTime())
+		}
+	}
+
+	prevThisUpdate, audited := au.lastThisUpdate[key]
+	inCRL := make(map[string]bool, len(published.RevokedCertificateEntries))
+	for _, entry := range published.RevokedCertificateEntries {
+		serial := core.SerialToString(entry.SerialNumber)
+		inCRL[serial] = true
+
+		saEntry, ok := known[serial]
+		if !ok {
+			report(problemExtra, serial, entry.RevocationTime)
+			continue
+		}
+
+		// Entries revoked since the previous CRL we audited were first
+		// published in this one.
+		revokedAt := saEntry.RevokedAt.AsTime()
+		if audited && !revokedAt.Before(prevThisUpdate) {
+			res.maxLatency = max(res.maxLatency, thisUpdate.Sub(revokedAt))
+		}
+	}
+
+	for serial, entry := range known {
+		if inCRL[serial] {
+			continue
+		}
+		revokedAt := entry.RevokedAt.AsTime()
+		_, isRequired := required[serial]
+		if !isRequired && revokedAt.Before(thisUpdate) {
+			// Revoked before thisUpdate, but for a certificate which had
+			// already expired.
+			continue
+		}
+
+		waiting := now.Sub(revokedAt)
+		res.maxLatency = max(res.maxLatency, waiting)
+		if isRequired {
+			report(problemMissing, serial, revokedAt)
+		} else if waiting > au.maxLatency {
+			report(problemLate, serial, revokedAt)
+		}
+	}
+
+	au.lastThisUpdate[key] = thisUpdate
+	au.certShards[key] = certShards
+	return res, nil
+}
@@ -0,0 +1,250 @@
+package auditor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/mocks"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// revocation is a single row of the fake SA's revokedCertificates table.
+type revocation struct {
+	serial    int64
+	shardIdx  int64
+	revokedAt time.Time
+	notAfter  time.Time
+}
+
+// fakeSA serves revocations for GetRevokedCertsByShard, and the certificates
+// in certs, by serial.
+type fakeSA struct {
+	sapb.StorageAuthorityReadOnlyClient
+	revocations []revocation
+	certs       map[string][]byte
+	certLookups int
+}
+
+func (f *fakeSA) GetRevokedCertsByShard(_ context.Context, req *sapb.GetRevokedCertsByShardRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[corepb.CRLEntry], error) {
+	var entries []*corepb.CRLEntry
+	for _, r := range f.revocations {
+		if r.shardIdx != req.ShardIdx || !r.revokedAt.Before(req.RevokedBefore.AsTime()) || r.notAfter.Before(req.ExpiresAfter.AsTime()) {
+			continue
+		}
+		entries = append(entries, &corepb.CRLEntry{
+			Serial:    core.SerialToString(big.NewInt(r.serial)),
+			RevokedAt: timestamppb.New(r.revokedAt),
+		})
+	}
+	return &mocks.ServerStreamClient[corepb.CRLEntry]{Results: entries}, nil
+}
+
+func (f *fakeSA) GetCertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	f.certLookups++
+	der, ok := f.certs[req.Serial]
+	if !ok {
+		return nil, berrors.NotFoundError("no certificate")
+	}
+	return &corepb.Certificate{Der: der}, nil
+}
+
+func (f *fakeSA) GetLintPrecertificate(_ context.Context, _ *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	return nil, berrors.NotFoundError("no precertificate")
+}
+
+// crlServer serves CRL shards at "/<shard>.crl".
+type crlServer struct {
+	sync.Mutex
+	shards map[string][]byte
+}
+
+func (s *crlServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	body, ok := s.shards[r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Write(body)
+}
+
+func (s *crlServer) publish(t *testing.T, iss *issuance.Issuer, path string, thisUpdate time.Time, serials ...int64) {
+	t.Helper()
+	var entries []x509.RevocationListEntry
+	for _, serial := range serials {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: big.NewInt(serial), RevocationTime: thisUpdate.Add(-time.Hour)})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		ThisUpdate:                thisUpdate,
+		NextUpdate:                thisUpdate.Add(time.Hour),
+		Number:                    big.NewInt(thisUpdate.UnixNano()),
+		RevokedCertificateEntries: entries,
+	}, iss.Cert.Certificate, iss.Signer)
+	test.AssertNotError(t, err, "creating test CRL")
+
+	s.Lock()
+	defer s.Unlock()
+	s.shards[path] = der
+}
+
+// issueCert returns a certificate with the given serial, signed by iss, which
+// names the given shard in its CRLDistributionPoint.
+func issueCert(t *testing.T, iss *issuance.Issuer, serial int64, shard int) []byte {
+	t.Helper()
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		CRLDistributionPoints: []string{fmt.Sprintf("http://not-example.com/crl/%d.crl", shard)},
+	}, iss.Cert.Certificate, iss.Cert.PublicKey, iss.Signer)
+	test.AssertNotError(t, err, "creating test certificate")
+	return der
+}
+
+func TestAudit(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	now := fc.Now()
+
+	iss, err := issuance.LoadIssuer(
+		issuance.IssuerConfig{
+			Location: issuance.IssuerLoc{
+				File:     "../../test/hierarchy/int-e1.key.pem",
+				CertFile: "../../test/hierarchy/int-e1.cert.pem",
+			},
+			IssuerURL:  "http://not-example.com/issuer-url",
+			CRLURLBase: "http://not-example.com/crl/",
+			CRLShards:  2,
+		}, fc)
+	test.AssertNotError(t, err, "loading test issuer")
+
+	srv := &crlServer{shards: make(map[string][]byte)}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	sa := &fakeSA{revocations: []revocation{
+		// Published in the first CRL.
+		{serial: 1, shardIdx: 1, revokedAt: now.Add(-3 * time.Hour), notAfter: now.Add(24 * time.Hour)},
+		// Revoked before the first CRL, but missing from it.
+		{serial: 2, shardIdx: 1, revokedAt: now.Add(-2 * time.Hour), notAfter: now.Add(24 * time.Hour)},
+		// Revoked after the first CRL, and not yet published.
+		{serial: 3, shardIdx: 1, revokedAt: now.Add(-30 * time.Minute), notAfter: now.Add(24 * time.Hour)},
+		// Revoked before the first CRL, but expired before it, so needn't be
+		// published.
+		{serial: 4, shardIdx: 1, revokedAt: now.Add(-4 * time.Hour), notAfter: now.Add(-3 * time.Hour)},
+		// In the second shard, and published in it.
+		{serial: 6, shardIdx: 2, revokedAt: now.Add(-3 * time.Hour), notAfter: now.Add(24 * time.Hour)},
+		// Published in the first shard, but its certificate names the second.
+		{serial: 7, shardIdx: 1, revokedAt: now.Add(-3 * time.Hour), notAfter: now.Add(24 * time.Hour)},
+	}}
+	sa.certs = map[string][]byte{
+		core.SerialToString(big.NewInt(1)): issueCert(t, iss, 1, 1),
+		core.SerialToString(big.NewInt(7)): issueCert(t, iss, 7, 2),
+	}
+
+	// Serial 5 has no revocation in the SA, and serial 6 belongs in shard 2.
+	srv.publish(t, iss, "/1.crl", now.Add(-time.Hour), 1, 5, 6, 7)
+	srv.publish(t, iss, "/2.crl", now.Add(-10*time.Minute), 6)
+
+	au, err := New(
+		[]Issuer{{Cert: iss.Cert, URLBase: ts.URL + "/"}},
+		2,
+		24*time.Hour,
+		20*time.Minute,
+		core.DefaultMaxCRLRead,
+		sa,
+		ts.Client(),
+		metrics.NoopRegisterer,
+		blog.NewMock(),
+		fc,
+	)
+	test.AssertNotError(t, err, "creating auditor")
+
+	problems, err := au.RunOnce(context.Background())
+	test.AssertNotError(t, err, "auditing CRLs")
+	test.AssertEquals(t, problems, 5)
+
+	cn := iss.Cert.Subject.CommonName
+	test.AssertMetricWithLabelsEquals(t, au.problemsGauge, prometheus.Labels{"issuer": cn, "problem": problemMissing}, 1)
+	test.AssertMetricWithLabelsEquals(t, au.problemsGauge, prometheus.Labels{"issuer": cn, "problem": problemExtra}, 2)
+	test.AssertMetricWithLabelsEquals(t, au.problemsGauge, prometheus.Labels{"issuer": cn, "problem": problemLate}, 1)
+	test.AssertMetricWithLabelsEquals(t, au.problemsGauge, prometheus.Labels{"issuer": cn, "problem": problemWrongShard}, 1)
+	// Serial 2 has been waiting the longest.
+	test.AssertMetricWithLabelsEquals(t, au.latencyGauge, prometheus.Labels{"issuer": cn}, (2 * time.Hour).Seconds())
+
+	// A new CRL includes every entry. Serial 3 is the only entry revoked since
+	// the previous CRL, so only its publication latency is measured. Serial 7
+	// is still in the wrong shard, but its certificate isn't fetched again.
+	fc.Add(10 * time.Minute)
+	srv.publish(t, iss, "/1.crl", now.Add(5*time.Minute), 1, 2, 3, 7)
+	lookups := sa.certLookups
+	problems, err = au.RunOnce(context.Background())
+	test.AssertNotError(t, err, "auditing CRLs")
+	test.AssertEquals(t, problems, 1)
+	test.AssertMetricWithLabelsEquals(t, au.problemsGauge, prometheus.Labels{"issuer": cn, "problem": problemMissing}, 0)
+	test.AssertMetricWithLabelsEquals(t, au.problemsGauge, prometheus.Labels{"issuer": cn, "problem": problemWrongShard}, 1)
+	// Serials 2, 3, 4, and 6 have no certificate, so are looked up again.
+	test.AssertEquals(t, sa.certLookups-lookups, 4)
+	test.AssertMetricWithLabelsEquals(t, au.latencyGauge, prometheus.Labels{"issuer": cn}, (35 * time.Minute).Seconds())
+	test.AssertMetricWithLabelsEquals(t, au.auditCounter, prometheus.Labels{"issuer": cn, "result": "success"}, 2)
+
+	// A shard which can't be downloaded fails the audit, without updating the
+	// problem metrics.
+	srv.Lock()
+	delete(srv.shards, "/2.crl")
+	srv.Unlock()
+	_, err = au.RunOnce(context.Background())
+	test.AssertError(t, err, "auditing with a missing shard should fail")
+	test.AssertContains(t, err.Error(), "shard 2")
+	test.AssertMetricWithLabelsEquals(t, au.auditCounter, prometheus.Labels{"issuer": cn, "result": "failed"}, 1)
+
+	// A CRL signed by another issuer is rejected.
+	other, err := issuance.LoadIssuer(
+		issuance.IssuerConfig{
+			Location: issuance.IssuerLoc{
+				File:     "../../test/hierarchy/int-e2.key.pem",
+				CertFile: "../../test/hierarchy/int-e2.cert.pem",
+			},
+			IssuerURL:  "http://not-example.com/issuer-url",
+			CRLURLBase: "http://not-example.com/crl/",
+			CRLShards:  2,
+		}, fc)
+	test.AssertNotError(t, err, "loading other test issuer")
+	srv.publish(t, other, "/2.crl", now, 6)
+	_, err = au.RunOnce(context.Background())
+	test.AssertError(t, err, "auditing a CRL with a bad signature should fail")
+	test.AssertContains(t, err.Error(), "checking signature")
+}
+
+func TestFetchCRLTooLarge(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "0123456789")
+	}))
+	defer ts.Close()
+
+	au := &crlAuditor{client: ts.Client(), maxCRLSize: 5}
+	_, err := au.fetchCRL(context.Background(), Issuer{URLBase: ts.URL + "/"}, 1)
+	test.AssertError(t, err, "fetching an oversized CRL should fail")
+}
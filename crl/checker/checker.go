@@ -14,17 +14,22 @@ import (
 	"github.com/letsencrypt/boulder/linter"
 )
 
-// Validate runs the given CRL through our set of lints, ensures its signature
-// validates (if supplied with a non-nil issuer), and checks that the CRL is
-// less than ageLimit old. It returns an error if any of these conditions are
-// not met.
-func Validate(crl *x509.RevocationList, issuer *x509.Certificate, ageLimit time.Duration) error {
+// Validate runs the given CRL through our set of lints, except for those named
+// in skipLints, ensures its signature validates (if supplied with a non-nil
+// issuer), and checks that the CRL is less than ageLimit old. It returns an
+// error if any of these conditions are not met.
+func Validate(crl *x509.RevocationList, issuer *x509.Certificate, ageLimit time.Duration, skipLints []string) error {
 	zcrl, err := zlint_x509.ParseRevocationList(crl.Raw)
 	if err != nil {
 		return fmt.Errorf("parsing CRL: %w", err)
 	}
 
-	err = linter.ProcessResultSet(zlint.LintRevocationList(zcrl))
+	reg, err := linter.NewRegistry(skipLints)
+	if err != nil {
+		return fmt.Errorf("creating lint registry: %w", err)
+	}
+
+	err = linter.ProcessResultSet(zlint.LintRevocationListEx(zcrl, reg))
 	if err != nil {
 		return fmt.Errorf("linting CRL: %w", err)
 	}
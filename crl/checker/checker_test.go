@@ -14,6 +14,7 @@ import (
 
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/linter"
 	"github.com/letsencrypt/boulder/test"
 )
 
@@ -28,16 +29,16 @@ func TestValidate(t *testing.T) {
 	issuer, err := core.LoadCert("../../test/hierarchy/int-e1.cert.pem")
 	test.AssertNotError(t, err, "loading test issuer")
 
-	err = Validate(crl, issuer, 100*365*24*time.Hour)
+	err = Validate(crl, issuer, 100*365*24*time.Hour, nil)
 	test.AssertNotError(t, err, "validating good crl")
 
-	err = Validate(crl, issuer, 0)
+	err = Validate(crl, issuer, 0, nil)
 	test.AssertError(t, err, "validating too-old crl")
 	test.AssertContains(t, err.Error(), "in the past")
 
 	issuer2, err := core.LoadCert("../../test/hierarchy/int-r3.cert.pem")
 	test.AssertNotError(t, err, "loading test issuer")
-	err = Validate(crl, issuer2, 100*365*24*time.Hour)
+	err = Validate(crl, issuer2, 100*365*24*time.Hour, nil)
 	test.AssertError(t, err, "validating crl from wrong issuer")
 	test.AssertContains(t, err.Error(), "signature")
 
@@ -48,9 +49,23 @@ func TestValidate(t *testing.T) {
 	crlDER, _ = pem.Decode(crlPEM)
 	crl, err = x509.ParseRevocationList(crlDER.Bytes)
 	test.AssertNotError(t, err, "parsing test crl")
-	err = Validate(crl, issuer, 100*365*24*time.Hour)
+	err = Validate(crl, issuer, 100*365*24*time.Hour, nil)
 	test.AssertError(t, err, "validating crl with lint error")
 	test.AssertContains(t, err.Error(), "linting")
+
+	// Delta CRLs are lint errors unless the delta CRL lints are skipped.
+	crlFile, err = os.Open("../../linter/lints/rfc/testdata/crl_delta_good.pem")
+	test.AssertNotError(t, err, "opening test crl file")
+	crlPEM, err = io.ReadAll(crlFile)
+	test.AssertNotError(t, err, "reading test crl file")
+	crlDER, _ = pem.Decode(crlPEM)
+	crl, err = x509.ParseRevocationList(crlDER.Bytes)
+	test.AssertNotError(t, err, "parsing test crl")
+	err = Validate(crl, nil, 100*365*24*time.Hour, nil)
+	test.AssertError(t, err, "validating delta crl without skipping lints")
+	test.AssertContains(t, err.Error(), "e_crl_is_not_delta")
+	err = Validate(crl, nil, 100*365*24*time.Hour, linter.DeltaCRLLints)
+	test.AssertNotError(t, err, "validating delta crl while skipping delta lints")
 }
 
 func TestDiff(t *testing.T) {
@@ -1,6 +1,7 @@
 package crl
 
 import (
+	"crypto/x509"
 	"fmt"
 	"math/big"
 	"testing"
@@ -15,3 +16,60 @@ func TestId(t *testing.T) {
 	expectCRLId := fmt.Sprintf("{\"issuerID\":1337,\"shardIdx\":1,\"crlNumber\":%d}", big.NewInt(thisUpdate.UnixNano()))
 	test.AssertEquals(t, string(out), expectCRLId)
 }
+
+func TestShard(t *testing.T) {
+	var cdp []string
+	n, err := Shard(&x509.Certificate{CRLDistributionPoints: cdp})
+	if err == nil {
+		t.Errorf("Shard(%+v) = %d, %s, want 0, some error", cdp, n, err)
+	}
+
+	cdp = []string{
+		"https://example.com/123.crl",
+		"https://example.net/123.crl",
+	}
+	n, err = Shard(&x509.Certificate{CRLDistributionPoints: cdp})
+	if err == nil {
+		t.Errorf("Shard(%+v) = %d, %s, want 0, some error", cdp, n, err)
+	}
+
+	cdp = []string{
+		"https://example.com/abc",
+	}
+	n, err = Shard(&x509.Certificate{CRLDistributionPoints: cdp})
+	if err == nil {
+		t.Errorf("Shard(%+v) = %d, %s, want 0, some error", cdp, n, err)
+	}
+
+	cdp = []string{
+		"example",
+	}
+	n, err = Shard(&x509.Certificate{CRLDistributionPoints: cdp})
+	if err == nil {
+		t.Errorf("Shard(%+v) = %d, %s, want 0, some error", cdp, n, err)
+	}
+
+	cdp = []string{
+		"https://example.com/abc/-77.crl",
+	}
+	n, err = Shard(&x509.Certificate{CRLDistributionPoints: cdp})
+	if err == nil {
+		t.Errorf("Shard(%+v) = %d, %s, want 0, some error", cdp, n, err)
+	}
+
+	cdp = []string{
+		"https://example.com/abc/123",
+	}
+	n, err = Shard(&x509.Certificate{CRLDistributionPoints: cdp})
+	if err != nil || n != 123 {
+		t.Errorf("Shard(%+v) = %d, %s, want 123, nil", cdp, n, err)
+	}
+
+	cdp = []string{
+		"https://example.com/abc/123.crl",
+	}
+	n, err = Shard(&x509.Certificate{CRLDistributionPoints: cdp})
+	if err != nil || n != 123 {
+		t.Errorf("Shard(%+v) = %d, %s, want 123, nil", cdp, n, err)
+	}
+}
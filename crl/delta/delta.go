@@ -0,0 +1,116 @@
+// Package delta builds and parses the extensions which tie delta CRLs to the
+// full CRLs they update, as defined in RFC 5280 Sections 5.2.4 and 5.2.6.
+package delta
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+)
+
+var (
+	indicatorOID   = asn1.ObjectIdentifier{2, 5, 29, 27} // id-ce-deltaCRLIndicator
+	freshestCRLOID = asn1.ObjectIdentifier{2, 5, 29, 46} // id-ce-freshestCRL
+)
+
+// distributionPoint represents the ASN.1 DistributionPoint SEQUENCE as defined
+// in RFC 5280 Section 4.2.1.13. We only use one of the fields, so the others
+// are omitted.
+type distributionPoint struct {
+	DistributionPoint distributionPointName `asn1:"optional,tag:0"`
+}
+
+// distributionPointName represents the ASN.1 DistributionPointName CHOICE as
+// defined in RFC 5280 Section 4.2.1.13. We only use one of the fields, so the
+// others are omitted.
+type distributionPointName struct {
+	// As in the idp package, FullName is a GeneralNames, which we encode
+	// ourselves because asn1.Marshal doesn't support CHOICEs.
+	FullName []asn1.RawValue `asn1:"optional,tag:0"`
+}
+
+// MakeIndicatorExt returns a critical DeltaCRLIndicator extension, marking a
+// CRL as a delta CRL which updates the full CRL with the given CRL Number.
+func MakeIndicatorExt(base *big.Int) (pkix.Extension, error) {
+	valBytes, err := asn1.Marshal(base)
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+
+	return pkix.Extension{
+		Id:       indicatorOID,
+		Value:    valBytes,
+		Critical: true,
+	}, nil
+}
+
+// GetBaseCRLNumber returns the BaseCRLNumber contained within the
+// DeltaCRLIndicator extension, or nil if there is no such extension because the
+// CRL is not a delta CRL.
+func GetBaseCRLNumber(exts []pkix.Extension) (*big.Int, error) {
+	for _, ext := range exts {
+		if ext.Id.Equal(indicatorOID) {
+			var base *big.Int
+			rest, err := asn1.Unmarshal(ext.Value, &base)
+			if err != nil {
+				return nil, fmt.Errorf("parsing DeltaCRLIndicator extension: %w", err)
+			}
+			if len(rest) != 0 {
+				return nil, fmt.Errorf("parsing DeltaCRLIndicator extension: got %d unexpected trailing bytes", len(rest))
+			}
+			return base, nil
+		}
+	}
+	return nil, nil
+}
+
+// MakeFreshestCRLExt returns a non-critical FreshestCRL extension, pointing
+// from a full CRL to the delta CRLs which update it, at the given URLs.
+func MakeFreshestCRLExt(urls []string) (pkix.Extension, error) {
+	var gns []asn1.RawValue
+	for _, url := range urls {
+		gns = append(gns, asn1.RawValue{ // GeneralName
+			Class: 2, // context-specific
+			Tag:   6, // uniformResourceIdentifier, IA5String
+			Bytes: []byte(url),
+		})
+	}
+
+	valBytes, err := asn1.Marshal([]distributionPoint{{
+		DistributionPoint: distributionPointName{FullName: gns},
+	}})
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+
+	return pkix.Extension{
+		Id:    freshestCRLOID,
+		Value: valBytes,
+	}, nil
+}
+
+// GetFreshestCRLURIs returns the URIs contained within the FreshestCRL
+// extension, or nil if there is no such extension.
+func GetFreshestCRLURIs(exts []pkix.Extension) ([]string, error) {
+	for _, ext := range exts {
+		if ext.Id.Equal(freshestCRLOID) {
+			var dps []distributionPoint
+			rest, err := asn1.Unmarshal(ext.Value, &dps)
+			if err != nil {
+				return nil, fmt.Errorf("parsing FreshestCRL extension: %w", err)
+			}
+			if len(rest) != 0 {
+				return nil, fmt.Errorf("parsing FreshestCRL extension: got %d unexpected trailing bytes", len(rest))
+			}
+			var uris []string
+			for _, dp := range dps {
+				for _, generalName := range dp.DistributionPoint.FullName {
+					uris = append(uris, string(generalName.Bytes))
+				}
+			}
+			return uris, nil
+		}
+	}
+	return nil, nil
+}
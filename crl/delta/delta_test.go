@@ -0,0 +1,70 @@
+package delta
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestIndicatorExt(t *testing.T) {
+	t.Parallel()
+
+	ext, err := MakeIndicatorExt(big.NewInt(1700000000000000000))
+	test.AssertNotError(t, err, "should never fail to marshal asn1 to bytes")
+	test.AssertDeepEquals(t, ext.Id, indicatorOID)
+	test.AssertEquals(t, ext.Critical, true)
+
+	base, err := GetBaseCRLNumber([]pkix.Extension{ext})
+	test.AssertNotError(t, err, "parsing DeltaCRLIndicator")
+	test.AssertEquals(t, base.Int64(), int64(1700000000000000000))
+
+	base, err = GetBaseCRLNumber(nil)
+	test.AssertNotError(t, err, "full CRLs have no base")
+	test.Assert(t, base == nil, "full CRLs have no base")
+
+	ext.Value = append(ext.Value, 0)
+	_, err = GetBaseCRLNumber([]pkix.Extension{ext})
+	test.AssertError(t, err, "trailing bytes should fail")
+}
+
+func TestFreshestCRLExt(t *testing.T) {
+	t.Parallel()
+
+	urls := []string{"http://c.example.org/123/4-delta.crl"}
+	ext, err := MakeFreshestCRLExt(urls)
+	test.AssertNotError(t, err, "should never fail to marshal asn1 to bytes")
+	test.AssertDeepEquals(t, ext.Id, freshestCRLOID)
+	test.AssertEquals(t, ext.Critical, false)
+
+	got, err := GetFreshestCRLURIs([]pkix.Extension{ext})
+	test.AssertNotError(t, err, "parsing FreshestCRL")
+	test.AssertDeepEquals(t, got, urls)
+
+	got, err = GetFreshestCRLURIs(nil)
+	test.AssertNotError(t, err, "CRLs need not have a FreshestCRL")
+	test.AssertEquals(t, len(got), 0)
+
+	// The FreshestCRL extension has the same syntax as the CRLDistributionPoints
+	// certificate extension, so the standard library can parse it.
+	crlDPOID := asn1.ObjectIdentifier{2, 5, 29, 31} // id-ce-cRLDistributionPoints
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating test key")
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber:    big.NewInt(1),
+		NotBefore:       time.Now(),
+		NotAfter:        time.Now().Add(time.Hour),
+		ExtraExtensions: []pkix.Extension{{Id: crlDPOID, Value: ext.Value}},
+	}, &x509.Certificate{}, key.Public(), key)
+	test.AssertNotError(t, err, "creating test certificate")
+	cert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "parsing test certificate")
+	test.AssertDeepEquals(t, cert.CRLDistributionPoints, urls)
+}
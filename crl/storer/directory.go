@@ -0,0 +1,131 @@
+package storer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/letsencrypt/boulder/core"
+)
+
+// directoryTarget stores CRLs as files under a local or network-mounted
+// directory, at paths matching their S3 keys, so that the directory can be
+// served directly or synced to an air-gapped host. CRLs are written to a
+// temporary file which is then renamed into place, so readers never see a
+// partial CRL.
+//
+// Versions are the SHA-256 hash of the stored file. The check that the file
+// is unchanged immediately precedes the rename, but isn't atomic with it, so
+// it narrows rather than eliminates races with other crl-storers.
+//
+// The UploadMetadata is not stored: whatever serves the directory is
+// responsible for setting appropriate Cache-Control and Expires headers.
+type directoryTarget struct {
+	name string
+	root string
+}
+
+// NewDirectoryTarget returns a Target which stores CRLs under the given
+// directory, which must already exist.
+func NewDirectoryTarget(name, root string) (Target, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("checking CRL directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("CRL directory %q is not a directory", root)
+	}
+	return &directoryTarget{name: name, root: root}, nil
+}
+
+func (t *directoryTarget) Name() string {
+	return t.name
+}
+
+// path returns the path of the file holding the CRL with the given key.
+func (t *directoryTarget) path(key string) string {
+	return filepath.Join(t.root, filepath.FromSlash(key))
+}
+
+// fileVersion returns the version of the named file, without reading it into
+// memory.
+func fileVersion(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (t *directoryTarget) Get(_ context.Context, key string, maxSize int64) ([]byte, string, error) {
+	f, err := os.Open(t.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, "", ErrNotFound
+		}
+		return nil, "", err
+	}
+	defer f.Close()
+
+	body, err := io.ReadAll(core.ErrOnLimitReader(f, maxSize))
+	if err != nil {
+		return nil, "", err
+	}
+	digest := sha256.Sum256(body)
+	return body, hex.EncodeToString(digest[:]), nil
+}
+
+func (t *directoryTarget) Put(_ context.Context, key string, crl []byte, _ UploadMetadata, prevVersion string) error {
+	name := t.path(key)
+	dir := filepath.Dir(name)
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(name)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(crl)
+	if err == nil {
+		err = tmp.Chmod(0644)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	closeErr := tmp.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	if prevVersion != "" {
+		version, err := fileVersion(name)
+		if err != nil {
+			return fmt.Errorf("re-reading previous CRL: %w", err)
+		}
+		if version != prevVersion {
+			return errors.New("previous CRL was replaced during upload")
+		}
+	}
+
+	return os.Rename(tmp.Name(), name)
+}
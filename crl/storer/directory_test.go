@@ -0,0 +1,62 @@
+package storer
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestDirectoryTarget(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	root := t.TempDir()
+
+	_, err := NewDirectoryTarget("dir", filepath.Join(root, "missing"))
+	test.AssertError(t, err, "missing directory should be rejected")
+
+	target, err := NewDirectoryTarget("dir", root)
+	test.AssertNotError(t, err, "creating directory target")
+	test.AssertEquals(t, target.Name(), "dir")
+
+	_, _, err = target.Get(ctx, "123/4.crl", 1000)
+	test.AssertErrorIs(t, err, ErrNotFound)
+
+	md := UploadMetadata{Number: big.NewInt(1)}
+	err = target.Put(ctx, "123/4.crl", []byte("first"), md, "")
+	test.AssertNotError(t, err, "storing first CRL")
+
+	// The CRL is laid out like its S3 key, and no temporary files are left
+	// behind.
+	stored, err := os.ReadFile(filepath.Join(root, "123", "4.crl"))
+	test.AssertNotError(t, err, "reading stored CRL")
+	test.AssertByteEquals(t, stored, []byte("first"))
+	entries, err := os.ReadDir(filepath.Join(root, "123"))
+	test.AssertNotError(t, err, "listing directory")
+	test.AssertEquals(t, len(entries), 1)
+
+	got, version, err := target.Get(ctx, "123/4.crl", 1000)
+	test.AssertNotError(t, err, "getting first CRL")
+	test.AssertByteEquals(t, got, []byte("first"))
+
+	_, _, err = target.Get(ctx, "123/4.crl", 2)
+	test.AssertError(t, err, "getting an oversized CRL should fail")
+
+	// Another storer replaces the CRL after we read it.
+	err = os.WriteFile(filepath.Join(root, "123", "4.crl"), []byte("other"), 0644)
+	test.AssertNotError(t, err, "replacing CRL")
+	err = target.Put(ctx, "123/4.crl", []byte("second"), md, version)
+	test.AssertError(t, err, "storing over a replaced CRL should fail")
+	test.AssertContains(t, err.Error(), "replaced during upload")
+
+	_, version, err = target.Get(ctx, "123/4.crl", 1000)
+	test.AssertNotError(t, err, "getting replaced CRL")
+	err = target.Put(ctx, "123/4.crl", []byte("second"), md, version)
+	test.AssertNotError(t, err, "storing second CRL")
+	got, _, err = target.Get(ctx, "123/4.crl", 1000)
+	test.AssertNotError(t, err, "getting second CRL")
+	test.AssertByteEquals(t, got, []byte("second"))
+}
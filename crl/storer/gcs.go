@@ -0,0 +1,102 @@
+package storer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+
+	"github.com/letsencrypt/boulder/core"
+)
+
+// DefaultGCSEndpoint is the endpoint of Google Cloud Storage's XML API, which
+// is compatible with the S3 API.
+const DefaultGCSEndpoint = "https://storage.googleapis.com"
+
+// gcsTarget stores CRLs in a Google Cloud Storage bucket, or another bucket
+// which speaks GCS's dialect of the S3 API, using HMAC credentials.
+//
+// GCS doesn't support S3's conditional writes or SHA-256 checksums, so object
+// generation numbers are used as versions instead of ETags, and checksums are
+// left to the transport.
+type gcsTarget struct {
+	name   string
+	client simpleS3
+}
+
+// NewGCSTarget returns a Target which stores CRLs in the client's bucket, using
+// GCS's XML API.
+func NewGCSTarget(name string, client simpleS3) Target {
+	return &gcsTarget{name: name, client: client}
+}
+
+func (t *gcsTarget) Name() string {
+	return t.name
+}
+
+// gcsOptions disables the checksums which the S3 client adds by default, as
+// GCS rejects them, and adds the given middleware.
+func gcsOptions(apiOptions ...func(*middleware.Stack) error) func(*s3.Options) {
+	return func(o *s3.Options) {
+		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+		o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+		o.APIOptions = append(o.APIOptions, apiOptions...)
+	}
+}
+
+func (t *gcsTarget) Get(ctx context.Context, key string, maxSize int64) ([]byte, string, error) {
+	bucket := t.client.Bucket()
+	obj, err := t.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	}, gcsOptions(awsmiddleware.AddRawResponseToMetadata))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, "", ErrNotFound
+		}
+		return nil, "", err
+	}
+	defer obj.Body.Close()
+
+	body, err := io.ReadAll(core.ErrOnLimitReader(obj.Body, maxSize))
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, ok := awsmiddleware.GetRawResponse(obj.ResultMetadata).(*smithyhttp.Response)
+	if !ok {
+		return nil, "", errors.New("missing raw response")
+	}
+	generation := resp.Header.Get("x-goog-generation")
+	if generation == "" {
+		return nil, "", errors.New("response has no x-goog-generation header")
+	}
+	return body, generation, nil
+}
+
+func (t *gcsTarget) Put(ctx context.Context, key string, crl []byte, md UploadMetadata, prevVersion string) error {
+	bucket := t.client.Bucket()
+	crlContentType := "application/pkix-crl"
+
+	var apiOptions []func(*middleware.Stack) error
+	if prevVersion != "" {
+		apiOptions = append(apiOptions, smithyhttp.AddHeaderValue("x-goog-if-generation-match", prevVersion))
+	}
+
+	_, err := t.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:       &bucket,
+		Key:          &key,
+		Body:         bytes.NewReader(crl),
+		ContentType:  &crlContentType,
+		Metadata:     map[string]string{"crlNumber": md.Number.String()},
+		Expires:      &md.Expires,
+		CacheControl: &md.CacheControl,
+	}, gcsOptions(apiOptions...))
+	return err
+}
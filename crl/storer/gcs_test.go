@@ -0,0 +1,114 @@
+package storer
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeGCS implements just enough of GCS's XML API to store objects with
+// generation numbers, and honor generation preconditions on uploads.
+type fakeGCS struct {
+	sync.Mutex
+	objects     map[string][]byte
+	generations map[string]int64
+	nextGen     int64
+}
+
+func (f *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		body, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("x-goog-generation", strconv.FormatInt(f.generations[r.URL.Path], 10))
+		w.Write(body)
+	case http.MethodPut:
+		// GCS doesn't understand S3's checksum headers or chunked encoding.
+		for name := range r.Header {
+			if strings.HasPrefix(strings.ToLower(name), "x-amz-checksum") || strings.HasPrefix(strings.ToLower(name), "x-amz-trailer") {
+				http.Error(w, "unsupported header "+name, http.StatusBadRequest)
+				return
+			}
+		}
+		match := r.Header.Get("x-goog-if-generation-match")
+		if match != "" && match != strconv.FormatInt(f.generations[r.URL.Path], 10) {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.nextGen++
+		f.objects[r.URL.Path] = body
+		f.generations[r.URL.Path] = f.nextGen
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// bucketClient is an s3.Client for a single bucket.
+type bucketClient struct {
+	*s3.Client
+	bucket string
+}
+
+func (c *bucketClient) Bucket() string {
+	return c.bucket
+}
+
+func TestGCSTarget(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	gcs := &fakeGCS{objects: make(map[string][]byte), generations: make(map[string]int64)}
+	srv := httptest.NewServer(gcs)
+	defer srv.Close()
+
+	client := s3.New(s3.Options{
+		Region:       "auto",
+		BaseEndpoint: aws.String(srv.URL),
+		UsePathStyle: true,
+		Credentials:  aws.AnonymousCredentials{},
+	})
+	target := NewGCSTarget("gcs", &bucketClient{client, "crls"})
+
+	_, _, err := target.Get(ctx, "123/4.crl", 1000)
+	test.AssertErrorIs(t, err, ErrNotFound)
+
+	md := UploadMetadata{Number: big.NewInt(1), Expires: time.Now(), CacheControl: "max-age=60"}
+	err = target.Put(ctx, "123/4.crl", []byte("first"), md, "")
+	test.AssertNotError(t, err, "storing first CRL")
+	test.AssertByteEquals(t, gcs.objects["/crls/123/4.crl"], []byte("first"))
+
+	got, version, err := target.Get(ctx, "123/4.crl", 1000)
+	test.AssertNotError(t, err, "getting first CRL")
+	test.AssertByteEquals(t, got, []byte("first"))
+	test.AssertEquals(t, version, "1")
+
+	// Another storer replaces the CRL after we read it.
+	err = target.Put(ctx, "123/4.crl", []byte("other"), md, version)
+	test.AssertNotError(t, err, "storing other CRL")
+	err = target.Put(ctx, "123/4.crl", []byte("second"), md, version)
+	test.AssertError(t, err, "storing over a replaced CRL should fail")
+	test.AssertByteEquals(t, gcs.objects["/crls/123/4.crl"], []byte("other"))
+}
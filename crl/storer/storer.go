@@ -3,44 +3,32 @@ package storer
 import (
 	"bytes"
 	"context"
-	"crypto/sha256"
 	"crypto/x509"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
 	"math/big"
 	"slices"
+	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/s3/types"
-	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/jmhodges/clock"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 
-	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/crl"
+	"github.com/letsencrypt/boulder/crl/delta"
 	"github.com/letsencrypt/boulder/crl/idp"
 	cspb "github.com/letsencrypt/boulder/crl/storer/proto"
 	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
 )
 
-// simpleS3 matches the subset of the s3.Client interface which we use, to allow
-// simpler mocking in tests.
-type simpleS3 interface {
-	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
-	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
-	Bucket() string
-}
-
 type crlStorer struct {
 	cspb.UnsafeCRLStorerServer
-	s3Client         simpleS3
+	targets          []Target
 	issuers          map[issuance.NameID]*issuance.Certificate
 	maxCRLSize       int64
 	uploadCount      *prometheus.CounterVec
@@ -53,12 +41,23 @@ var _ cspb.CRLStorerServer = (*crlStorer)(nil)
 
 func New(
 	issuers []*issuance.Certificate,
-	s3Client simpleS3,
+	targets []Target,
 	maxCRLSize int64,
 	stats prometheus.Registerer,
 	log blog.Logger,
 	clk clock.Clock,
 ) (*crlStorer, error) {
+	if len(targets) == 0 {
+		return nil, errors.New("at least one storage target is required")
+	}
+	names := make(map[string]bool, len(targets))
+	for _, t := range targets {
+		if names[t.Name()] {
+			return nil, fmt.Errorf("duplicate storage target name %q", t.Name())
+		}
+		names[t.Name()] = true
+	}
+
 	issuersByNameID := make(map[issuance.NameID]*issuance.Certificate, len(issuers))
 	for _, issuer := range issuers {
 		issuersByNameID[issuer.NameID()] = issuer
@@ -66,18 +65,18 @@ func New(
 
 	uploadCount := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
 		Name: "crl_storer_uploads",
-		Help: "A counter of the number of CRLs uploaded by crl-storer",
-	}, []string{"issuer", "result"})
+		Help: "A counter of the number of CRLs uploaded by crl-storer, labelled by storage target",
+	}, []string{"issuer", "target", "result"})
 
 	latencyHistogram := promauto.With(stats).NewHistogramVec(prometheus.HistogramOpts{
 		Name:    "crl_storer_upload_times",
-		Help:    "A histogram of the time (in seconds) it took crl-storer to upload CRLs",
+		Help:    "A histogram of the time (in seconds) it took crl-storer to upload CRLs, labelled by storage target",
 		Buckets: []float64{0.01, 0.2, 0.5, 1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000},
-	}, []string{"issuer"})
+	}, []string{"issuer", "target"})
 
 	return &crlStorer{
 		issuers:          issuersByNameID,
-		s3Client:         s3Client,
+		targets:          targets,
 		maxCRLSize:       maxCRLSize,
 		uploadCount:      uploadCount,
 		latencyHistogram: latencyHistogram,
@@ -91,11 +90,13 @@ func New(
 
 // UploadCRL implements the gRPC method of the same name. It takes a stream of
 // bytes as its input, parses and runs some sanity checks on the CRL, and then
-// uploads it to S3.
+// uploads it to every storage target. It returns an error if the upload to any
+// target fails.
 func (cs *crlStorer) UploadCRL(stream grpc.ClientStreamingServer[cspb.UploadCRLRequest, emptypb.Empty]) error {
 	var issuer *issuance.Certificate
 	var shardIdx int64
 	var crlNumber *big.Int
+	var isDelta bool
 	crlBytes := make([]byte, 0)
 	var cacheControl string
 	var expires time.Time
@@ -123,6 +124,7 @@ func (cs *crlStorer) UploadCRL(stream grpc.ClientStreamingServer[cspb.UploadCRLR
 			expires = payload.Metadata.Expires.AsTime()
 
 			shardIdx = payload.Metadata.ShardIdx
+			isDelta = payload.Metadata.Delta
 			crlNumber = crl.Number(time.Unix(0, payload.Metadata.Number))
 
 			var ok bool
@@ -162,38 +164,85 @@ func (cs *crlStorer) UploadCRL(stream grpc.ClientStreamingServer[cspb.UploadCRLR
 		return fmt.Errorf("validating signature for %s: %w", crlId, err)
 	}
 
+	// Delta CRLs are stored under their own name, so that they don't replace
+	// the full CRL which they're relative to.
+	baseNumber, err := delta.GetBaseCRLNumber(crl.Extensions)
+	if err != nil {
+		return fmt.Errorf("getting Delta CRL Indicator for %s: %w", crlId, err)
+	}
+	if (baseNumber != nil) != isDelta {
+		return fmt.Errorf("got mismatched delta CRL metadata for %s: delta=%t", crlId, isDelta)
+	}
+
+	filename := fmt.Sprintf("%d/%d.crl", issuer.NameID(), shardIdx)
+	if isDelta {
+		filename = fmt.Sprintf("%d/%d-delta.crl", issuer.NameID(), shardIdx)
+	}
+	md := UploadMetadata{
+		Number:       crlNumber,
+		Expires:      expires,
+		CacheControl: cacheControl,
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(cs.targets))
+	for i, target := range cs.targets {
+		wg.Go(func() {
+			errs[i] = cs.uploadToTarget(stream.Context(), target, issuer, filename, string(crlId), crl, crlBytes, md)
+		})
+	}
+	wg.Wait()
+
+	err = errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&emptypb.Empty{})
+}
+
+// uploadToTarget stores the CRL in a single storage target, after checking it
+// against the CRL previously stored there.
+func (cs *crlStorer) uploadToTarget(
+	ctx context.Context,
+	target Target,
+	issuer *issuance.Certificate,
+	filename string,
+	crlId string,
+	crl *x509.RevocationList,
+	crlBytes []byte,
+	md UploadMetadata,
+) error {
 	// Before uploading this CRL, we want to compare it against the previous CRL
 	// to ensure that the CRL Number field is not going backwards. This is an
 	// additional safety check against clock skew and potential races, if multiple
 	// crl-updaters are working on the same shard at the same time. We only run
 	// these checks if we found a CRL, so we don't block uploading brand new CRLs.
-	var prevEtag *string
-	filename := fmt.Sprintf("%d/%d.crl", issuer.NameID(), shardIdx)
-	bucket := cs.s3Client.Bucket()
-	prevObj, err := cs.s3Client.GetObject(stream.Context(), &s3.GetObjectInput{
-		Bucket: &bucket,
-		Key:    &filename,
-	})
+	prevBytes, prevVersion, err := target.Get(ctx, filename, cs.maxCRLSize)
 	if err != nil {
-		smithyErr, ok := errors.AsType[*smithyhttp.ResponseError](err)
-		if !ok || smithyErr.HTTPStatusCode() != 404 {
-			return fmt.Errorf("getting previous CRL for %s: %w", crlId, err)
+		if !errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("getting previous CRL for %s from %s: %w", crlId, target.Name(), err)
 		}
-		cs.log.Infof("No previous CRL found for %s, proceeding", crlId)
+		cs.log.Infof("No previous CRL found for %s in %s, proceeding", crlId, target.Name())
 	} else {
-		defer prevObj.Body.Close()
-		prevBytes, err := io.ReadAll(core.ErrOnLimitReader(prevObj.Body, cs.maxCRLSize))
+		prevCRL, err := x509.ParseRevocationList(prevBytes)
 		if err != nil {
-			return fmt.Errorf("downloading previous CRL for %s: %w", crlId, err)
+			return fmt.Errorf("parsing previous CRL for %s from %s: %w", crlId, target.Name(), err)
 		}
 
-		prevCRL, err := x509.ParseRevocationList(prevBytes)
-		if err != nil {
-			return fmt.Errorf("parsing previous CRL for %s: %w", crlId, err)
+		// If an upload to another target failed, the crl-updater retries with
+		// the same CRL Number, so this target may already hold this CRL. The CA
+		// re-signs it on each attempt, and ECDSA signatures are randomized, so
+		// compare the signed contents rather than just the bytes.
+		if crl.Number.Cmp(prevCRL.Number) == 0 &&
+			(bytes.Equal(crlBytes, prevBytes) || bytes.Equal(crl.RawTBSRevocationList, prevCRL.RawTBSRevocationList)) {
+			cs.uploadCount.WithLabelValues(issuer.Subject.CommonName, target.Name(), "success").Inc()
+			cs.log.Infof("CRL %s already uploaded to %s, skipping", crlId, target.Name())
+			return nil
 		}
 
 		if crl.Number.Cmp(prevCRL.Number) <= 0 {
-			return fmt.Errorf("crlNumber not strictly increasing: %d <= %d", crl.Number, prevCRL.Number)
+			return fmt.Errorf("crlNumber not strictly increasing in %s: %d <= %d", target.Name(), crl.Number, prevCRL.Number)
 		}
 
 		idpURIs, err := idp.GetIDPURIs(crl.Extensions)
@@ -203,7 +252,7 @@ func (cs *crlStorer) UploadCRL(stream grpc.ClientStreamingServer[cspb.UploadCRLR
 
 		prevURIs, err := idp.GetIDPURIs(prevCRL.Extensions)
 		if err != nil {
-			return fmt.Errorf("getting previous IDP for %s: %w", crlId, err)
+			return fmt.Errorf("getting previous IDP for %s from %s: %w", crlId, target.Name(), err)
 		}
 
 		uriMatch := false
@@ -214,50 +263,35 @@ func (cs *crlStorer) UploadCRL(stream grpc.ClientStreamingServer[cspb.UploadCRLR
 			}
 		}
 		if !uriMatch {
-			return fmt.Errorf("IDP does not match previous: %v !∩ %v", idpURIs, prevURIs)
+			return fmt.Errorf("IDP does not match previous in %s: %v !∩ %v", target.Name(), idpURIs, prevURIs)
 		}
-
-		// This ensures that the CRL object hasn't been replaced since we downloaded
-		// it above. Prevents races against another storer.
-		prevEtag = prevObj.ETag
 	}
 
-	// Finally actually upload the new CRL.
+	// Finally actually upload the new CRL. Passing the previous version ensures
+	// that the CRL hasn't been replaced since we downloaded it above, which
+	// prevents races against another storer.
 	start := cs.clk.Now()
 
-	checksum := sha256.Sum256(crlBytes)
-	checksumb64 := base64.StdEncoding.EncodeToString(checksum[:])
-	crlContentType := "application/pkix-crl"
-	_, err = cs.s3Client.PutObject(stream.Context(), &s3.PutObjectInput{
-		Bucket:            &bucket,
-		Key:               &filename,
-		Body:              bytes.NewReader(crlBytes),
-		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
-		ChecksumSHA256:    &checksumb64,
-		ContentType:       &crlContentType,
-		Metadata:          map[string]string{"crlNumber": crlNumber.String()},
-		Expires:           &expires,
-		CacheControl:      &cacheControl,
-		IfMatch:           prevEtag,
-	})
+	err = target.Put(ctx, filename, crlBytes, md, prevVersion)
 
 	latency := cs.clk.Now().Sub(start)
-	cs.latencyHistogram.WithLabelValues(issuer.Subject.CommonName).Observe(latency.Seconds())
+	cs.latencyHistogram.WithLabelValues(issuer.Subject.CommonName, target.Name()).Observe(latency.Seconds())
 
 	if err != nil {
-		cs.uploadCount.WithLabelValues(issuer.Subject.CommonName, "failed").Inc()
-		cs.log.AuditErr("CRL upload failed", err, map[string]any{"id": crlId})
-		return fmt.Errorf("uploading to S3: %w", err)
+		cs.uploadCount.WithLabelValues(issuer.Subject.CommonName, target.Name(), "failed").Inc()
+		cs.log.AuditErr("CRL upload failed", err, map[string]any{"id": crlId, "target": target.Name()})
+		return fmt.Errorf("uploading to %s: %w", target.Name(), err)
 	}
 
-	cs.uploadCount.WithLabelValues(issuer.Subject.CommonName, "success").Inc()
+	cs.uploadCount.WithLabelValues(issuer.Subject.CommonName, target.Name(), "success").Inc()
 	cs.log.AuditInfo("CRL uploaded", map[string]any{
 		"id":         crlId,
+		"target":     target.Name(),
 		"issuerCN":   issuer.Subject.CommonName,
 		"thisUpdate": crl.ThisUpdate.Format(time.RFC3339),
 		"nextUpdate": crl.NextUpdate.Format(time.RFC3339),
 		"numEntries": len(crl.RevokedCertificateEntries),
 	})
 
-	return stream.SendAndClose(&emptypb.Empty{})
+	return nil
 }
@@ -9,19 +9,24 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
+	"fmt"
 	"io"
 	"math/big"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/crl/delta"
 	"github.com/letsencrypt/boulder/crl/idp"
 	cspb "github.com/letsencrypt/boulder/crl/storer/proto"
 	"github.com/letsencrypt/boulder/issuance"
@@ -70,7 +75,7 @@ func setupTestUploadCRL(t *testing.T) (*crlStorer, *issuance.Issuer) {
 
 	storer, err := New(
 		[]*issuance.Certificate{r3, issuerE1.Cert},
-		nil,
+		[]Target{NewS3Target("s3", &brokenSimpleS3{})},
 		core.DefaultMaxCRLRead,
 		metrics.NoopRegisterer, blog.NewMock(), clock.NewFake(),
 	)
@@ -390,7 +395,7 @@ func TestUploadCRLSuccess(t *testing.T) {
 	)
 	test.AssertNotError(t, err, "creating test CRL")
 
-	storer.s3Client = &fakeSimpleS3{prevBytes: prevCRLBytes, expectBytes: crlBytes}
+	storer.targets = []Target{NewS3Target("s3", &fakeSimpleS3{prevBytes: prevCRLBytes, expectBytes: crlBytes})}
 	ins <- &cspb.UploadCRLRequest{
 		Payload: &cspb.UploadCRLRequest_CrlChunk{
 			CrlChunk: crlBytes,
@@ -434,7 +439,7 @@ func TestUploadNewCRLSuccess(t *testing.T) {
 	)
 	test.AssertNotError(t, err, "creating test CRL")
 
-	storer.s3Client = &fakeSimpleS3{expectBytes: crlBytes}
+	storer.targets = []Target{NewS3Target("s3", &fakeSimpleS3{expectBytes: crlBytes})}
 	ins <- &cspb.UploadCRLRequest{
 		Payload: &cspb.UploadCRLRequest_CrlChunk{
 			CrlChunk: crlBytes,
@@ -495,7 +500,7 @@ func TestUploadCRLBackwardsNumber(t *testing.T) {
 	)
 	test.AssertNotError(t, err, "creating test CRL")
 
-	storer.s3Client = &fakeSimpleS3{prevBytes: prevCRLBytes, expectBytes: crlBytes}
+	storer.targets = []Target{NewS3Target("s3", &fakeSimpleS3{prevBytes: prevCRLBytes, expectBytes: crlBytes})}
 	ins <- &cspb.UploadCRLRequest{
 		Payload: &cspb.UploadCRLRequest_CrlChunk{
 			CrlChunk: crlBytes,
@@ -554,7 +559,7 @@ func TestUploadCRLBrokenS3(t *testing.T) {
 		iss.Signer,
 	)
 	test.AssertNotError(t, err, "creating test CRL")
-	storer.s3Client = &brokenSimpleS3{}
+	storer.targets = []Target{NewS3Target("s3", &brokenSimpleS3{})}
 	ins <- &cspb.UploadCRLRequest{
 		Payload: &cspb.UploadCRLRequest_CrlChunk{
 			CrlChunk: crlBytes,
@@ -565,3 +570,176 @@ func TestUploadCRLBrokenS3(t *testing.T) {
 	test.AssertError(t, err, "uploading to broken S3 should fail")
 	test.AssertContains(t, err.Error(), "getting previous CRL")
 }
+
+// Test that CRLs are stored in every target, and that a failure in one target
+// doesn't prevent storing the CRL in the others.
+// flakyTarget wraps a Target, and fails the given number of Puts before
+// passing them through.
+type flakyTarget struct {
+	Target
+	failures int
+}
+
+func (f *flakyTarget) Put(ctx context.Context, key string, crl []byte, md UploadMetadata, prevVersion string) error {
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("temporarily unavailable")
+	}
+	return f.Target.Put(ctx, key, crl, md, prevVersion)
+}
+
+func TestUploadCRLMultipleTargets(t *testing.T) {
+	storer, iss := setupTestUploadCRL(t)
+
+	sign := func(entries []x509.RevocationListEntry) []byte {
+		t.Helper()
+		crlBytes, err := x509.CreateRevocationList(
+			rand.Reader,
+			&x509.RevocationList{
+				ThisUpdate:                time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+				NextUpdate:                time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC),
+				Number:                    big.NewInt(1),
+				RevokedCertificateEntries: entries,
+			},
+			iss.Cert.Certificate,
+			iss.Signer,
+		)
+		test.AssertNotError(t, err, "creating test CRL")
+		return crlBytes
+	}
+	crlBytes := sign(nil)
+
+	upload := func(crlBytes []byte) error {
+		ins := make(chan *cspb.UploadCRLRequest, 2)
+		ins <- &cspb.UploadCRLRequest{
+			Payload: &cspb.UploadCRLRequest_Metadata{
+				Metadata: &cspb.CRLMetadata{
+					IssuerNameID: int64(iss.Cert.NameID()),
+					Number:       1,
+				},
+			},
+		}
+		ins <- &cspb.UploadCRLRequest{
+			Payload: &cspb.UploadCRLRequest_CrlChunk{
+				CrlChunk: crlBytes,
+			},
+		}
+		close(ins)
+		return storer.UploadCRL(&fakeUploadCRLServerStream{input: ins})
+	}
+
+	root := t.TempDir()
+	dirTarget, err := NewDirectoryTarget("local", root)
+	test.AssertNotError(t, err, "creating directory target")
+	flakyRoot := t.TempDir()
+	flakyDir, err := NewDirectoryTarget("flaky", flakyRoot)
+	test.AssertNotError(t, err, "creating directory target")
+	storer.targets = []Target{
+		dirTarget,
+		NewS3Target("s3", &fakeSimpleS3{expectBytes: crlBytes}),
+		&flakyTarget{Target: flakyDir, failures: 1},
+	}
+
+	err = upload(crlBytes)
+	test.AssertError(t, err, "uploading to a failing target should fail")
+	test.AssertContains(t, err.Error(), "flaky")
+
+	filename := filepath.Join(fmt.Sprintf("%d", iss.Cert.NameID()), "0.crl")
+	stored, err := os.ReadFile(filepath.Join(root, filename))
+	test.AssertNotError(t, err, "reading CRL from directory target")
+	test.AssertByteEquals(t, stored, crlBytes)
+
+	issuerCN := iss.Cert.Subject.CommonName
+	test.AssertMetricWithLabelsEquals(t, storer.uploadCount, prometheus.Labels{"issuer": issuerCN, "target": "local", "result": "success"}, 1)
+	test.AssertMetricWithLabelsEquals(t, storer.uploadCount, prometheus.Labels{"issuer": issuerCN, "target": "s3", "result": "success"}, 1)
+
+	// The crl-updater's retry has the same CRL Number, and the CA re-signs it.
+	// The targets which already hold it accept it without storing it again, and
+	// the target which failed stores it.
+	resigned := sign(nil)
+	test.Assert(t, !bytes.Equal(resigned, crlBytes), "ECDSA signatures should differ")
+	storer.targets = []Target{dirTarget, storer.targets[2]}
+	err = upload(resigned)
+	test.AssertNotError(t, err, "retrying the upload should succeed")
+	stored, err = os.ReadFile(filepath.Join(root, filename))
+	test.AssertNotError(t, err, "reading CRL from directory target")
+	test.AssertByteEquals(t, stored, crlBytes)
+	stored, err = os.ReadFile(filepath.Join(flakyRoot, filename))
+	test.AssertNotError(t, err, "reading CRL from flaky target")
+	test.AssertByteEquals(t, stored, resigned)
+	test.AssertMetricWithLabelsEquals(t, storer.uploadCount, prometheus.Labels{"issuer": issuerCN, "target": "local", "result": "success"}, 2)
+	test.AssertMetricWithLabelsEquals(t, storer.uploadCount, prometheus.Labels{"issuer": issuerCN, "target": "flaky", "result": "success"}, 1)
+
+	// A different CRL with the same number is still rejected.
+	err = upload(sign([]x509.RevocationListEntry{{SerialNumber: big.NewInt(1), RevocationTime: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)}}))
+	test.AssertError(t, err, "uploading a different CRL with the same number should fail")
+	test.AssertContains(t, err.Error(), "crlNumber not strictly increasing in local")
+}
+
+// Test that delta CRLs are stored alongside, rather than over, the full CRL,
+// and that their metadata must match their extensions.
+func TestUploadDeltaCRL(t *testing.T) {
+	storer, iss := setupTestUploadCRL(t)
+
+	indicator, err := delta.MakeIndicatorExt(big.NewInt(1))
+	test.AssertNotError(t, err, "creating Delta CRL Indicator")
+	deltaBytes, err := x509.CreateRevocationList(
+		rand.Reader,
+		&x509.RevocationList{
+			ThisUpdate:      time.Now(),
+			NextUpdate:      time.Now().Add(time.Hour),
+			Number:          big.NewInt(2),
+			ExtraExtensions: []pkix.Extension{indicator},
+		},
+		iss.Cert.Certificate,
+		iss.Signer,
+	)
+	test.AssertNotError(t, err, "creating test delta CRL")
+
+	upload := func(isDelta bool) error {
+		ins := make(chan *cspb.UploadCRLRequest, 2)
+		ins <- &cspb.UploadCRLRequest{
+			Payload: &cspb.UploadCRLRequest_Metadata{
+				Metadata: &cspb.CRLMetadata{
+					IssuerNameID: int64(iss.Cert.NameID()),
+					Number:       2,
+					Delta:        isDelta,
+				},
+			},
+		}
+		ins <- &cspb.UploadCRLRequest{
+			Payload: &cspb.UploadCRLRequest_CrlChunk{
+				CrlChunk: deltaBytes,
+			},
+		}
+		close(ins)
+		return storer.UploadCRL(&fakeUploadCRLServerStream{input: ins})
+	}
+
+	root := t.TempDir()
+	dirTarget, err := NewDirectoryTarget("local", root)
+	test.AssertNotError(t, err, "creating directory target")
+	storer.targets = []Target{dirTarget}
+
+	err = upload(false)
+	test.AssertError(t, err, "uploading a delta CRL as a full CRL should fail")
+	test.AssertContains(t, err.Error(), "mismatched delta CRL metadata")
+
+	err = upload(true)
+	test.AssertNotError(t, err, "uploading a delta CRL should work")
+
+	issuerDir := filepath.Join(root, fmt.Sprintf("%d", iss.Cert.NameID()))
+	stored, err := os.ReadFile(filepath.Join(issuerDir, "0-delta.crl"))
+	test.AssertNotError(t, err, "reading delta CRL from directory target")
+	test.AssertByteEquals(t, stored, deltaBytes)
+	_, err = os.Stat(filepath.Join(issuerDir, "0.crl"))
+	test.Assert(t, os.IsNotExist(err), "delta CRL should not be stored as the full CRL")
+}
+
+func TestNewNoTargets(t *testing.T) {
+	_, err := New(nil, nil, core.DefaultMaxCRLRead, metrics.NoopRegisterer, blog.NewMock(), clock.NewFake())
+	test.AssertError(t, err, "creating a crl-storer without targets should fail")
+
+	_, err = New(nil, []Target{NewS3Target("s3", &brokenSimpleS3{}), NewS3Target("s3", &brokenSimpleS3{})}, core.DefaultMaxCRLRead, metrics.NoopRegisterer, blog.NewMock(), clock.NewFake())
+	test.AssertError(t, err, "creating a crl-storer with duplicate targets should fail")
+}
@@ -0,0 +1,129 @@
+package storer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+
+	"github.com/letsencrypt/boulder/core"
+)
+
+// ErrNotFound is returned by a Target's Get method when no CRL is stored under
+// the requested key.
+var ErrNotFound = errors.New("no CRL stored under key")
+
+// UploadMetadata is the information about a CRL which is stored alongside it,
+// for those targets which support it.
+type UploadMetadata struct {
+	Number       *big.Int
+	Expires      time.Time
+	CacheControl string
+}
+
+// Target is a location which CRLs can be stored in. Every target lays out CRLs
+// using the same keys, of the form "<issuer NameID>/<shard index>.crl".
+type Target interface {
+	// Name identifies the target in logs and metrics.
+	Name() string
+
+	// Get returns the CRL currently stored under the given key, along with an
+	// opaque version identifying it. It returns ErrNotFound if there is no CRL
+	// stored under the key, and an error if the stored CRL is larger than
+	// maxSize.
+	Get(ctx context.Context, key string, maxSize int64) ([]byte, string, error)
+
+	// Put stores the CRL under the given key. If prevVersion is not empty, Put
+	// fails unless the stored CRL still has that version, to prevent races with
+	// other crl-storers.
+	Put(ctx context.Context, key string, crl []byte, md UploadMetadata, prevVersion string) error
+}
+
+// simpleS3 matches the subset of the s3.Client interface which we use, to allow
+// simpler mocking in tests.
+type simpleS3 interface {
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	Bucket() string
+}
+
+// isNotFound returns true if err is an HTTP 404 from an S3-compatible API.
+func isNotFound(err error) bool {
+	smithyErr, ok := errors.AsType[*smithyhttp.ResponseError](err)
+	return ok && smithyErr.HTTPStatusCode() == 404
+}
+
+// s3Target stores CRLs in an S3 bucket, using object ETags as versions.
+type s3Target struct {
+	name   string
+	client simpleS3
+}
+
+// NewS3Target returns a Target which stores CRLs in the client's S3 bucket.
+func NewS3Target(name string, client simpleS3) Target {
+	return &s3Target{name: name, client: client}
+}
+
+func (t *s3Target) Name() string {
+	return t.name
+}
+
+func (t *s3Target) Get(ctx context.Context, key string, maxSize int64) ([]byte, string, error) {
+	bucket := t.client.Bucket()
+	obj, err := t.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, "", ErrNotFound
+		}
+		return nil, "", err
+	}
+	defer obj.Body.Close()
+
+	body, err := io.ReadAll(core.ErrOnLimitReader(obj.Body, maxSize))
+	if err != nil {
+		return nil, "", err
+	}
+
+	var etag string
+	if obj.ETag != nil {
+		etag = *obj.ETag
+	}
+	return body, etag, nil
+}
+
+func (t *s3Target) Put(ctx context.Context, key string, crl []byte, md UploadMetadata, prevVersion string) error {
+	bucket := t.client.Bucket()
+	checksum := sha256.Sum256(crl)
+	checksumb64 := base64.StdEncoding.EncodeToString(checksum[:])
+	crlContentType := "application/pkix-crl"
+
+	var ifMatch *string
+	if prevVersion != "" {
+		ifMatch = &prevVersion
+	}
+
+	_, err := t.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:            &bucket,
+		Key:               &key,
+		Body:              bytes.NewReader(crl),
+		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+		ChecksumSHA256:    &checksumb64,
+		ContentType:       &crlContentType,
+		Metadata:          map[string]string{"crlNumber": md.Number.String()},
+		Expires:           &md.Expires,
+		CacheControl:      &md.CacheControl,
+		IfMatch:           ifMatch,
+	})
+	return err
+}
@@ -30,9 +30,17 @@ func (cu *crlUpdater) Run(ctx context.Context) error {
 			return
 		}
 
-		// Do work, then sleep for updatePeriod. Rinse, and repeat.
+		// Do work, then sleep for updatePeriod. Rinse, and repeat. If delta CRLs
+		// are enabled, publish one every deltaPeriod while sleeping.
 		ticker := time.NewTicker(cu.updatePeriod)
 		defer ticker.Stop()
+		var deltaTicker *time.Ticker
+		var deltaC <-chan time.Time
+		if cu.deltaPeriod > 0 {
+			deltaTicker = time.NewTicker(cu.deltaPeriod)
+			defer deltaTicker.Stop()
+			deltaC = deltaTicker.C
+		}
 		for {
 			// Check for context cancellation before we do any real work, in case we
 			// overran the last tick and both cases were selectable at the same time.
@@ -50,11 +58,27 @@ func (cu *crlUpdater) Run(ctx context.Context) error {
 				})
 			}
 
-			select {
-			case <-ticker.C:
-				continue
-			case <-ctx.Done():
-				return
+			// Count deltaPeriod from the full CRL which the deltas are relative to.
+			if deltaTicker != nil {
+				deltaTicker.Reset(cu.deltaPeriod)
+			}
+
+		sleep:
+			for {
+				select {
+				case <-ticker.C:
+					break sleep
+				case <-deltaC:
+					atTime := cu.clk.Now()
+					err := cu.updateDelta(ctx, atTime, issuerNameID, shardIdx)
+					if err != nil {
+						cu.log.AuditErr("Generating delta CRL failed", err, map[string]any{
+							"id": crl.Id(issuerNameID, shardIdx, crl.Number(atTime)),
+						})
+					}
+				case <-ctx.Done():
+					return
+				}
 			}
 		}
 	}
@@ -0,0 +1,181 @@
+package updater
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	capb "github.com/letsencrypt/boulder/ca/proto"
+	"github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/crl"
+	"github.com/letsencrypt/boulder/issuance"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// shardKey identifies a single shard of a single issuer.
+type shardKey struct {
+	issuerNameID issuance.NameID
+	shardIdx     int
+}
+
+// deltaBase records the contents of a full CRL, so that delta CRLs can be
+// generated relative to it.
+type deltaBase struct {
+	thisUpdate time.Time
+	// reasons maps the serial of each entry in the full CRL to its reason code.
+	reasons map[string]int32
+}
+
+// setDeltaBase records the entries of a newly-published full CRL as the base
+// for the shard's subsequent delta CRLs.
+func (cu *crlUpdater) setDeltaBase(issuerNameID issuance.NameID, shardIdx int, thisUpdate time.Time, crlEntries []*proto.CRLEntry) {
+	base := &deltaBase{
+		thisUpdate: thisUpdate,
+		reasons:    make(map[string]int32, len(crlEntries)),
+	}
+	for _, entry := range crlEntries {
+		base.reasons[entry.Serial] = entry.Reason
+	}
+
+	cu.basesMu.Lock()
+	defer cu.basesMu.Unlock()
+	// Updates of the same shard can race, e.g. with on-demand regeneration, so
+	// never replace a base with an older one.
+	prev, ok := cu.bases[shardKey{issuerNameID, shardIdx}]
+	if ok && !prev.thisUpdate.Before(thisUpdate) {
+		return
+	}
+	cu.bases[shardKey{issuerNameID, shardIdx}] = base
+}
+
+// getDeltaBase returns the base for the shard's delta CRLs, or nil if this
+// updater hasn't published a full CRL for the shard.
+func (cu *crlUpdater) getDeltaBase(issuerNameID issuance.NameID, shardIdx int) *deltaBase {
+	cu.basesMu.Lock()
+	defer cu.basesMu.Unlock()
+	return cu.bases[shardKey{issuerNameID, shardIdx}]
+}
+
+// dropDeltaBase forgets the given base for the shard's delta CRLs, unless it
+// has already been replaced.
+func (cu *crlUpdater) dropDeltaBase(issuerNameID issuance.NameID, shardIdx int, base *deltaBase) {
+	cu.basesMu.Lock()
+	defer cu.basesMu.Unlock()
+	if cu.bases[shardKey{issuerNameID, shardIdx}] == base {
+		delete(cu.bases, shardKey{issuerNameID, shardIdx})
+	}
+}
+
+// deltaPublished returns true if this updater has published a delta CRL for
+// the shard, so that the shard's full CRLs can point to it. Until then, there
+// may be no delta CRL to serve.
+func (cu *crlUpdater) deltaPublished(issuerNameID issuance.NameID, shardIdx int) bool {
+	cu.basesMu.Lock()
+	defer cu.basesMu.Unlock()
+	return cu.published[shardKey{issuerNameID, shardIdx}]
+}
+
+// updateDelta publishes a delta CRL for a single shard, listing the
+// certificates which have been revoked, or whose revocation reason has changed,
+// since the shard's last full CRL. Certificates which have dropped out of the
+// shard because they expired are not listed, as RFC 5280, Section 5.2.4, allows.
+// The delta CRL's NextUpdate is deltaPeriod after its ThisUpdate, when the next
+// one is due.
+//
+// Delta CRLs take the same lease on the shard as full CRLs, so that only one
+// updater publishes a shard at a time, and release it without changing the
+// shard's thisUpdate. If this updater hasn't published a full CRL for the shard
+// since it started, there's nothing to generate a delta relative to, and
+// updateDelta does nothing.
+func (cu *crlUpdater) updateDelta(ctx context.Context, atTime time.Time, issuerNameID issuance.NameID, shardIdx int) (err error) {
+	base := cu.getDeltaBase(issuerNameID, shardIdx)
+	if base == nil {
+		cu.log.Infof("No base for delta CRL, skipping: issuer=[%d] shardIdx=[%d]", issuerNameID, shardIdx)
+		return nil
+	}
+
+	deadline := cu.clk.Now().Add(cu.updateTimeout)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	crlID := crl.Id(issuerNameID, shardIdx, crl.Number(atTime))
+
+	defer func() {
+		// This func closes over the named return value `err`, so can reference it.
+		result := "success"
+		if err != nil {
+			result = "failed"
+		}
+		cu.deltaCounter.WithLabelValues(cu.issuers[issuerNameID].Subject.CommonName, result).Inc()
+	}()
+
+	_, err = cu.sa.LeaseCRLShard(ctx, &sapb.LeaseCRLShardRequest{
+		IssuerNameID: int64(issuerNameID),
+		MinShardIdx:  int64(shardIdx),
+		MaxShardIdx:  int64(shardIdx),
+		Until:        timestamppb.New(deadline.Add(time.Minute)),
+	})
+	if err != nil {
+		return fmt.Errorf("leasing shard: %w", err)
+	}
+
+	cu.log.Infof("Generating delta CRL shard: id=[%s] base=[%s]", crlID, base.thisUpdate.Format(time.RFC3339Nano))
+
+	crlEntries, err := cu.getShardEntries(ctx, atTime, issuerNameID, shardIdx)
+	if err != nil {
+		return err
+	}
+
+	var deltaEntries []*proto.CRLEntry
+	for _, entry := range crlEntries {
+		reason, ok := base.reasons[entry.Serial]
+		if ok && reason == entry.Reason {
+			continue
+		}
+		deltaEntries = append(deltaEntries, entry)
+	}
+
+	cu.log.Infof("Queried SA for delta CRL shard: id=[%s] shardIdx=[%d] numEntries=[%d]", crlID, shardIdx, len(deltaEntries))
+
+	crlLen, crlHash, err := cu.signAndStore(
+		ctx,
+		&capb.CRLMetadata{
+			IssuerNameID: int64(issuerNameID),
+			ThisUpdate:   timestamppb.New(atTime),
+			ShardIdx:     int64(shardIdx),
+			DeltaBase:    timestamppb.New(base.thisUpdate),
+			NextUpdate:   timestamppb.New(atTime.Add(cu.deltaPeriod)),
+		},
+		deltaEntries,
+		atTime.Add(cu.deltaPeriod).Add(cu.expiresMargin),
+	)
+	if err != nil {
+		return fmt.Errorf("generating delta CRL: %w", err)
+	}
+
+	cu.basesMu.Lock()
+	cu.published[shardKey{issuerNameID, shardIdx}] = true
+	cu.basesMu.Unlock()
+
+	cu.log.Infof(
+		"Generated delta CRL shard: id=[%s] size=[%d] hash=[%x]",
+		crlID, crlLen, crlHash)
+
+	// Release the lease by recording the base's thisUpdate again. The SA refuses
+	// to move a shard's thisUpdate backwards, so this fails if another updater
+	// has since published a newer full CRL, in which case this updater's base is
+	// stale and it stops publishing delta CRLs until its own next full CRL.
+	_, err = cu.sa.UpdateCRLShard(ctx, &sapb.UpdateCRLShardRequest{
+		IssuerNameID: int64(issuerNameID),
+		ShardIdx:     int64(shardIdx),
+		ThisUpdate:   timestamppb.New(base.thisUpdate),
+	})
+	if err != nil {
+		cu.dropDeltaBase(issuerNameID, shardIdx, base)
+		return fmt.Errorf("releasing shard: %w", err)
+	}
+
+	return nil
+}
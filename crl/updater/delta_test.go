@@ -0,0 +1,146 @@
+package updater
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestNewUpdaterDeltaPeriod(t *testing.T) {
+	e1, err := issuance.LoadCertificate("../../test/hierarchy/int-e1.cert.pem")
+	test.AssertNotError(t, err, "loading test issuer")
+
+	for _, deltaPeriod := range []time.Duration{-time.Minute, 6 * time.Hour} {
+		_, err = NewUpdater(
+			[]*issuance.Certificate{e1},
+			2, 18*time.Hour, 24*time.Hour,
+			6*time.Hour, deltaPeriod, time.Minute, 1, 1,
+			"stale-if-error=60",
+			5*time.Minute,
+			&fakeSAC{},
+			&fakeCA{},
+			&fakeStorer{},
+			metrics.NoopRegisterer, blog.NewMock(), clock.NewFake(),
+		)
+		test.AssertError(t, err, "invalid deltaPeriod should fail")
+		test.AssertContains(t, err.Error(), "deltaPeriod")
+	}
+}
+
+func TestUpdateDelta(t *testing.T) {
+	e1, err := issuance.LoadCertificate("../../test/hierarchy/int-e1.cert.pem")
+	test.AssertNotError(t, err, "loading test issuer")
+
+	clk := clock.NewFake()
+	clk.Set(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+	sa := &fakeSAC{maxNotAfter: clk.Now().Add(90 * 24 * time.Hour)}
+	ca := &fakeCA{}
+	uploader := &recordingUploader{}
+	cu, err := NewUpdater(
+		[]*issuance.Certificate{e1},
+		2, 18*time.Hour, 24*time.Hour,
+		6*time.Hour, time.Hour, time.Minute, 1, 1,
+		"stale-if-error=60",
+		5*time.Minute,
+		sa,
+		ca,
+		&fakeStorer{uploaderStream: uploader},
+		metrics.NoopRegisterer, blog.NewMock(), clk,
+	)
+	test.AssertNotError(t, err, "building test crlUpdater")
+
+	ctx := context.Background()
+	issuerCN := e1.Subject.CommonName
+
+	// Without a full CRL to be relative to, no delta CRL is generated.
+	err = cu.updateDelta(ctx, clk.Now(), e1.NameID(), 1)
+	test.AssertNotError(t, err, "skipping delta CRL should not fail")
+	test.AssertEquals(t, len(uploader.crlBody), 0)
+	test.AssertMetricWithLabelsEquals(t, cu.deltaCounter, prometheus.Labels{"issuer": issuerCN}, 0)
+
+	// A full CRL becomes the base for the shard's delta CRLs, but doesn't point
+	// to them until one has been published.
+	baseTime := clk.Now()
+	sa.revokedCerts = revokedCertsStream{entries: []*corepb.CRLEntry{
+		{Serial: "aa", Reason: 0, RevokedAt: timestamppb.New(baseTime.Add(-time.Hour))},
+		{Serial: "bb", Reason: 4, RevokedAt: timestamppb.New(baseTime.Add(-time.Hour))},
+		{Serial: "cc", Reason: 5, RevokedAt: timestamppb.New(baseTime.Add(-time.Hour))},
+	}}
+	err = cu.updateShard(ctx, baseTime, e1.NameID(), 1)
+	test.AssertNotError(t, err, "full CRL should succeed")
+	test.Assert(t, !ca.gcc.metadata.FreshestCRL, "full CRL should not point to unpublished delta CRLs")
+	test.AssertBoxedNil(t, ca.gcc.metadata.DeltaBase, "full CRL should not be a delta CRL")
+	test.AssertBoxedNil(t, ca.gcc.metadata.NextUpdate, "full CRL should not set its NextUpdate")
+	test.Assert(t, !uploader.metadata.Delta, "full CRL should not be stored as a delta CRL")
+
+	// The delta lists new revocations and changed reasons, but not unchanged
+	// entries or entries which have since expired.
+	clk.Add(time.Hour)
+	ca.gcc = generateCRLStream{}
+	*uploader = recordingUploader{}
+	sa.revokedCerts = revokedCertsStream{entries: []*corepb.CRLEntry{
+		{Serial: "aa", Reason: 1, RevokedAt: timestamppb.New(baseTime.Add(-time.Hour))},
+		{Serial: "bb", Reason: 4, RevokedAt: timestamppb.New(baseTime.Add(-time.Hour))},
+		{Serial: "dd", Reason: 0, RevokedAt: timestamppb.New(baseTime.Add(time.Minute))},
+	}}
+	err = cu.updateDelta(ctx, clk.Now(), e1.NameID(), 1)
+	test.AssertNotError(t, err, "delta CRL should succeed")
+	test.AssertEquals(t, ca.gcc.metadata.DeltaBase.AsTime(), baseTime)
+	test.AssertEquals(t, ca.gcc.metadata.NextUpdate.AsTime(), clk.Now().Add(time.Hour))
+	test.Assert(t, !ca.gcc.metadata.FreshestCRL, "delta CRL should not point to delta CRLs")
+	test.Assert(t, uploader.metadata.Delta, "delta CRL should be stored as a delta CRL")
+	test.AssertEquals(t, uploader.metadata.Expires.AsTime(), clk.Now().Add(time.Hour).Add(5*time.Minute))
+	body := string(uploader.crlBody)
+	test.AssertContains(t, body, `"Serial":"aa","Reason":1`)
+	test.AssertContains(t, body, `"Serial":"dd"`)
+	test.AssertNotContains(t, body, `"Serial":"bb"`)
+	test.AssertNotContains(t, body, `"Serial":"cc"`)
+	test.AssertEquals(t, strings.Count(body, "\n"), 2)
+	test.AssertMetricWithLabelsEquals(t, cu.deltaCounter, prometheus.Labels{"issuer": issuerCN, "result": "success"}, 1)
+
+	// The delta CRL released its lease without changing the shard's thisUpdate.
+	test.AssertEquals(t, len(sa.updated), 1)
+	test.AssertEquals(t, sa.updated[0].ThisUpdate.AsTime(), baseTime)
+
+	// A base is never replaced by an older one.
+	cu.setDeltaBase(e1.NameID(), 1, baseTime.Add(-time.Hour), nil)
+	test.AssertEquals(t, cu.getDeltaBase(e1.NameID(), 1).thisUpdate, baseTime)
+
+	// Once a delta CRL has been published, full CRLs point to it.
+	clk.Add(time.Hour)
+	baseTime = clk.Now()
+	err = cu.updateShard(ctx, baseTime, e1.NameID(), 1)
+	test.AssertNotError(t, err, "full CRL should succeed")
+	test.Assert(t, ca.gcc.metadata.FreshestCRL, "full CRL should point to delta CRLs")
+
+	// A delta CRL isn't published if the shard is leased by another updater.
+	clk.Add(time.Hour)
+	*uploader = recordingUploader{}
+	sa.leaseError = errors.New("already leased")
+	err = cu.updateDelta(ctx, clk.Now(), e1.NameID(), 1)
+	test.AssertError(t, err, "delta CRL should fail without a lease")
+	test.AssertContains(t, err.Error(), "leasing shard")
+	test.AssertEquals(t, len(uploader.crlBody), 0)
+	sa.leaseError = nil
+
+	// If the lease can't be released because another updater has published a
+	// newer full CRL, the base is stale, and no more deltas are generated
+	// relative to it.
+	sa.updateError = errors.New("thisUpdate is newer")
+	err = cu.updateDelta(ctx, clk.Now(), e1.NameID(), 1)
+	test.AssertError(t, err, "delta CRL should fail to release its lease")
+	test.AssertContains(t, err.Error(), "releasing shard")
+	test.AssertBoxedNil(t, cu.getDeltaBase(e1.NameID(), 1), "stale base should be dropped")
+}
@@ -0,0 +1,97 @@
+package updater
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/letsencrypt/boulder/crl"
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
+	"github.com/letsencrypt/boulder/issuance"
+)
+
+// RegenerateShards immediately updates each of the requested shards, rather
+// than waiting for their next scheduled update. It uses the same shard leases
+// as Run and RunOnce, so a shard which is already being updated (by this or any
+// other crl-updater) will fail rather than be updated twice at once. It runs as
+// many simultaneous goroutines as the configured maxParallelism, and returns an
+// error describing every shard which could not be updated.
+//
+// Shards are updated on a context which the caller can't cancel, so that a
+// caller which gives up doesn't interrupt updates midway and leave their shards
+// leased. Once the caller's context is done, no further shards are started.
+func (cu *crlUpdater) RegenerateShards(ctx context.Context, req *cupb.RegenerateShardsRequest) (*emptypb.Empty, error) {
+	if req == nil || len(req.Shards) == 0 {
+		return nil, errors.New("incomplete gRPC request message")
+	}
+
+	type workItem struct {
+		issuerNameID issuance.NameID
+		shardIdx     int
+	}
+
+	var work []workItem
+	seen := make(map[workItem]bool)
+	for _, shard := range req.Shards {
+		item := workItem{issuance.NameID(shard.IssuerNameID), int(shard.ShardIdx)}
+		_, ok := cu.issuers[item.issuerNameID]
+		if !ok {
+			return nil, fmt.Errorf("unrecognized issuer %d", shard.IssuerNameID)
+		}
+		if item.shardIdx < 1 || item.shardIdx > cu.numShards {
+			return nil, fmt.Errorf("shard %d out of range [1, %d]", shard.ShardIdx, cu.numShards)
+		}
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		work = append(work, item)
+	}
+
+	atTime := cu.clk.Now()
+	workCtx := context.WithoutCancel(ctx)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	sem := make(chan struct{}, cu.maxParallelism)
+	for i, item := range work {
+		err := ctx.Err()
+		if err == nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+		}
+		if err != nil {
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("%d of %d shards not started: %w", len(work)-i, len(work), err))
+			mu.Unlock()
+			break
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			id := crl.Id(item.issuerNameID, item.shardIdx, crl.Number(atTime))
+			err := cu.updateShardWithRetry(workCtx, atTime, item.issuerNameID, item.shardIdx)
+			if err != nil {
+				cu.log.AuditErr("Regenerating CRL failed", err, map[string]any{"id": id})
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", id, err))
+				mu.Unlock()
+				return
+			}
+			cu.log.AuditInfo("Regenerated CRL on demand", map[string]any{"id": id})
+		})
+	}
+	wg.Wait()
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,97 @@
+package updater
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestRegenerateShards(t *testing.T) {
+	e1, err := issuance.LoadCertificate("../../test/hierarchy/int-e1.cert.pem")
+	test.AssertNotError(t, err, "loading test issuer")
+	r3, err := issuance.LoadCertificate("../../test/hierarchy/int-r3.cert.pem")
+	test.AssertNotError(t, err, "loading test issuer")
+
+	clk := clock.NewFake()
+	clk.Set(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+	sa := &fakeSAC{maxNotAfter: clk.Now().Add(90 * 24 * time.Hour)}
+	mockLog := blog.NewMock()
+	cu, err := NewUpdater(
+		[]*issuance.Certificate{e1, r3},
+		2, 18*time.Hour, 24*time.Hour,
+		6*time.Hour, 0, time.Minute, 1, 1,
+		"stale-if-error=60",
+		5*time.Minute,
+		sa,
+		&fakeCA{gcc: generateCRLStream{}},
+		&fakeStorer{uploaderStream: &noopUploader{}},
+		metrics.NoopRegisterer, mockLog, clk,
+	)
+	test.AssertNotError(t, err, "building test crlUpdater")
+
+	for _, tc := range []struct {
+		name    string
+		req     *cupb.RegenerateShardsRequest
+		wantErr string
+	}{
+		{"nil request", nil, "incomplete"},
+		{"no shards", &cupb.RegenerateShardsRequest{}, "incomplete"},
+		{"unknown issuer", &cupb.RegenerateShardsRequest{Shards: []*cupb.Shard{{IssuerNameID: 1, ShardIdx: 1}}}, "unrecognized issuer"},
+		{"shard zero", &cupb.RegenerateShardsRequest{Shards: []*cupb.Shard{{IssuerNameID: int64(e1.NameID()), ShardIdx: 0}}}, "out of range"},
+		{"shard too large", &cupb.RegenerateShardsRequest{Shards: []*cupb.Shard{{IssuerNameID: int64(e1.NameID()), ShardIdx: 3}}}, "out of range"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := cu.RegenerateShards(context.Background(), tc.req)
+			test.AssertError(t, err, "invalid request should fail")
+			test.AssertContains(t, err.Error(), tc.wantErr)
+		})
+	}
+	test.AssertEquals(t, len(sa.updated), 0)
+
+	// Duplicate shards are only regenerated once.
+	_, err = cu.RegenerateShards(context.Background(), &cupb.RegenerateShardsRequest{Shards: []*cupb.Shard{
+		{IssuerNameID: int64(e1.NameID()), ShardIdx: 2},
+		{IssuerNameID: int64(r3.NameID()), ShardIdx: 1},
+		{IssuerNameID: int64(e1.NameID()), ShardIdx: 2},
+	}})
+	test.AssertNotError(t, err, "regenerating shards")
+	test.AssertEquals(t, len(sa.updated), 2)
+	test.AssertEquals(t, len(mockLog.GetAllMatching("Regenerated CRL on demand")), 2)
+	for _, req := range sa.updated {
+		test.AssertEquals(t, req.ThisUpdate.AsTime(), clk.Now())
+	}
+
+	// A shard which is already leased, for instance by the periodic update loop,
+	// is not regenerated, and every such shard is reported.
+	sa.updated = nil
+	sa.leaseError = errors.New("shard already leased")
+	_, err = cu.RegenerateShards(context.Background(), &cupb.RegenerateShardsRequest{Shards: []*cupb.Shard{
+		{IssuerNameID: int64(e1.NameID()), ShardIdx: 1},
+		{IssuerNameID: int64(r3.NameID()), ShardIdx: 2},
+	}})
+	test.AssertError(t, err, "regenerating leased shards should fail")
+	test.AssertContains(t, err.Error(), "already leased")
+	test.AssertEquals(t, len(sa.updated), 0)
+	test.AssertEquals(t, len(mockLog.GetAllMatching("Regenerating CRL failed")), 2)
+
+	// Once the caller has given up, no more shards are started.
+	sa.leaseError = nil
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = cu.RegenerateShards(ctx, &cupb.RegenerateShardsRequest{Shards: []*cupb.Shard{
+		{IssuerNameID: int64(e1.NameID()), ShardIdx: 1},
+		{IssuerNameID: int64(r3.NameID()), ShardIdx: 2},
+	}})
+	test.AssertError(t, err, "regenerating shards for a canceled caller should fail")
+	test.AssertContains(t, err.Error(), "2 of 2 shards not started")
+	test.AssertEquals(t, len(sa.updated), 0)
+}
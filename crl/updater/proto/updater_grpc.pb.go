@@ -0,0 +1,126 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.20.1
+// source: updater.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CRLUpdater_RegenerateShards_FullMethodName = "/updater.CRLUpdater/RegenerateShards"
+)
+
+// CRLUpdaterClient is the client API for CRLUpdater service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CRLUpdaterClient interface {
+	// RegenerateShards immediately generates and uploads new versions of the
+	// given CRL shards, rather than waiting for their next scheduled update.
+	RegenerateShards(ctx context.Context, in *RegenerateShardsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type cRLUpdaterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCRLUpdaterClient(cc grpc.ClientConnInterface) CRLUpdaterClient {
+	return &cRLUpdaterClient{cc}
+}
+
+func (c *cRLUpdaterClient) RegenerateShards(ctx context.Context, in *RegenerateShardsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CRLUpdater_RegenerateShards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CRLUpdaterServer is the server API for CRLUpdater service.
+// All implementations must embed UnimplementedCRLUpdaterServer
+// for forward compatibility.
+type CRLUpdaterServer interface {
+	// RegenerateShards immediately generates and uploads new versions of the
+	// given CRL shards, rather than waiting for their next scheduled update.
+	RegenerateShards(context.Context, *RegenerateShardsRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCRLUpdaterServer()
+}
+
+// UnimplementedCRLUpdaterServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCRLUpdaterServer struct{}
+
+func (UnimplementedCRLUpdaterServer) RegenerateShards(context.Context, *RegenerateShardsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateShards not implemented")
+}
+func (UnimplementedCRLUpdaterServer) mustEmbedUnimplementedCRLUpdaterServer() {}
+func (UnimplementedCRLUpdaterServer) testEmbeddedByValue()                    {}
+
+// UnsafeCRLUpdaterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CRLUpdaterServer will
+// result in compilation errors.
+type UnsafeCRLUpdaterServer interface {
+	mustEmbedUnimplementedCRLUpdaterServer()
+}
+
+func RegisterCRLUpdaterServer(s grpc.ServiceRegistrar, srv CRLUpdaterServer) {
+	// If the following call pancis, it indicates UnimplementedCRLUpdaterServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CRLUpdater_ServiceDesc, srv)
+}
+
+func _CRLUpdater_RegenerateShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CRLUpdaterServer).RegenerateShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CRLUpdater_RegenerateShards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CRLUpdaterServer).RegenerateShards(ctx, req.(*RegenerateShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CRLUpdater_ServiceDesc is the grpc.ServiceDesc for CRLUpdater service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CRLUpdater_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "updater.CRLUpdater",
+	HandlerType: (*CRLUpdaterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegenerateShards",
+			Handler:    _CRLUpdater_RegenerateShards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "updater.proto",
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/jmhodges/clock"
@@ -18,21 +19,33 @@ import (
 	"github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/crl"
 	cspb "github.com/letsencrypt/boulder/crl/storer/proto"
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
 	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
 type crlUpdater struct {
+	cupb.UnsafeCRLUpdaterServer
+
 	issuers        map[issuance.NameID]*issuance.Certificate
 	numShards      int
 	shardWidth     time.Duration
 	lookbackPeriod time.Duration
 	updatePeriod   time.Duration
+	deltaPeriod    time.Duration
 	updateTimeout  time.Duration
 	maxParallelism int
 	maxAttempts    int
 
+	// bases holds the contents of the most recent full CRL of each shard, which
+	// delta CRLs are generated relative to, and published records the shards
+	// for which a delta CRL has been published. They are only populated if
+	// deltaPeriod is non-zero.
+	basesMu   sync.Mutex
+	bases     map[shardKey]*deltaBase
+	published map[shardKey]bool
+
 	cacheControl  string
 	expiresMargin time.Duration
 
@@ -42,6 +55,7 @@ type crlUpdater struct {
 
 	tickHistogram    *prometheus.HistogramVec
 	updatedCounter   *prometheus.CounterVec
+	deltaCounter     *prometheus.CounterVec
 	sizeBytesGauge   *prometheus.GaugeVec
 	sizeEntriesGauge *prometheus.GaugeVec
 
@@ -49,12 +63,15 @@ type crlUpdater struct {
 	clk clock.Clock
 }
 
+var _ cupb.CRLUpdaterServer = (*crlUpdater)(nil)
+
 func NewUpdater(
 	issuers []*issuance.Certificate,
 	numShards int,
 	shardWidth time.Duration,
 	lookbackPeriod time.Duration,
 	updatePeriod time.Duration,
+	deltaPeriod time.Duration,
 	updateTimeout time.Duration,
 	maxParallelism int,
 	maxAttempts int,
@@ -88,6 +105,10 @@ func NewUpdater(
 		return nil, fmt.Errorf("lookbackPeriod must be at least 2x updatePeriod: %s !< 2 * %s", lookbackPeriod, updatePeriod)
 	}
 
+	if deltaPeriod < 0 || (deltaPeriod > 0 && deltaPeriod >= updatePeriod) {
+		return nil, fmt.Errorf("deltaPeriod must be zero or less than updatePeriod: %s !< %s", deltaPeriod, updatePeriod)
+	}
+
 	if maxParallelism <= 0 {
 		maxParallelism = 1
 	}
@@ -107,6 +128,11 @@ func NewUpdater(
 		Help: "A counter of CRL generation calls labeled by result",
 	}, []string{"issuer", "result"})
 
+	deltaCounter := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "crl_updater_delta_generated",
+		Help: "A counter of delta CRL generation calls labeled by issuer and result",
+	}, []string{"issuer", "result"})
+
 	sizeBytesGauge := promauto.With(stats).NewGaugeVec(prometheus.GaugeOpts{
 		Name: "crl_updater_crl_size_bytes",
 		Help: "The size in bytes of each CRL, labeled by issuer and shard",
@@ -118,25 +144,29 @@ func NewUpdater(
 	}, []string{"issuer", "shard"})
 
 	return &crlUpdater{
-		issuersByNameID,
-		numShards,
-		shardWidth,
-		lookbackPeriod,
-		updatePeriod,
-		updateTimeout,
-		maxParallelism,
-		maxAttempts,
-		cacheControl,
-		expiresMargin,
-		sa,
-		ca,
-		cs,
-		tickHistogram,
-		updatedCounter,
-		sizeBytesGauge,
-		sizeEntriesGauge,
-		log,
-		clk,
+		issuers:          issuersByNameID,
+		numShards:        numShards,
+		shardWidth:       shardWidth,
+		lookbackPeriod:   lookbackPeriod,
+		updatePeriod:     updatePeriod,
+		deltaPeriod:      deltaPeriod,
+		updateTimeout:    updateTimeout,
+		maxParallelism:   maxParallelism,
+		maxAttempts:      maxAttempts,
+		cacheControl:     cacheControl,
+		expiresMargin:    expiresMargin,
+		bases:            make(map[shardKey]*deltaBase),
+		published:        make(map[shardKey]bool),
+		sa:               sa,
+		ca:               ca,
+		cs:               cs,
+		tickHistogram:    tickHistogram,
+		updatedCounter:   updatedCounter,
+		deltaCounter:     deltaCounter,
+		sizeBytesGauge:   sizeBytesGauge,
+		sizeEntriesGauge: sizeEntriesGauge,
+		log:              log,
+		clk:              clk,
 	}, nil
 }
 
@@ -218,6 +248,43 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 
 	cu.log.Infof("Generating CRL shard: id=[%s]", crlID)
 
+	crlEntries, err := cu.getShardEntries(ctx, atTime, issuerNameID, shardIdx)
+	if err != nil {
+		return err
+	}
+
+	cu.log.Infof("Queried SA for CRL shard: id=[%s] shardIdx=[%d] numEntries=[%d]", crlID, shardIdx, len(crlEntries))
+
+	crlLen, crlHash, err := cu.signAndStore(
+		ctx,
+		&capb.CRLMetadata{
+			IssuerNameID: int64(issuerNameID),
+			ThisUpdate:   timestamppb.New(atTime),
+			ShardIdx:     int64(shardIdx),
+			FreshestCRL:  cu.deltaPublished(issuerNameID, shardIdx),
+		},
+		crlEntries,
+		atTime.Add(cu.updatePeriod).Add(cu.expiresMargin),
+	)
+	if err != nil {
+		return err
+	}
+
+	cu.log.Infof(
+		"Generated CRL shard: id=[%s] size=[%d] hash=[%x]",
+		crlID, crlLen, crlHash)
+	cu.sizeBytesGauge.WithLabelValues(cu.issuers[issuerNameID].Subject.CommonName, strconv.Itoa(shardIdx)).Set(float64(crlLen))
+	cu.sizeEntriesGauge.WithLabelValues(cu.issuers[issuerNameID].Subject.CommonName, strconv.Itoa(shardIdx)).Set(float64(len(crlEntries)))
+
+	if cu.deltaPeriod > 0 {
+		cu.setDeltaBase(issuerNameID, shardIdx, atTime, crlEntries)
+	}
+
+	return nil
+}
+
+// getShardEntries gets the list of revoked certs in a shard from the SA.
+func (cu *crlUpdater) getShardEntries(ctx context.Context, atTime time.Time, issuerNameID issuance.NameID, shardIdx int) ([]*proto.CRLEntry, error) {
 	// Query for unexpired certificates, with padding to ensure that revoked certificates show
 	// up in at least one CRL, even if they expire between revocation and CRL generation.
 	expiresAfter := cu.clk.Now().Add(-cu.lookbackPeriod)
@@ -229,7 +296,7 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 		RevokedBefore: timestamppb.New(atTime),
 	})
 	if err != nil {
-		return fmt.Errorf("GetRevokedCertsByShard: %w", err)
+		return nil, fmt.Errorf("GetRevokedCertsByShard: %w", err)
 	}
 
 	var crlEntries []*proto.CRLEntry
@@ -239,30 +306,31 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 			if err == io.EOF {
 				break
 			}
-			return fmt.Errorf("retrieving entry from SA: %w", err)
+			return nil, fmt.Errorf("retrieving entry from SA: %w", err)
 		}
 		crlEntries = append(crlEntries, entry)
 	}
 
-	cu.log.Infof("Queried SA for CRL shard: id=[%s] shardIdx=[%d] numEntries=[%d]", crlID, shardIdx, len(crlEntries))
+	return crlEntries, nil
+}
 
+// signAndStore gets the CA to sign a CRL with the given metadata and entries,
+// and gets the crl-storer to upload it. It returns the size and hash of the
+// signed CRL.
+func (cu *crlUpdater) signAndStore(ctx context.Context, meta *capb.CRLMetadata, crlEntries []*proto.CRLEntry, expires time.Time) (int, []byte, error) {
 	// Send the full list of CRL Entries to the CA.
 	caStream, err := cu.ca.GenerateCRL(ctx)
 	if err != nil {
-		return fmt.Errorf("connecting to CA: %w", err)
+		return 0, nil, fmt.Errorf("connecting to CA: %w", err)
 	}
 
 	err = caStream.Send(&capb.GenerateCRLRequest{
 		Payload: &capb.GenerateCRLRequest_Metadata{
-			Metadata: &capb.CRLMetadata{
-				IssuerNameID: int64(issuerNameID),
-				ThisUpdate:   timestamppb.New(atTime),
-				ShardIdx:     int64(shardIdx),
-			},
+			Metadata: meta,
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("sending CA metadata: %w", err)
+		return 0, nil, fmt.Errorf("sending CA metadata: %w", err)
 	}
 
 	for _, entry := range crlEntries {
@@ -272,13 +340,13 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("sending entry to CA: %w", err)
+			return 0, nil, fmt.Errorf("sending entry to CA: %w", err)
 		}
 	}
 
 	err = caStream.CloseSend()
 	if err != nil {
-		return fmt.Errorf("closing CA request stream: %w", err)
+		return 0, nil, fmt.Errorf("closing CA request stream: %w", err)
 	}
 
 	// Receive the full bytes of the signed CRL from the CA.
@@ -291,7 +359,7 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 			if err == io.EOF {
 				break
 			}
-			return fmt.Errorf("receiving CRL bytes: %w", err)
+			return 0, nil, fmt.Errorf("receiving CRL bytes: %w", err)
 		}
 
 		crlLen += len(out.Chunk)
@@ -302,22 +370,23 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 	// Send the full bytes of the signed CRL to the Storer.
 	csStream, err := cu.cs.UploadCRL(ctx)
 	if err != nil {
-		return fmt.Errorf("connecting to CRLStorer: %w", err)
+		return 0, nil, fmt.Errorf("connecting to CRLStorer: %w", err)
 	}
 
 	err = csStream.Send(&cspb.UploadCRLRequest{
 		Payload: &cspb.UploadCRLRequest_Metadata{
 			Metadata: &cspb.CRLMetadata{
-				IssuerNameID: int64(issuerNameID),
-				Number:       atTime.UnixNano(),
-				ShardIdx:     int64(shardIdx),
+				IssuerNameID: meta.IssuerNameID,
+				Number:       meta.ThisUpdate.AsTime().UnixNano(),
+				ShardIdx:     meta.ShardIdx,
 				CacheControl: cu.cacheControl,
-				Expires:      timestamppb.New(atTime.Add(cu.updatePeriod).Add(cu.expiresMargin)),
+				Expires:      timestamppb.New(expires),
+				Delta:        meta.DeltaBase != nil,
 			},
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("sending CRLStorer metadata: %w", err)
+		return 0, nil, fmt.Errorf("sending CRLStorer metadata: %w", err)
 	}
 
 	for _, chunk := range crlChunks {
@@ -327,20 +396,14 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("uploading CRL bytes: %w", err)
+			return 0, nil, fmt.Errorf("uploading CRL bytes: %w", err)
 		}
 	}
 
 	_, err = csStream.CloseAndRecv()
 	if err != nil {
-		return fmt.Errorf("closing CRLStorer upload stream: %w", err)
+		return 0, nil, fmt.Errorf("closing CRLStorer upload stream: %w", err)
 	}
 
-	cu.log.Infof(
-		"Generated CRL shard: id=[%s] size=[%d] hash=[%x]",
-		crlID, crlLen, crlHash.Sum(nil))
-	cu.sizeBytesGauge.WithLabelValues(cu.issuers[issuerNameID].Subject.CommonName, strconv.Itoa(shardIdx)).Set(float64(crlLen))
-	cu.sizeEntriesGauge.WithLabelValues(cu.issuers[issuerNameID].Subject.CommonName, strconv.Itoa(shardIdx)).Set(float64(len(crlEntries)))
-
-	return nil
+	return crlLen, crlHash.Sum(nil), nil
 }
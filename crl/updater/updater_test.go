@@ -57,6 +57,8 @@ type fakeSAC struct {
 	revokedCerts revokedCertsStream
 	maxNotAfter  time.Time
 	leaseError   error
+	updateError  error
+	updated      []*sapb.UpdateCRLShardRequest
 }
 
 // Return the configured stream.
@@ -71,6 +73,15 @@ func (f *fakeSAC) LeaseCRLShard(_ context.Context, req *sapb.LeaseCRLShardReques
 	return &sapb.LeaseCRLShardResponse{IssuerNameID: req.IssuerNameID, ShardIdx: req.MinShardIdx}, nil
 }
 
+// Record the shards which were marked as updated.
+func (f *fakeSAC) UpdateCRLShard(_ context.Context, req *sapb.UpdateCRLShardRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if f.updateError != nil {
+		return nil, f.updateError
+	}
+	f.updated = append(f.updated, req)
+	return &emptypb.Empty{}, nil
+}
+
 // generateCRLStream implements the streaming API returned from GenerateCRL.
 //
 // Specifically it implements grpc.BidiStreamingClient.
@@ -86,10 +97,11 @@ func (f *fakeSAC) LeaseCRLShard(_ context.Context, req *sapb.LeaseCRLShardReques
 // by the CA, just the plumbing of different components together done by the crl-updater.
 type generateCRLStream struct {
 	grpc.ClientStream
-	chunks  [][]byte
-	nextIdx int
-	sendErr error
-	recvErr error
+	metadata *capb.CRLMetadata
+	chunks   [][]byte
+	nextIdx  int
+	sendErr  error
+	recvErr  error
 }
 
 type crlEntry struct {
@@ -102,6 +114,9 @@ func (f *generateCRLStream) Send(req *capb.GenerateCRLRequest) error {
 	if f.sendErr != nil {
 		return f.sendErr
 	}
+	if t, ok := req.Payload.(*capb.GenerateCRLRequest_Metadata); ok {
+		f.metadata = t.Metadata
+	}
 	if t, ok := req.Payload.(*capb.GenerateCRLRequest_Entry); ok {
 		jsonBytes, err := json.Marshal(crlEntry{
 			Serial:    t.Entry.Serial,
@@ -147,14 +162,18 @@ func (f *fakeCA) GenerateCRL(ctx context.Context, opts ...grpc.CallOption) (grpc
 
 // recordingUploader acts as the streaming part of UploadCRL.
 //
-// Records all uploaded chunks in crlBody.
+// Records the metadata in metadata, and all uploaded chunks in crlBody.
 type recordingUploader struct {
 	grpc.ClientStream
 
-	crlBody []byte
+	metadata *cspb.CRLMetadata
+	crlBody  []byte
 }
 
 func (r *recordingUploader) Send(req *cspb.UploadCRLRequest) error {
+	if t, ok := req.Payload.(*cspb.UploadCRLRequest_Metadata); ok {
+		r.metadata = t.Metadata
+	}
 	if t, ok := req.Payload.(*cspb.UploadCRLRequest_CrlChunk); ok {
 		r.crlBody = append(r.crlBody, t.CrlChunk...)
 	}
@@ -214,6 +233,7 @@ func TestUpdateShard(t *testing.T) {
 		18*time.Hour, // shardWidth
 		24*time.Hour, // lookbackPeriod
 		6*time.Hour,  // updatePeriod
+		0,            // deltaPeriod
 		time.Minute,  // updateTimeout
 		1, 1,
 		"stale-if-error=60",
@@ -384,7 +404,7 @@ func TestUpdateShardWithRetry(t *testing.T) {
 	cu, err := NewUpdater(
 		[]*issuance.Certificate{e1, r3},
 		2, 18*time.Hour, 24*time.Hour,
-		6*time.Hour, time.Minute, 1, 1,
+		6*time.Hour, 0, time.Minute, 1, 1,
 		"stale-if-error=60",
 		5*time.Minute,
 		&fakeSAC{revokedCerts: revokedCertsStream{err: sentinelErr}, maxNotAfter: clk.Now().Add(90 * 24 * time.Hour)},
@@ -0,0 +1,118 @@
+package ctmonitor
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	ctx509 "github.com/google/certificate-transparency-go/x509"
+	"golang.org/x/mod/sumdb/tlog"
+
+	"github.com/letsencrypt/boulder/issuance"
+)
+
+// Entry is a log entry which a log has promised, by issuing an SCT, to
+// incorporate within its Maximum Merge Delay.
+type Entry struct {
+	// LogID is the base64 ID of the log which issued the SCT, as in
+	// loglist.Log.Id.
+	LogID string
+	// Timestamp is the SCT's timestamp.
+	Timestamp time.Time
+	// LeafHash is the Merkle tree hash of the entry's leaf.
+	LeafHash tlog.Hash
+	// LeafIndex is the entry's index in the log, from the SCT's leaf_index
+	// extension, which tiled logs include. It is -1 if the SCT has no such
+	// extension.
+	LeafIndex int64
+}
+
+// EmbeddedEntries returns the log entries promised by each SCT embedded in the
+// given certificate, which must have been issued by issuer. The entries are for
+// the corresponding precertificate, which is what the logs were given.
+func EmbeddedEntries(certDER []byte, issuer *issuance.Certificate) ([]Entry, error) {
+	cert, err := ctx509.ParseCertificate(certDER)
+	if ctx509.IsFatal(err) {
+		return nil, fmt.Errorf("parsing certificate: %w", err)
+	}
+	issuerCert, err := ctx509.ParseCertificate(issuer.Raw)
+	if ctx509.IsFatal(err) {
+		return nil, fmt.Errorf("parsing issuer: %w", err)
+	}
+
+	entries := make([]Entry, 0, len(cert.SCTList.SCTList))
+	for _, serialized := range cert.SCTList.SCTList {
+		var sct ct.SignedCertificateTimestamp
+		rest, err := cttls.Unmarshal(serialized.Val, &sct)
+		if err != nil {
+			return nil, fmt.Errorf("parsing embedded SCT: %w", err)
+		}
+		if len(rest) != 0 {
+			return nil, errors.New("trailing data after embedded SCT")
+		}
+
+		leaf, err := ct.MerkleTreeLeafForEmbeddedSCT([]*ctx509.Certificate{cert, issuerCert}, sct.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("building Merkle tree leaf: %w", err)
+		}
+		// The leaf includes the SCT's extensions, which MerkleTreeLeafForEmbeddedSCT
+		// doesn't know about.
+		leaf.TimestampedEntry.Extensions = sct.Extensions
+		leafHash, err := ct.LeafHashForLeaf(leaf)
+		if err != nil {
+			return nil, err
+		}
+
+		leafIndex, err := parseLeafIndex(sct.Extensions)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, Entry{
+			LogID:     base64.StdEncoding.EncodeToString(sct.LogID.KeyID[:]),
+			Timestamp: time.UnixMilli(int64(sct.Timestamp)),
+			LeafHash:  tlog.Hash(leafHash),
+			LeafIndex: leafIndex,
+		})
+	}
+	return entries, nil
+}
+
+// leafIndexExtensionType identifies the static-ct-api leaf_index SCT extension.
+const leafIndexExtensionType = 0
+
+// parseLeafIndex returns the value of the leaf_index extension in the given SCT
+// extensions, or -1 if there is none. The extensions are a sequence of 1-byte
+// types, each followed by 2-byte length-prefixed data. A leaf_index is a 5-byte
+// big-endian integer.
+func parseLeafIndex(exts ct.CTExtensions) (int64, error) {
+	for len(exts) > 0 {
+		if len(exts) < 3 {
+			return 0, errors.New("malformed SCT extensions")
+		}
+		extType := exts[0]
+		extLen := int(exts[1])<<8 | int(exts[2])
+		exts = exts[3:]
+		if len(exts) < extLen {
+			return 0, errors.New("malformed SCT extensions")
+		}
+		data := exts[:extLen]
+		exts = exts[extLen:]
+
+		if extType != leafIndexExtensionType {
+			continue
+		}
+		if len(data) != 5 {
+			return 0, fmt.Errorf("leaf_index extension has length %d, want 5", len(data))
+		}
+		var index int64
+		for _, b := range data {
+			index = index<<8 | int64(b)
+		}
+		return index, nil
+	}
+	return -1, nil
+}
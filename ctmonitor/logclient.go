@@ -0,0 +1,472 @@
+package ctmonitor
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	ctClient "github.com/google/certificate-transparency-go/client"
+	"github.com/google/certificate-transparency-go/jsonclient"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	"golang.org/x/mod/sumdb/note"
+	"golang.org/x/mod/sumdb/tlog"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+)
+
+// ErrNotIncluded is returned by LogClient.CheckInclusion when an entry is not
+// included in the tree described by the given tree head.
+var ErrNotIncluded = errors.New("entry not included in tree")
+
+// ErrInconsistent is returned by LogClient.CheckConsistency when the tree
+// described by one tree head does not extend the tree described by another.
+var ErrInconsistent = errors.New("tree heads are inconsistent")
+
+// TreeHead is a log's signed tree head (for RFC 6962 logs) or checkpoint (for
+// tiled logs), whose signature has been verified.
+type TreeHead struct {
+	Size      uint64
+	RootHash  tlog.Hash
+	Timestamp time.Time
+}
+
+// LogClient fetches tree heads from a single CT log, and proves that entries
+// are included in them, and that they are consistent with each other.
+type LogClient interface {
+	// TreeHead fetches the log's latest tree head, and verifies its signature.
+	TreeHead(ctx context.Context) (*TreeHead, error)
+	// CheckInclusion returns nil if the entry is included in the tree described
+	// by head, ErrNotIncluded if it is not, or any other error if its inclusion
+	// couldn't be determined.
+	CheckInclusion(ctx context.Context, head *TreeHead, entry Entry) error
+	// CheckConsistency returns nil if the tree described by newer extends the
+	// tree described by older, ErrInconsistent if it does not, or any other
+	// error if their consistency couldn't be determined.
+	CheckConsistency(ctx context.Context, older, newer *TreeHead) error
+}
+
+// NewLogClient returns a LogClient for the given log, using the RFC 6962 API
+// or the static-ct-api as appropriate.
+func NewLogClient(log loglist.Log, userAgent string, httpClient *http.Client) (LogClient, error) {
+	if log.Tiled {
+		return newTiledClient(log, userAgent, httpClient)
+	}
+	return newRFC6962Client(log, userAgent, httpClient)
+}
+
+// checkTrivialConsistency checks the consistency of tree heads which don't need
+// a proof: those of equal size, and those where older is empty or larger than
+// newer. It returns true if it was able to decide.
+func checkTrivialConsistency(older, newer *TreeHead) (bool, error) {
+	switch {
+	case older.Size > newer.Size:
+		return true, fmt.Errorf("%w: tree shrank from size %d to %d", ErrInconsistent, older.Size, newer.Size)
+	case older.Size == newer.Size:
+		if older.RootHash != newer.RootHash {
+			return true, fmt.Errorf("%w: trees of size %d have different root hashes", ErrInconsistent, older.Size)
+		}
+		return true, nil
+	case older.Size == 0:
+		return true, nil
+	}
+	return false, nil
+}
+
+// noopLogger discards jsonclient's logs, which are all variations of "backing
+// off", like the publisher's logAdaptor.
+type noopLogger struct{}
+
+func (noopLogger) Printf(string, ...any) {}
+
+// rfc6962Client proves inclusion and consistency using the RFC 6962 get-sth,
+// get-proof-by-hash, and get-sth-consistency endpoints.
+type rfc6962Client struct {
+	client *ctClient.LogClient
+}
+
+func newRFC6962Client(log loglist.Log, userAgent string, httpClient *http.Client) (*rfc6962Client, error) {
+	client, err := ctClient.New(strings.TrimSuffix(log.MonitoringURL, "/"), httpClient, jsonclient.Options{
+		Logger:       noopLogger{},
+		PublicKeyDER: log.Key,
+		UserAgent:    userAgent,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("making CT client for %q: %w", log.Name, err)
+	}
+	return &rfc6962Client{client: client}, nil
+}
+
+// TreeHead implements LogClient. The CT client verifies the STH's signature,
+// since it was constructed with the log's public key.
+func (c *rfc6962Client) TreeHead(ctx context.Context) (*TreeHead, error) {
+	sth, err := c.client.GetSTH(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching STH: %w", err)
+	}
+	return &TreeHead{
+		Size:      sth.TreeSize,
+		RootHash:  tlog.Hash(sth.SHA256RootHash),
+		Timestamp: time.UnixMilli(int64(sth.Timestamp)),
+	}, nil
+}
+
+// CheckInclusion implements LogClient.
+func (c *rfc6962Client) CheckInclusion(ctx context.Context, head *TreeHead, entry Entry) error {
+	if head.Size == 0 {
+		return ErrNotIncluded
+	}
+	resp, err := c.client.GetProofByHash(ctx, entry.LeafHash[:], head.Size)
+	if err != nil {
+		// RFC 6962 doesn't specify how logs respond to unknown hashes, but in
+		// practice they respond 404 Not Found.
+		rspErr, ok := errors.AsType[jsonclient.RspError](err)
+		if ok && rspErr.StatusCode == http.StatusNotFound {
+			return ErrNotIncluded
+		}
+		return fmt.Errorf("fetching inclusion proof: %w", err)
+	}
+	proof := make(tlog.RecordProof, len(resp.AuditPath))
+	for i, node := range resp.AuditPath {
+		if len(node) != tlog.HashSize {
+			return fmt.Errorf("inclusion proof has node of length %d", len(node))
+		}
+		proof[i] = tlog.Hash(node)
+	}
+	if resp.LeafIndex < 0 || uint64(resp.LeafIndex) >= head.Size {
+		return fmt.Errorf("inclusion proof has leaf index %d outside tree of size %d", resp.LeafIndex, head.Size)
+	}
+	err = tlog.CheckRecord(proof, int64(head.Size), head.RootHash, resp.LeafIndex, entry.LeafHash)
+	if err != nil {
+		return fmt.Errorf("verifying inclusion proof: %w", err)
+	}
+	return nil
+}
+
+// CheckConsistency implements LogClient.
+func (c *rfc6962Client) CheckConsistency(ctx context.Context, older, newer *TreeHead) error {
+	done, err := checkTrivialConsistency(older, newer)
+	if done {
+		return err
+	}
+	resp, err := c.client.GetSTHConsistency(ctx, older.Size, newer.Size)
+	if err != nil {
+		return fmt.Errorf("fetching consistency proof: %w", err)
+	}
+	proof := make(tlog.TreeProof, len(resp))
+	for i, node := range resp {
+		if len(node) != tlog.HashSize {
+			return fmt.Errorf("consistency proof has node of length %d", len(node))
+		}
+		proof[i] = tlog.Hash(node)
+	}
+	err = tlog.CheckTree(proof, int64(newer.Size), newer.RootHash, int64(older.Size), older.RootHash)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInconsistent, err)
+	}
+	return nil
+}
+
+// maxTiledResponseSize bounds the size of checkpoints and tiles. A full tile is
+// 256 hashes of 32 bytes each.
+const maxTiledResponseSize = 1 << 16
+
+// tiledClient proves inclusion and consistency using the static-ct-api
+// checkpoint and tile endpoints, and the leaf index which tiled logs include in
+// their SCTs.
+type tiledClient struct {
+	monitoringURL string
+	userAgent     string
+	verifier      note.Verifier
+	httpClient    *http.Client
+}
+
+func newTiledClient(log loglist.Log, userAgent string, httpClient *http.Client) (*tiledClient, error) {
+	if log.MonitoringURL == "" {
+		return nil, fmt.Errorf("tiled log %q has no monitoring URL", log.Name)
+	}
+	verifier, err := newCheckpointVerifier(checkpointOrigin(log.Url), log.Key)
+	if err != nil {
+		return nil, fmt.Errorf("making checkpoint verifier for %q: %w", log.Name, err)
+	}
+	return &tiledClient{
+		monitoringURL: strings.TrimSuffix(log.MonitoringURL, "/"),
+		userAgent:     userAgent,
+		verifier:      verifier,
+		httpClient:    httpClient,
+	}, nil
+}
+
+// errHTTPNotFound is returned by tiledClient.get on a 404 Not Found response.
+var errHTTPNotFound = errors.New("not found")
+
+// get fetches the given path, relative to the log's monitoring prefix.
+func (c *tiledClient) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.monitoringURL+"/"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("fetching %q: %w", path, errHTTPNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %q: unexpected status %d", path, resp.StatusCode)
+	}
+	body, err := io.ReadAll(core.ErrOnLimitReader(resp.Body, maxTiledResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %w", path, err)
+	}
+	return body, nil
+}
+
+// TreeHead implements LogClient.
+func (c *tiledClient) TreeHead(ctx context.Context) (*TreeHead, error) {
+	body, err := c.get(ctx, "checkpoint")
+	if err != nil {
+		return nil, err
+	}
+	n, err := note.Open(body, note.VerifierList(c.verifier))
+	if err != nil {
+		return nil, fmt.Errorf("verifying checkpoint: %w", err)
+	}
+	size, rootHash, err := parseCheckpoint(n.Text, c.verifier.Name())
+	if err != nil {
+		return nil, err
+	}
+	// note.Open only returns signatures which it has verified, and the only
+	// verifier is the log's, so the first is the log's.
+	sig, err := base64.StdEncoding.DecodeString(n.Sigs[0].Base64)
+	if err != nil || len(sig) < 4 {
+		return nil, errors.New("malformed checkpoint signature")
+	}
+	timestamp, _, err := parseNoteSignature(sig[4:])
+	if err != nil {
+		return nil, err
+	}
+	return &TreeHead{
+		Size:      size,
+		RootHash:  rootHash,
+		Timestamp: time.UnixMilli(int64(timestamp)),
+	}, nil
+}
+
+// CheckInclusion implements LogClient. Rather than asking the log for a proof,
+// it computes one from the tiles covering the entry's leaf index, which
+// tlog.TileHashReader authenticates against the tree head.
+func (c *tiledClient) CheckInclusion(ctx context.Context, head *TreeHead, entry Entry) error {
+	if entry.LeafIndex < 0 {
+		return errors.New("SCT from tiled log has no leaf_index extension")
+	}
+	if uint64(entry.LeafIndex) >= head.Size {
+		return ErrNotIncluded
+	}
+	tree := tlog.Tree{N: int64(head.Size), Hash: head.RootHash}
+	proof, err := tlog.ProveRecord(tree.N, entry.LeafIndex, tlog.TileHashReader(tree, &tileReader{ctx, c}))
+	if err != nil {
+		return fmt.Errorf("reading tiles: %w", err)
+	}
+	err = tlog.CheckRecord(proof, tree.N, tree.Hash, entry.LeafIndex, entry.LeafHash)
+	if err != nil {
+		// The tiles were authenticated against the tree head, so the log has
+		// included something other than our entry at the promised index.
+		return fmt.Errorf("%w: leaf %d has a different hash", ErrNotIncluded, entry.LeafIndex)
+	}
+	return nil
+}
+
+// CheckConsistency implements LogClient. Like CheckInclusion, it computes the
+// proof from tiles authenticated against the newer tree head.
+func (c *tiledClient) CheckConsistency(ctx context.Context, older, newer *TreeHead) error {
+	done, err := checkTrivialConsistency(older, newer)
+	if done {
+		return err
+	}
+	tree := tlog.Tree{N: int64(newer.Size), Hash: newer.RootHash}
+	proof, err := tlog.ProveTree(tree.N, int64(older.Size), tlog.TileHashReader(tree, &tileReader{ctx, c}))
+	if err != nil {
+		return fmt.Errorf("reading tiles: %w", err)
+	}
+	err = tlog.CheckTree(proof, tree.N, tree.Hash, int64(older.Size), older.RootHash)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInconsistent, err)
+	}
+	return nil
+}
+
+// tileReader implements tlog.TileReader by fetching tiles from a tiled log.
+type tileReader struct {
+	ctx    context.Context
+	client *tiledClient
+}
+
+// Height implements tlog.TileReader. The static-ct-api always uses tiles of
+// height 8.
+func (r *tileReader) Height() int {
+	return 8
+}
+
+// ReadTiles implements tlog.TileReader.
+func (r *tileReader) ReadTiles(tiles []tlog.Tile) ([][]byte, error) {
+	data := make([][]byte, len(tiles))
+	for i, tile := range tiles {
+		body, err := r.readTile(tile)
+		if err != nil {
+			return nil, err
+		}
+		data[i] = body
+	}
+	return data, nil
+}
+
+// readTile fetches a single tile. Logs may delete partial tiles once the
+// corresponding full tile exists, so if a partial tile isn't found, readTile
+// falls back to the full tile and truncates it.
+func (r *tileReader) readTile(tile tlog.Tile) ([]byte, error) {
+	want := tile.W * tlog.HashSize
+	body, err := r.client.get(r.ctx, tilePath(tile))
+	if errors.Is(err, errHTTPNotFound) && tile.W < 1<<tile.H {
+		full := tile
+		full.W = 1 << tile.H
+		body, err = r.client.get(r.ctx, tilePath(full))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(body) < want {
+		return nil, fmt.Errorf("tile %q has %d bytes, want %d", tilePath(tile), len(body), want)
+	}
+	return body[:want], nil
+}
+
+// SaveTiles implements tlog.TileReader. Tiles are not cached.
+func (r *tileReader) SaveTiles([]tlog.Tile, [][]byte) {}
+
+// tilePath returns the path of a Merkle tree tile in the static-ct-api, which
+// unlike the Go checksum database omits the tile height.
+func tilePath(tile tlog.Tile) string {
+	return strings.Replace(tile.Path(), "tile/8/", "tile/", 1)
+}
+
+// checkpointOrigin returns the origin line of a tiled log's checkpoints, which
+// is its submission prefix without the scheme or trailing slash.
+func checkpointOrigin(submissionURL string) string {
+	origin := strings.TrimPrefix(submissionURL, "https://")
+	origin = strings.TrimPrefix(origin, "http://")
+	return strings.TrimSuffix(origin, "/")
+}
+
+// parseCheckpoint parses the body of a checkpoint, which consists of the
+// origin, tree size, and base64 root hash on separate lines, optionally
+// followed by extension lines.
+func parseCheckpoint(text string, origin string) (uint64, tlog.Hash, error) {
+	lines := strings.SplitN(text, "\n", 4)
+	if len(lines) < 4 {
+		return 0, tlog.Hash{}, errors.New("malformed checkpoint: too few lines")
+	}
+	if lines[0] != origin {
+		return 0, tlog.Hash{}, fmt.Errorf("checkpoint has origin %q, want %q", lines[0], origin)
+	}
+	size, err := strconv.ParseUint(lines[1], 10, 64)
+	if err != nil {
+		return 0, tlog.Hash{}, fmt.Errorf("malformed checkpoint tree size: %w", err)
+	}
+	rootHash, err := base64.StdEncoding.DecodeString(lines[2])
+	if err != nil || len(rootHash) != tlog.HashSize {
+		return 0, tlog.Hash{}, errors.New("malformed checkpoint root hash")
+	}
+	return size, tlog.Hash(rootHash), nil
+}
+
+// parseNoteSignature parses a static-ct-api checkpoint signature, excluding the
+// key hash. It is a millisecond timestamp followed by an RFC 6962
+// TreeHeadSignature.
+func parseNoteSignature(sig []byte) (uint64, ct.DigitallySigned, error) {
+	if len(sig) < 8 {
+		return 0, ct.DigitallySigned{}, errors.New("checkpoint signature too short")
+	}
+	var ds ct.DigitallySigned
+	rest, err := cttls.Unmarshal(sig[8:], &ds)
+	if err != nil {
+		return 0, ct.DigitallySigned{}, fmt.Errorf("malformed checkpoint signature: %w", err)
+	}
+	if len(rest) != 0 {
+		return 0, ct.DigitallySigned{}, errors.New("trailing data after checkpoint signature")
+	}
+	return binary.BigEndian.Uint64(sig[:8]), ds, nil
+}
+
+// checkpointVerifier implements note.Verifier for the RFC6962NoteSignature
+// type used by static-ct-api logs, which signs an RFC 6962 TreeHeadSignature
+// built from the checkpoint.
+type checkpointVerifier struct {
+	origin   string
+	keyHash  uint32
+	verifier *ct.SignatureVerifier
+}
+
+func newCheckpointVerifier(origin string, spki []byte) (*checkpointVerifier, error) {
+	pub, err := x509.ParsePKIXPublicKey(spki)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := ct.NewSignatureVerifier(pub)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	h.Write([]byte(origin))
+	h.Write([]byte{'\n', 0x05})
+	h.Write(spki)
+	return &checkpointVerifier{
+		origin:   origin,
+		keyHash:  binary.BigEndian.Uint32(h.Sum(nil)),
+		verifier: verifier,
+	}, nil
+}
+
+// Name implements note.Verifier.
+func (v *checkpointVerifier) Name() string {
+	return v.origin
+}
+
+// KeyHash implements note.Verifier.
+func (v *checkpointVerifier) KeyHash() uint32 {
+	return v.keyHash
+}
+
+// Verify implements note.Verifier.
+func (v *checkpointVerifier) Verify(msg, sig []byte) bool {
+	size, rootHash, err := parseCheckpoint(string(msg), v.origin)
+	if err != nil {
+		return false
+	}
+	timestamp, ds, err := parseNoteSignature(sig)
+	if err != nil {
+		return false
+	}
+	return v.verifier.VerifySTHSignature(ct.SignedTreeHead{
+		Version:           ct.V1,
+		TreeSize:          size,
+		Timestamp:         timestamp,
+		SHA256RootHash:    ct.SHA256Hash(rootHash),
+		TreeHeadSignature: ds,
+	}) == nil
+}
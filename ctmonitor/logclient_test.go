@@ -0,0 +1,555 @@
+package ctmonitor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	ctx509 "github.com/google/certificate-transparency-go/x509"
+	"github.com/jmhodges/clock"
+	"golang.org/x/mod/sumdb/note"
+	"golang.org/x/mod/sumdb/tlog"
+
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeLog is a CT log which serves both the RFC 6962 monitoring API and the
+// static-ct-api checkpoint and tiles. Leaves are accepted by addLeaf, but only
+// become part of the tree, and of its signed tree heads, when integrate is
+// called.
+type fakeLog struct {
+	sync.Mutex
+	info loglist.Log
+	key  *ecdsa.PrivateKey
+	clk  clock.Clock
+
+	// stored holds the tlog stored hashes for every accepted leaf.
+	stored  []tlog.Hash
+	indexes map[tlog.Hash]int64
+	// accepted is the number of leaves accepted, and size is the number of
+	// those which have been integrated into the tree.
+	accepted int64
+	size     int64
+	// deletePartialTiles makes the log respond 404 to requests for partial
+	// tiles whose corresponding full tile exists, as logs may.
+	deletePartialTiles bool
+	// frozenAt, if set, is the timestamp of every tree head the log signs, as
+	// if it had stopped advancing its tree.
+	frozenAt time.Time
+
+	server *httptest.Server
+}
+
+func newFakeLog(t *testing.T, name string, tiled bool, clk clock.Clock) *fakeLog {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating log key")
+	spki, err := x509.MarshalPKIXPublicKey(key.Public())
+	test.AssertNotError(t, err, "marshalling log key")
+	logID := sha256.Sum256(spki)
+
+	f := &fakeLog{key: key, clk: clk, indexes: make(map[tlog.Hash]int64)}
+	f.server = httptest.NewServer(f)
+	t.Cleanup(f.server.Close)
+
+	f.info = loglist.Log{
+		Operator:      "Test Operator",
+		Name:          name,
+		Id:            base64.StdEncoding.EncodeToString(logID[:]),
+		Key:           spki,
+		Url:           f.server.URL,
+		MonitoringURL: f.server.URL,
+		MMD:           time.Hour,
+		Tiled:         tiled,
+	}
+	if tiled {
+		f.info.Url = f.server.URL + "/submit/"
+	}
+	return f
+}
+
+func (f *fakeLog) hashReader() tlog.HashReader {
+	return tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		hashes := make([]tlog.Hash, len(indexes))
+		for i, index := range indexes {
+			if index >= int64(len(f.stored)) {
+				return nil, fmt.Errorf("no stored hash %d", index)
+			}
+			hashes[i] = f.stored[index]
+		}
+		return hashes, nil
+	})
+}
+
+// addLeaf accepts a leaf, and returns the index at which it will be integrated.
+func (f *fakeLog) addLeaf(t *testing.T, leafHash tlog.Hash) int64 {
+	t.Helper()
+	f.Lock()
+	defer f.Unlock()
+	hashes, err := tlog.StoredHashesForRecordHash(f.accepted, leafHash, f.hashReader())
+	test.AssertNotError(t, err, "computing stored hashes")
+	f.stored = append(f.stored, hashes...)
+	f.indexes[leafHash] = f.accepted
+	f.accepted++
+	return f.accepted - 1
+}
+
+// nextIndex returns the index at which the next leaf accepted will be
+// integrated.
+func (f *fakeLog) nextIndex() int64 {
+	f.Lock()
+	defer f.Unlock()
+	return f.accepted
+}
+
+// freeze makes the log sign every future tree head with the current time.
+func (f *fakeLog) freeze() {
+	f.Lock()
+	defer f.Unlock()
+	f.frozenAt = f.clk.Now()
+}
+
+// now returns the timestamp of the tree heads the log signs. The caller must
+// hold the lock.
+func (f *fakeLog) now() time.Time {
+	if !f.frozenAt.IsZero() {
+		return f.frozenAt
+	}
+	return f.clk.Now()
+}
+
+// integrate integrates every accepted leaf into the tree.
+func (f *fakeLog) integrate() {
+	f.Lock()
+	defer f.Unlock()
+	f.size = f.accepted
+}
+
+// signTreeHead returns an RFC 6962 TreeHeadSignature over the given tree head.
+func (f *fakeLog) signTreeHead(size uint64, rootHash tlog.Hash, timestamp uint64) (ct.DigitallySigned, error) {
+	input, err := ct.SerializeSTHSignatureInput(ct.SignedTreeHead{
+		Version:        ct.V1,
+		TreeSize:       size,
+		Timestamp:      timestamp,
+		SHA256RootHash: ct.SHA256Hash(rootHash),
+	})
+	if err != nil {
+		return ct.DigitallySigned{}, err
+	}
+	digest := sha256.Sum256(input)
+	sig, err := ecdsa.SignASN1(rand.Reader, f.key, digest[:])
+	if err != nil {
+		return ct.DigitallySigned{}, err
+	}
+	return ct.DigitallySigned{
+		Algorithm: cttls.SignatureAndHashAlgorithm{Hash: cttls.SHA256, Signature: cttls.ECDSA},
+		Signature: sig,
+	}, nil
+}
+
+// checkpointSigner implements note.Signer for the fakeLog's checkpoints.
+type checkpointSigner struct {
+	*checkpointVerifier
+	log *fakeLog
+}
+
+func (s checkpointSigner) Sign(msg []byte) ([]byte, error) {
+	size, rootHash, err := parseCheckpoint(string(msg), s.origin)
+	if err != nil {
+		return nil, err
+	}
+	timestamp := uint64(s.log.now().UnixMilli())
+	ds, err := s.log.signTreeHead(size, rootHash, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	dsBytes, err := cttls.Marshal(ds)
+	if err != nil {
+		return nil, err
+	}
+	return append(binary.BigEndian.AppendUint64(nil, timestamp), dsBytes...), nil
+}
+
+func (f *fakeLog) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	rootHash, err := tlog.TreeHash(f.size, f.hashReader())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/ct/v1/get-sth":
+		timestamp := uint64(f.now().UnixMilli())
+		ds, err := f.signTreeHead(uint64(f.size), rootHash, timestamp)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		dsBytes, err := cttls.Marshal(ds)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(ct.GetSTHResponse{
+			TreeSize:          uint64(f.size),
+			Timestamp:         timestamp,
+			SHA256RootHash:    rootHash[:],
+			TreeHeadSignature: dsBytes,
+		})
+
+	case r.URL.Path == "/ct/v1/get-proof-by-hash":
+		hash, err := base64.StdEncoding.DecodeString(r.URL.Query().Get("hash"))
+		if err != nil || len(hash) != tlog.HashSize {
+			http.Error(w, "bad hash", http.StatusBadRequest)
+			return
+		}
+		treeSize, err := strconv.ParseInt(r.URL.Query().Get("tree_size"), 10, 64)
+		if err != nil || treeSize > f.size {
+			http.Error(w, "bad tree_size", http.StatusBadRequest)
+			return
+		}
+		index, ok := f.indexes[tlog.Hash(hash)]
+		if !ok || index >= treeSize {
+			http.NotFound(w, r)
+			return
+		}
+		proof, err := tlog.ProveRecord(treeSize, index, f.hashReader())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		auditPath := make([][]byte, len(proof))
+		for i := range proof {
+			auditPath[i] = proof[i][:]
+		}
+		_ = json.NewEncoder(w).Encode(ct.GetProofByHashResponse{LeafIndex: index, AuditPath: auditPath})
+
+	case r.URL.Path == "/ct/v1/get-sth-consistency":
+		first, err := strconv.ParseInt(r.URL.Query().Get("first"), 10, 64)
+		if err != nil {
+			http.Error(w, "bad first", http.StatusBadRequest)
+			return
+		}
+		second, err := strconv.ParseInt(r.URL.Query().Get("second"), 10, 64)
+		if err != nil || first > second || second > f.size {
+			http.Error(w, "bad second", http.StatusBadRequest)
+			return
+		}
+		proof, err := tlog.ProveTree(second, first, f.hashReader())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		consistency := make([][]byte, len(proof))
+		for i := range proof {
+			consistency[i] = proof[i][:]
+		}
+		_ = json.NewEncoder(w).Encode(ct.GetSTHConsistencyResponse{Consistency: consistency})
+
+	case r.URL.Path == "/checkpoint":
+		verifier, err := newCheckpointVerifier(checkpointOrigin(f.info.Url), f.info.Key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		text := fmt.Sprintf("%s\n%d\n%s\n", verifier.origin, f.size, base64.StdEncoding.EncodeToString(rootHash[:]))
+		signed, err := note.Sign(&note.Note{Text: text}, checkpointSigner{verifier, f})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(signed)
+
+	case strings.HasPrefix(r.URL.Path, "/tile/"):
+		tile, err := tlog.ParseTilePath(strings.Replace(r.URL.Path[1:], "tile/", "tile/8/", 1))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		if tile.W < 1<<tile.H && f.deletePartialTiles {
+			full := tile
+			full.W = 1 << tile.H
+			_, err := tlog.ReadTileData(full, f.hashReader())
+			if err == nil {
+				http.NotFound(w, r)
+				return
+			}
+		}
+		data, err := tlog.ReadTileData(tile, f.hashReader())
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// testIssuer returns a self-signed issuer certificate and its key.
+func testIssuer(t *testing.T) (*issuance.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating issuer key")
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ctmonitor test issuer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	test.AssertNotError(t, err, "creating issuer certificate")
+	cert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "parsing issuer certificate")
+	issuer, err := issuance.NewCertificate(cert)
+	test.AssertNotError(t, err, "wrapping issuer certificate")
+	return issuer, key
+}
+
+// issueWithSCTs returns a certificate issued by issuer, with an SCT embedded
+// from each of the given logs, which accept the corresponding precertificate
+// entries but do not yet integrate them. The SCTs from tiled logs have
+// leaf_index extensions.
+func issueWithSCTs(t *testing.T, issuer *issuance.Certificate, issuerKey *ecdsa.PrivateKey, serial int64, sctTime time.Time, logs ...*fakeLog) []byte {
+	t.Helper()
+	var list ctx509.SignedCertificateTimestampList
+	for _, l := range logs {
+		logID, err := base64.StdEncoding.DecodeString(l.info.Id)
+		test.AssertNotError(t, err, "decoding log ID")
+		sct := ct.SignedCertificateTimestamp{
+			SCTVersion: ct.V1,
+			LogID:      ct.LogID{KeyID: ct.SHA256Hash(logID)},
+			Timestamp:  uint64(sctTime.UnixMilli()),
+			Signature: ct.DigitallySigned{
+				Algorithm: cttls.SignatureAndHashAlgorithm{Hash: cttls.SHA256, Signature: cttls.ECDSA},
+				Signature: []byte{0},
+			},
+		}
+		if l.info.Tiled {
+			index := l.nextIndex()
+			sct.Extensions = ct.CTExtensions{0, 0, 5, 0, byte(index >> 24), byte(index >> 16), byte(index >> 8), byte(index)}
+		}
+		sctBytes, err := cttls.Marshal(sct)
+		test.AssertNotError(t, err, "marshalling SCT")
+		list.SCTList = append(list.SCTList, ctx509.SerializedSCT{Val: sctBytes})
+	}
+	listBytes, err := cttls.Marshal(list)
+	test.AssertNotError(t, err, "marshalling SCT list")
+	extValue, err := asn1.Marshal(listBytes)
+	test.AssertNotError(t, err, "marshalling SCT list extension")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating subscriber key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		DNSNames:     []string{"example.com"},
+		NotBefore:    sctTime,
+		NotAfter:     sctTime.Add(24 * time.Hour),
+		ExtraExtensions: []pkix.Extension{{
+			Id:    asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2},
+			Value: extValue,
+		}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer.Certificate, key.Public(), issuerKey)
+	test.AssertNotError(t, err, "creating certificate")
+
+	entries, err := EmbeddedEntries(der, issuer)
+	test.AssertNotError(t, err, "getting embedded entries")
+	test.AssertEquals(t, len(entries), len(logs))
+	for i, l := range logs {
+		index := l.addLeaf(t, entries[i].LeafHash)
+		if l.info.Tiled {
+			test.AssertEquals(t, entries[i].LeafIndex, index)
+		}
+	}
+	return der
+}
+
+func TestLogClients(t *testing.T) {
+	t.Parallel()
+	issuer, issuerKey := testIssuer(t)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name               string
+		tiled              bool
+		deletePartialTiles bool
+	}{
+		{"rfc6962", false, false},
+		{"tiled", true, false},
+		{"tiled without partial tiles", true, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			clk := clock.NewFake()
+			clk.Set(time.Now())
+			fl := newFakeLog(t, tc.name, tc.tiled, clk)
+			fl.deletePartialTiles = tc.deletePartialTiles
+			client, err := NewLogClient(fl.info, "test", http.DefaultClient)
+			test.AssertNotError(t, err, "making log client")
+
+			// Fill most of a full tile, so that both full and partial tiles are
+			// read.
+			for i := range 300 {
+				fl.addLeaf(t, tlog.RecordHash([]byte(strconv.Itoa(i))))
+			}
+			der := issueWithSCTs(t, issuer, issuerKey, 1, clk.Now(), fl)
+			entries, err := EmbeddedEntries(der, issuer)
+			test.AssertNotError(t, err, "getting embedded entries")
+			entry := entries[0]
+
+			head, err := client.TreeHead(ctx)
+			test.AssertNotError(t, err, "fetching tree head")
+			test.AssertEquals(t, head.Size, uint64(0))
+			test.Assert(t, head.Timestamp.Equal(clk.Now().Truncate(time.Millisecond)), "tree head should have the log's timestamp")
+			err = client.CheckInclusion(ctx, head, entry)
+			test.AssertErrorIs(t, err, ErrNotIncluded)
+
+			fl.integrate()
+			head, err = client.TreeHead(ctx)
+			test.AssertNotError(t, err, "fetching tree head")
+			test.AssertEquals(t, head.Size, uint64(301))
+			// Complete the tile containing the entry, but prove inclusion in the
+			// tree head which only covers part of it.
+			for i := range 300 {
+				fl.addLeaf(t, tlog.RecordHash([]byte("extra"+strconv.Itoa(i))))
+			}
+			err = client.CheckInclusion(ctx, head, entry)
+			test.AssertNotError(t, err, "checking inclusion")
+
+			// An entry which the log didn't include is not found (RFC 6962), or
+			// found to have been replaced by a different leaf (tiled).
+			other := entry
+			other.LeafHash = tlog.RecordHash([]byte("other"))
+			err = client.CheckInclusion(ctx, head, other)
+			test.AssertErrorIs(t, err, ErrNotIncluded)
+
+			// A tree head signed by a different key is rejected.
+			otherLog := newFakeLog(t, tc.name, tc.tiled, clk)
+			wrongKey := fl.info
+			wrongKey.Key = otherLog.info.Key
+			client, err = NewLogClient(wrongKey, "test", http.DefaultClient)
+			test.AssertNotError(t, err, "making log client")
+			_, err = client.TreeHead(ctx)
+			test.AssertError(t, err, "tree head with wrong key should be rejected")
+		})
+	}
+}
+
+func TestCheckConsistency(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name  string
+		tiled bool
+	}{
+		{"rfc6962", false},
+		{"tiled", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			fl := newFakeLog(t, tc.name, tc.tiled, clock.NewFake())
+			client, err := NewLogClient(fl.info, "test", http.DefaultClient)
+			test.AssertNotError(t, err, "making log client")
+
+			empty, err := client.TreeHead(ctx)
+			test.AssertNotError(t, err, "fetching tree head")
+
+			for i := range 300 {
+				fl.addLeaf(t, tlog.RecordHash([]byte(strconv.Itoa(i))))
+			}
+			fl.integrate()
+			older, err := client.TreeHead(ctx)
+			test.AssertNotError(t, err, "fetching tree head")
+
+			for i := range 50 {
+				fl.addLeaf(t, tlog.RecordHash([]byte("more"+strconv.Itoa(i))))
+			}
+			fl.integrate()
+			newer, err := client.TreeHead(ctx)
+			test.AssertNotError(t, err, "fetching tree head")
+
+			test.AssertNotError(t, client.CheckConsistency(ctx, empty, newer), "checking consistency with empty tree")
+			test.AssertNotError(t, client.CheckConsistency(ctx, older, older), "checking consistency with itself")
+			test.AssertNotError(t, client.CheckConsistency(ctx, older, newer), "checking consistency")
+
+			err = client.CheckConsistency(ctx, newer, older)
+			test.AssertErrorIs(t, err, ErrInconsistent)
+
+			forked := *older
+			forked.RootHash = tlog.RecordHash([]byte("fork"))
+			err = client.CheckConsistency(ctx, &forked, older)
+			test.AssertErrorIs(t, err, ErrInconsistent)
+			err = client.CheckConsistency(ctx, &forked, newer)
+			test.AssertErrorIs(t, err, ErrInconsistent)
+		})
+	}
+}
+
+func TestTiledClientMissingLeafIndex(t *testing.T) {
+	t.Parallel()
+	fl := newFakeLog(t, "tiled", true, clock.NewFake())
+	client, err := NewLogClient(fl.info, "test", http.DefaultClient)
+	test.AssertNotError(t, err, "making log client")
+	err = client.CheckInclusion(context.Background(), &TreeHead{Size: 1}, Entry{LeafIndex: -1})
+	test.AssertError(t, err, "entry without leaf index should fail")
+	test.Assert(t, !errors.Is(err, ErrNotIncluded), "entry without leaf index should not be reported as not included")
+}
+
+func TestParseLeafIndex(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		exts    ct.CTExtensions
+		want    int64
+		wantErr bool
+	}{
+		{"none", nil, -1, false},
+		{"leaf_index", ct.CTExtensions{0, 0, 5, 1, 0, 0, 0, 2}, 1<<32 + 2, false},
+		{"after other extension", ct.CTExtensions{7, 0, 1, 9, 0, 0, 5, 0, 0, 0, 1, 0}, 256, false},
+		{"other extension only", ct.CTExtensions{7, 0, 1, 9}, -1, false},
+		{"wrong length", ct.CTExtensions{0, 0, 4, 0, 0, 0, 1}, 0, true},
+		{"truncated", ct.CTExtensions{0, 0, 5, 0, 0}, 0, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseLeafIndex(tc.exts)
+			if tc.wantErr {
+				test.AssertError(t, err, "parsing malformed extensions should fail")
+				return
+			}
+			test.AssertNotError(t, err, "parsing extensions")
+			test.AssertEquals(t, got, tc.want)
+		})
+	}
+}
@@ -0,0 +1,348 @@
+// Package ctmonitor checks that CT logs incorporate the precertificates they
+// have issued SCTs for within their Maximum Merge Delay (MMD), by sampling
+// recently issued certificates and proving the inclusion of each embedded SCT's
+// entry in the issuing log.
+package ctmonitor
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/events"
+	eventspb "github.com/letsencrypt/boulder/events/proto"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// defaultMMD is the MMD assumed for logs whose log list entry doesn't specify
+// one. It is the MMD required by every current CT log program.
+const defaultMMD = 24 * time.Hour
+
+// The results of monitoring a single SCT, for use as metric labels.
+const (
+	// resultIncluded is an SCT whose entry was proven to be included in its log.
+	resultIncluded = "included"
+	// resultMissedMMD is an SCT whose entry was not included in the log's latest
+	// tree head once more than the log's MMD had passed since the SCT's
+	// timestamp, even if that tree head was signed before then.
+	resultMissedMMD = "missed_mmd"
+	// resultUnverified is an SCT whose inclusion couldn't be determined, e.g.
+	// because its log was unreachable, before it was abandoned.
+	resultUnverified = "unverified"
+	// resultUnknownLog is an SCT from a log which isn't in the log list.
+	resultUnknownLog = "unknown_log"
+)
+
+// certSource is the subset of the SA's read-only gRPC client used by the
+// monitor.
+type certSource interface {
+	events.EventSource
+	GetCertificate(ctx context.Context, req *sapb.Serial, opts ...grpc.CallOption) (*corepb.Certificate, error)
+}
+
+// pendingEntry is an entry whose inclusion has not yet been proven.
+type pendingEntry struct {
+	Entry
+	serial string
+}
+
+// monitoredLog holds a single log's client, and the entries sampled from its
+// SCTs whose inclusion has not yet been proven.
+type monitoredLog struct {
+	info    loglist.Log
+	mmd     time.Duration
+	client  LogClient
+	pending []pendingEntry
+}
+
+type ctMonitor struct {
+	sa           certSource
+	logs         map[string]*monitoredLog
+	issuers      map[issuance.NameID]*issuance.Certificate
+	sampleRate   float64
+	maxAge       time.Duration
+	abandonAfter time.Duration
+
+	// tail reads the issuance events recorded by the SA.
+	tail *events.Tail
+
+	certsCounter     *prometheus.CounterVec
+	sctsCounter      *prometheus.CounterVec
+	pendingGauge     *prometheus.GaugeVec
+	inclusionLatency *prometheus.HistogramVec
+
+	log blog.Logger
+	clk clock.Clock
+}
+
+// New returns a monitor which samples certificates from the issuance events
+// recorded by the SA, and checks the inclusion of their SCTs in the given logs.
+//
+// Each certificate is sampled with probability sampleRate, from batches of at
+// most batchSize events, and only if it was issued at most maxAge ago. Only
+// events created after the monitor starts are read, so that a restart doesn't
+// rescan every retained event. Events which become visible up to lookback after
+// newer events are still read; see events.Tail. An SCT whose inclusion can't be
+// determined is abandoned abandonAfter the log's MMD has elapsed.
+func New(
+	logs loglist.List,
+	issuers []*issuance.Certificate,
+	sampleRate float64,
+	batchSize int64,
+	lookback time.Duration,
+	maxAge time.Duration,
+	abandonAfter time.Duration,
+	sa certSource,
+	httpClient *http.Client,
+	userAgent string,
+	stats prometheus.Registerer,
+	log blog.Logger,
+	clk clock.Clock,
+) (*ctMonitor, error) {
+	if len(logs) == 0 {
+		return nil, errors.New("must have at least one log")
+	}
+
+	if len(issuers) == 0 {
+		return nil, errors.New("must have at least one issuer")
+	}
+
+	if sampleRate <= 0 || sampleRate > 1 {
+		return nil, fmt.Errorf("sampleRate must be in (0, 1], got: %g", sampleRate)
+	}
+
+	if batchSize < 1 {
+		return nil, fmt.Errorf("batchSize must be positive, got: %d", batchSize)
+	}
+
+	if maxAge <= 0 {
+		return nil, fmt.Errorf("maxAge must be positive, got: %s", maxAge)
+	}
+
+	if abandonAfter <= 0 {
+		return nil, fmt.Errorf("abandonAfter must be positive, got: %s", abandonAfter)
+	}
+
+	monitoredLogs := make(map[string]*monitoredLog, len(logs))
+	for _, l := range logs {
+		client, err := NewLogClient(l, userAgent, httpClient)
+		if err != nil {
+			return nil, err
+		}
+		mmd := l.MMD
+		if mmd == 0 {
+			mmd = defaultMMD
+		}
+		monitoredLogs[l.Id] = &monitoredLog{info: l, mmd: mmd, client: client}
+	}
+
+	issuersByNameID := make(map[issuance.NameID]*issuance.Certificate, len(issuers))
+	for _, issuer := range issuers {
+		issuersByNameID[issuer.NameID()] = issuer
+	}
+
+	certsCounter := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "ct_monitor_sampled_certificates",
+		Help: "A counter of certificates sampled for SCT inclusion monitoring, labeled by result (success or failed)",
+	}, []string{"result"})
+
+	sctsCounter := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "ct_monitor_scts",
+		Help: "A counter of monitored SCTs, labeled by log and result (included, missed_mmd, unverified, or unknown_log)",
+	}, []string{"log", "result"})
+
+	pendingGauge := promauto.With(stats).NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ct_monitor_pending_scts",
+		Help: "The number of sampled SCTs whose inclusion has not yet been proven, labeled by log",
+	}, []string{"log"})
+
+	inclusionLatency := promauto.With(stats).NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ct_monitor_inclusion_latency_seconds",
+		Help:    "Time between an SCT's timestamp and the first proof of its inclusion, labeled by log",
+		Buckets: []float64{60, 300, 900, 1800, 3600, 2 * 3600, 4 * 3600, 8 * 3600, 16 * 3600, 24 * 3600},
+	}, []string{"log"})
+
+	return &ctMonitor{
+		sa:               sa,
+		logs:             monitoredLogs,
+		issuers:          issuersByNameID,
+		sampleRate:       sampleRate,
+		maxAge:           maxAge,
+		abandonAfter:     abandonAfter,
+		tail:             events.NewTail(sa, clk.Now(), lookback, batchSize, clk),
+		certsCounter:     certsCounter,
+		sctsCounter:      sctsCounter,
+		pendingGauge:     pendingGauge,
+		inclusionLatency: inclusionLatency,
+		log:              log,
+		clk:              clk,
+	}, nil
+}
+
+// Run samples new certificates and checks pending SCTs once per interval,
+// until the context is canceled.
+func (m *ctMonitor) Run(ctx context.Context, interval time.Duration) error {
+	for {
+		_, err := m.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			m.log.Errf("Monitoring SCT inclusion: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-m.clk.After(interval):
+		}
+	}
+}
+
+// RunOnce samples the certificates issued since the last run, and then checks
+// the inclusion of every pending SCT. It returns the number of SCTs found to
+// have missed their log's MMD, and an error if certificates couldn't be sampled
+// or any log couldn't be checked.
+func (m *ctMonitor) RunOnce(ctx context.Context) (int, error) {
+	err := m.sample(ctx)
+	if err != nil {
+		// Still check the SCTs which are already pending.
+		err = fmt.Errorf("sampling certificates: %w", err)
+	}
+
+	var missed int
+	errs := []error{err}
+	for _, l := range m.logs {
+		n, err := m.checkLog(ctx, l)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("checking %s: %w", l.info.Name, err))
+		}
+		missed += n
+	}
+	return missed, errors.Join(errs...)
+}
+
+// sample reads every issuance event not yet read, and adds the SCTs of a
+// sample of the certificates issued to the logs' pending entries.
+func (m *ctMonitor) sample(ctx context.Context) error {
+	return m.tail.Poll(ctx, func(event *eventspb.Event) error {
+		finalized := event.GetOrderFinalized()
+		if finalized == nil || event.Created.AsTime().Before(m.clk.Now().Add(-m.maxAge)) {
+			return nil
+		}
+		if rand.Float64() >= m.sampleRate {
+			return nil
+		}
+
+		err := m.sampleCert(ctx, finalized.CertificateSerial)
+		if err != nil {
+			m.certsCounter.WithLabelValues("failed").Inc()
+			m.log.Warningf("Sampling certificate: serial=[%s] err=[%s]", finalized.CertificateSerial, err)
+			return nil
+		}
+		m.certsCounter.WithLabelValues("success").Inc()
+		return nil
+	})
+}
+
+// sampleCert adds the entries promised by each of the certificate's SCTs to the
+// pending entries of the log which issued it.
+func (m *ctMonitor) sampleCert(ctx context.Context, serial string) error {
+	certPB, err := m.sa.GetCertificate(ctx, &sapb.Serial{Serial: serial})
+	if err != nil {
+		return fmt.Errorf("getting certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(certPB.Der)
+	if err != nil {
+		return fmt.Errorf("parsing certificate: %w", err)
+	}
+	issuer, ok := m.issuers[issuance.IssuerNameID(cert)]
+	if !ok {
+		return fmt.Errorf("unknown issuer %q", cert.Issuer)
+	}
+
+	entries, err := EmbeddedEntries(certPB.Der, issuer)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		l, ok := m.logs[entry.LogID]
+		if !ok {
+			m.sctsCounter.WithLabelValues(entry.LogID, resultUnknownLog).Inc()
+			m.log.Warningf("SCT from unknown log: serial=[%s] logID=[%s]", serial, entry.LogID)
+			continue
+		}
+		l.pending = append(l.pending, pendingEntry{entry, serial})
+	}
+	return nil
+}
+
+// checkLog fetches the log's latest tree head, and checks the inclusion of each
+// of its pending entries in it. Entries which are included, have missed the
+// log's MMD, or have been pending so long that they are abandoned are removed.
+// An entry missing from the latest tree head has missed the MMD once the MMD
+// has passed, even if the log hasn't signed a newer tree head since: a log
+// whose tree head stops advancing is failing to incorporate its entries. It
+// returns the number of entries which missed the log's MMD.
+func (m *ctMonitor) checkLog(ctx context.Context, l *monitoredLog) (int, error) {
+	if len(l.pending) == 0 {
+		m.pendingGauge.WithLabelValues(l.info.Name).Set(0)
+		return 0, nil
+	}
+
+	head, headErr := l.client.TreeHead(ctx)
+
+	var missed int
+	var stillPending []pendingEntry
+	for _, entry := range l.pending {
+		deadline := entry.Timestamp.Add(l.mmd)
+
+		err := headErr
+		if err == nil {
+			err = l.client.CheckInclusion(ctx, head, entry.Entry)
+		}
+		switch {
+		case err == nil:
+			m.sctsCounter.WithLabelValues(l.info.Name, resultIncluded).Inc()
+			m.inclusionLatency.WithLabelValues(l.info.Name).Observe(m.clk.Since(entry.Timestamp).Seconds())
+			continue
+		case errors.Is(err, ErrNotIncluded) && (head.Timestamp.After(deadline) || m.clk.Now().After(deadline)):
+			m.sctsCounter.WithLabelValues(l.info.Name, resultMissedMMD).Inc()
+			m.log.AuditErr("SCT missed log's MMD", err, map[string]any{
+				"log":           l.info.Name,
+				"serial":        entry.serial,
+				"sctTimestamp":  entry.Timestamp,
+				"mmd":           l.mmd.String(),
+				"treeSize":      head.Size,
+				"treeTimestamp": head.Timestamp,
+			})
+			missed++
+			continue
+		case !errors.Is(err, ErrNotIncluded) && m.clk.Now().After(deadline.Add(m.abandonAfter)):
+			m.sctsCounter.WithLabelValues(l.info.Name, resultUnverified).Inc()
+			m.log.Warningf("Abandoning unverifiable SCT: log=[%s] serial=[%s] sctTimestamp=[%s] err=[%s]",
+				l.info.Name, entry.serial, entry.Timestamp.Format(time.RFC3339), err)
+			continue
+		case headErr == nil && !errors.Is(err, ErrNotIncluded):
+			m.log.Warningf("Checking SCT inclusion: log=[%s] serial=[%s] err=[%s]", l.info.Name, entry.serial, err)
+		}
+		stillPending = append(stillPending, entry)
+	}
+	l.pending = stillPending
+	m.pendingGauge.WithLabelValues(l.info.Name).Set(float64(len(l.pending)))
+
+	if headErr != nil {
+		return missed, fmt.Errorf("fetching tree head: %w", headErr)
+	}
+	return missed, nil
+}
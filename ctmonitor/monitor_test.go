@@ -0,0 +1,200 @@
+package ctmonitor
+
+import (
+	"cmp"
+	"context"
+	"math/big"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	berrors "github.com/letsencrypt/boulder/errors"
+	eventspb "github.com/letsencrypt/boulder/events/proto"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/mocks"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeSA serves issuance events from a fixed slice, honoring CreatedAfter,
+// AfterID, CreatedBefore, and Limit, and certificates from a map of serials to
+// DER.
+type fakeSA struct {
+	events []*eventspb.Event
+	certs  map[string][]byte
+}
+
+func (f *fakeSA) GetIssuanceEvents(_ context.Context, req *sapb.GetIssuanceEventsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[eventspb.Event], error) {
+	sorted := slices.SortedFunc(slices.Values(f.events), func(a, b *eventspb.Event) int {
+		return cmp.Or(a.Created.AsTime().Compare(b.Created.AsTime()), cmp.Compare(a.Id, b.Id))
+	})
+	after := req.CreatedAfter.AsTime()
+	var results []*eventspb.Event
+	for _, event := range sorted {
+		created := event.Created.AsTime()
+		if created.Before(after) || (created.Equal(after) && event.Id <= req.AfterID) {
+			continue
+		}
+		if !created.Before(req.CreatedBefore.AsTime()) || int64(len(results)) >= req.Limit {
+			break
+		}
+		results = append(results, event)
+	}
+	return &mocks.ServerStreamClient[eventspb.Event]{Results: results}, nil
+}
+
+func (f *fakeSA) GetCertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	der, ok := f.certs[req.Serial]
+	if !ok {
+		return nil, berrors.NotFoundError("no certificate with serial %s", req.Serial)
+	}
+	return &corepb.Certificate{Serial: req.Serial, Der: der}, nil
+}
+
+func finalized(id int64, created time.Time, serial int64) *eventspb.Event {
+	return &eventspb.Event{
+		Id:      id,
+		Created: timestamppb.New(created),
+		Payload: &eventspb.Event_OrderFinalized{
+			OrderFinalized: &eventspb.OrderFinalized{CertificateSerial: core.SerialToString(big.NewInt(serial))},
+		},
+	}
+}
+
+func TestMonitor(t *testing.T) {
+	t.Parallel()
+	issuer, issuerKey := testIssuer(t)
+	ctx := context.Background()
+	clk := clock.NewFake()
+	clk.Set(time.Now())
+	// The monitor only reads events created after it starts, so it is created
+	// before any of the sampled certificates are issued.
+	start := clk.Now()
+	t0 := start.Add(3 * time.Minute)
+
+	rfcLog := newFakeLog(t, "rfc6962", false, clk)
+	tiledLog := newFakeLog(t, "tiled", true, clk)
+	brokenLog := newFakeLog(t, "broken", false, clk)
+	brokenLog.server.Close()
+	staleLog := newFakeLog(t, "stale", false, clk)
+	unknownLog := newFakeLog(t, "unknown", false, clk)
+
+	sa := &fakeSA{
+		events: []*eventspb.Event{
+			finalized(1, t0.Add(-2*time.Minute), 1),
+			{
+				Id:      2,
+				Created: timestamppb.New(t0.Add(-2 * time.Minute)),
+				Payload: &eventspb.Event_AccountDeactivated{AccountDeactivated: &eventspb.AccountDeactivated{RegistrationID: 1}},
+			},
+			// Created before the monitor started, so its missing certificate
+			// isn't fetched.
+			finalized(3, start.Add(-2*time.Hour), 99),
+			finalized(4, t0.Add(-2*time.Minute), 2),
+			finalized(5, t0.Add(-2*time.Minute), 3),
+			// Too new to read.
+			finalized(6, t0, 99),
+		},
+		certs: map[string][]byte{
+			core.SerialToString(big.NewInt(1)): issueWithSCTs(t, issuer, issuerKey, 1, t0, rfcLog, tiledLog),
+			core.SerialToString(big.NewInt(2)): issueWithSCTs(t, issuer, issuerKey, 2, t0, rfcLog, brokenLog, staleLog),
+			core.SerialToString(big.NewInt(3)): issueWithSCTs(t, issuer, issuerKey, 3, t0, unknownLog),
+		},
+	}
+
+	m, err := New(
+		loglist.List{rfcLog.info, tiledLog.info, brokenLog.info, staleLog.info},
+		[]*issuance.Certificate{issuer},
+		1, 2, time.Minute, time.Hour, time.Hour,
+		sa, http.DefaultClient, "test",
+		metrics.NoopRegisterer, blog.NewMock(), clk,
+	)
+	test.AssertNotError(t, err, "creating monitor")
+	clk.Set(t0)
+	staleLog.freeze()
+
+	// No log has integrated its entries yet.
+	missed, err := m.RunOnce(ctx)
+	test.AssertError(t, err, "unreachable log should cause an error")
+	test.AssertEquals(t, missed, 0)
+	test.Assert(t, m.tail.Watermark().Equal(t0.Add(-2*time.Minute)), "watermark should be the creation time of the newest event read")
+	test.AssertMetricWithLabelsEquals(t, m.certsCounter, prometheus.Labels{"result": "success"}, 3)
+	test.AssertMetricWithLabelsEquals(t, m.certsCounter, prometheus.Labels{"result": "failed"}, 0)
+	test.AssertMetricWithLabelsEquals(t, m.sctsCounter, prometheus.Labels{"log": unknownLog.info.Id, "result": resultUnknownLog}, 1)
+	test.AssertMetricWithLabelsEquals(t, m.pendingGauge, prometheus.Labels{"log": "rfc6962"}, 2)
+	test.AssertMetricWithLabelsEquals(t, m.pendingGauge, prometheus.Labels{"log": "tiled"}, 1)
+	test.AssertMetricWithLabelsEquals(t, m.pendingGauge, prometheus.Labels{"log": "broken"}, 1)
+	test.AssertMetricWithLabelsEquals(t, m.pendingGauge, prometheus.Labels{"log": "stale"}, 1)
+
+	// After the MMD, the entries in the log which integrated them are included,
+	// and the entries in the logs which didn't have missed the MMD, including
+	// the log whose tree head hasn't advanced since. The entry in the
+	// unreachable log is still pending, until abandonAfter has elapsed.
+	rfcLog.integrate()
+	clk.Add(90 * time.Minute)
+	// Visible only once it's too old to sample, so its missing certificate
+	// isn't fetched.
+	sa.events = append(sa.events, finalized(7, t0.Add(-time.Minute), 99))
+	missed, err = m.RunOnce(ctx)
+	test.AssertError(t, err, "unreachable log should cause an error")
+	test.AssertEquals(t, missed, 2)
+	test.AssertMetricWithLabelsEquals(t, m.certsCounter, prometheus.Labels{"result": "failed"}, 0)
+	test.AssertMetricWithLabelsEquals(t, m.sctsCounter, prometheus.Labels{"log": "rfc6962", "result": resultIncluded}, 2)
+	test.AssertMetricWithLabelsEquals(t, m.sctsCounter, prometheus.Labels{"log": "tiled", "result": resultMissedMMD}, 1)
+	test.AssertMetricWithLabelsEquals(t, m.sctsCounter, prometheus.Labels{"log": "stale", "result": resultMissedMMD}, 1)
+	test.AssertMetricWithLabelsEquals(t, m.pendingGauge, prometheus.Labels{"log": "rfc6962"}, 0)
+	test.AssertMetricWithLabelsEquals(t, m.pendingGauge, prometheus.Labels{"log": "tiled"}, 0)
+	test.AssertMetricWithLabelsEquals(t, m.pendingGauge, prometheus.Labels{"log": "broken"}, 1)
+	test.AssertMetricWithLabelsEquals(t, m.pendingGauge, prometheus.Labels{"log": "stale"}, 0)
+	test.AssertHistogramBucketCount(t, m.inclusionLatency, prometheus.Labels{"log": "rfc6962"}, 2*3600, 2)
+
+	clk.Add(time.Hour)
+	missed, err = m.RunOnce(ctx)
+	test.AssertError(t, err, "unreachable log should cause an error")
+	test.AssertEquals(t, missed, 0)
+	test.AssertMetricWithLabelsEquals(t, m.sctsCounter, prometheus.Labels{"log": "broken", "result": resultUnverified}, 1)
+	test.AssertMetricWithLabelsEquals(t, m.pendingGauge, prometheus.Labels{"log": "broken"}, 0)
+}
+
+func TestNewValidation(t *testing.T) {
+	t.Parallel()
+	issuer, _ := testIssuer(t)
+	fl := newFakeLog(t, "log", false, clock.NewFake())
+
+	for _, tc := range []struct {
+		name         string
+		sampleRate   float64
+		batchSize    int64
+		maxAge       time.Duration
+		abandonAfter time.Duration
+	}{
+		{"zero sampleRate", 0, 1, time.Hour, time.Hour},
+		{"sampleRate above one", 1.5, 1, time.Hour, time.Hour},
+		{"zero batchSize", 1, 0, time.Hour, time.Hour},
+		{"zero maxAge", 1, 1, 0, time.Hour},
+		{"zero abandonAfter", 1, 1, time.Hour, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(
+				loglist.List{fl.info},
+				[]*issuance.Certificate{issuer},
+				tc.sampleRate, tc.batchSize, time.Minute, tc.maxAge, tc.abandonAfter,
+				&fakeSA{}, http.DefaultClient, "test",
+				metrics.NoopRegisterer, blog.NewMock(), clock.NewFake(),
+			)
+			test.AssertError(t, err, "invalid configuration should fail")
+		})
+	}
+}
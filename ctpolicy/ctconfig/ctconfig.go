@@ -28,4 +28,29 @@ type CTConfig struct {
 	// SubmitToTestLogs enables inclusion of "test" logs when obtaining SCTs.
 	// This should only be used in test environments.
 	SubmitToTestLogs bool
+	// LogListRefresh, if set, causes the log list to be periodically reloaded
+	// so that changes to the states of the configured logs take effect without
+	// a restart.
+	LogListRefresh *LogListRefreshConfig `validate:"omitempty"`
+}
+
+// LogListRefreshConfig configures periodic reloading of the CT log list.
+type LogListRefreshConfig struct {
+	// Source is the path, or http:// or https:// URL, from which the log list
+	// is reloaded. If empty, LogListFile is reloaded.
+	Source string
+	// SignatureSource is the path or URL of a detached signature over the log
+	// list, such as https://www.gstatic.com/ct/log_list/v3/log_list.sig. It is
+	// required if SignatureKeyFile is set.
+	SignatureSource string `validate:"required_with=SignatureKeyFile"`
+	// SignatureKeyFile is the path to a PEM-encoded public key with which to
+	// verify the log list's signature. It is required if Source is a URL. If
+	// empty, the signature isn't checked.
+	SignatureKeyFile string
+	// Interval is how often to reload the log list.
+	Interval config.Duration `validate:"required"`
+	// MinOperators is the number of distinct operators which the configured SCT
+	// logs must have in a reloaded log list for it to be accepted. Defaults to
+	// 2, the minimum required by Chrome's CT policy.
+	MinOperators int `validate:"omitempty,min=2"`
 }
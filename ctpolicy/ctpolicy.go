@@ -3,8 +3,10 @@ package ctpolicy
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -26,13 +28,23 @@ const (
 // groupings
 type CTPolicy struct {
 	pub              pubpb.PublisherClient
-	sctLogs          loglist.List
-	infoLogs         loglist.List
-	finalLogs        loglist.List
+	logs             atomic.Pointer[logLists]
 	stagger          time.Duration
+	health           *healthTracker
 	log              blog.Logger
 	winnerCounter    *prometheus.CounterVec
 	shardExpiryGauge *prometheus.GaugeVec
+	reloadCounter    *prometheus.CounterVec
+	lastReloadGauge  prometheus.Gauge
+}
+
+// logLists holds the logs to which a CTPolicy submits. They are replaced
+// together when the log list is reloaded, so that every submission sees a
+// consistent set.
+type logLists struct {
+	sct   loglist.List
+	info  loglist.List
+	final loglist.List
 }
 
 // New creates a new CTPolicy struct
@@ -47,14 +59,15 @@ func New(pub pubpb.PublisherClient, sctLogs loglist.List, infoLogs loglist.List,
 		Help: "CT shard end_exclusive field expressed as Unix epoch time, by operator and logID.",
 	}, []string{"operator", "logID"})
 
-	for _, log := range sctLogs {
-		if log.EndExclusive.IsZero() {
-			// Handles the case for non-temporally sharded logs too.
-			shardExpiryGauge.WithLabelValues(log.Operator, log.Name).Set(float64(0))
-		} else {
-			shardExpiryGauge.WithLabelValues(log.Operator, log.Name).Set(float64(log.EndExclusive.Unix()))
-		}
-	}
+	reloadCounter := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "ct_log_list_reloads",
+		Help: "Counter of attempts to reload the CT log list, by result (succeeded, failed, or rejected).",
+	}, []string{"result"})
+
+	lastReloadGauge := promauto.With(stats).NewGauge(prometheus.GaugeOpts{
+		Name: "ct_log_list_last_reload_seconds",
+		Help: "The Unix time at which the CT log list was last successfully reloaded.",
+	})
 
 	// Stagger must be positive for time.Ticker.
 	// Default to the relatively safe value of 1 second.
@@ -62,15 +75,33 @@ func New(pub pubpb.PublisherClient, sctLogs loglist.List, infoLogs loglist.List,
 		stagger = time.Second
 	}
 
-	return &CTPolicy{
+	ctp := &CTPolicy{
 		pub:              pub,
-		sctLogs:          sctLogs,
-		infoLogs:         infoLogs,
-		finalLogs:        finalLogs,
 		stagger:          stagger,
+		health:           newHealthTracker(stagger, stats),
 		log:              log,
 		winnerCounter:    winnerCounter,
 		shardExpiryGauge: shardExpiryGauge,
+		reloadCounter:    reloadCounter,
+		lastReloadGauge:  lastReloadGauge,
+	}
+	ctp.setLogs(sctLogs, infoLogs, finalLogs)
+	return ctp
+}
+
+// setLogs atomically replaces the logs to which the CTPolicy submits, and
+// updates the shard expiry metrics to match.
+func (ctp *CTPolicy) setLogs(sctLogs loglist.List, infoLogs loglist.List, finalLogs loglist.List) {
+	ctp.logs.Store(&logLists{sct: sctLogs, info: infoLogs, final: finalLogs})
+
+	ctp.shardExpiryGauge.Reset()
+	for _, log := range sctLogs {
+		if log.EndExclusive.IsZero() {
+			// Handles the case for non-temporally sharded logs too.
+			ctp.shardExpiryGauge.WithLabelValues(log.Operator, log.Name).Set(float64(0))
+		} else {
+			ctp.shardExpiryGauge.WithLabelValues(log.Operator, log.Name).Set(float64(log.EndExclusive.Unix()))
+		}
 	}
 }
 
@@ -82,12 +113,18 @@ type result struct {
 
 // getOne obtains an SCT (or error), and returns it in resChan
 func (ctp *CTPolicy) getOne(ctx context.Context, cert core.CertDER, l loglist.Log, resChan chan result) {
+	start := time.Now()
 	sct, err := ctp.pub.SubmitToSingleCTWithResult(ctx, &pubpb.Request{
 		LogURL:       l.Url,
 		LogPublicKey: base64.StdEncoding.EncodeToString(l.Key),
 		Der:          cert,
 		Kind:         pubpb.SubmissionType_sct,
 	})
+	// Submissions abandoned because we already have enough SCTs, or because the
+	// caller gave up, say nothing about the log's health.
+	if err == nil || !errors.Is(ctx.Err(), context.Canceled) {
+		ctp.health.record(l, err, time.Since(start))
+	}
 	if err != nil {
 		resChan <- result{log: l, err: fmt.Errorf("ct submission to %q (%q) failed: %w", l.Name, l.Url, err)}
 		return
@@ -110,8 +147,9 @@ func (ctp *CTPolicy) GetSCTs(ctx context.Context, cert core.CertDER, expiration
 
 	// Identify the set of candidate logs whose temporal interval includes this
 	// cert's expiry. Randomize the order of the logs so that we're not always
-	// trying to submit to the same two.
-	logs := ctp.sctLogs.ForTime(expiration).Shuffle()
+	// trying to submit to the same two, then move unhealthy logs to the back so
+	// that they're only tried if the healthy ones can't provide enough SCTs.
+	logs := ctp.health.order(ctp.logs.Load().sct.ForTime(expiration).Shuffle())
 	if len(logs) < 2 {
 		return nil, berrors.MissingSCTsError("Insufficient CT logs available (%d)", len(logs))
 	}
@@ -226,9 +264,9 @@ func compliantSet(results []result) core.SCTDERs {
 func (ctp *CTPolicy) submitAllBestEffort(ctx context.Context, blob core.CertDER, kind pubpb.SubmissionType, expiry time.Time) {
 	ctx = context.WithoutCancel(ctx)
 
-	logs := ctp.finalLogs
+	logs := ctp.logs.Load().final
 	if kind == pubpb.SubmissionType_info {
-		logs = ctp.infoLogs
+		logs = ctp.logs.Load().info
 	}
 
 	for _, log := range logs {
@@ -0,0 +1,239 @@
+package ctpolicy
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+)
+
+// The circuit breaker rules applied to each log to which we submit for SCTs.
+// A log's circuit is closed while it is healthy. If, among its last
+// healthWindow submissions, at least minSubmissions were made and at least
+// tripErrorRate of them failed, its circuit opens. After openDuration, its
+// circuit becomes half-open, and the result of the next submission decides
+// whether it closes again or reopens.
+const (
+	healthWindow   = 50
+	minSubmissions = 10
+	tripErrorRate  = 0.5
+	openDuration   = 2 * time.Minute
+)
+
+// circuitState is the state of a log's circuit breaker. Its value is exported
+// by the ct_log_circuit_state metric.
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitHalfOpen
+	circuitOpen
+)
+
+// submission is the outcome of a single submission to a log.
+type submission struct {
+	failed  bool
+	latency time.Duration
+}
+
+// logHealth tracks the recent submissions to, and circuit state of, one log.
+type logHealth struct {
+	// window holds up to healthWindow of the most recent submissions, with the
+	// oldest overwritten first.
+	window   []submission
+	next     int
+	state    circuitState
+	openedAt time.Time
+}
+
+// add records a submission, evicting the oldest if the window is full.
+func (h *logHealth) add(s submission) {
+	if len(h.window) < healthWindow {
+		h.window = append(h.window, s)
+		return
+	}
+	h.window[h.next] = s
+	h.next = (h.next + 1) % healthWindow
+}
+
+// errorRate returns the fraction of the submissions in the window which failed.
+func (h *logHealth) errorRate() float64 {
+	if len(h.window) == 0 {
+		return 0
+	}
+	var failures int
+	for _, s := range h.window {
+		if s.failed {
+			failures++
+		}
+	}
+	return float64(failures) / float64(len(h.window))
+}
+
+// latencyQuantile returns the q-quantile latency of the successful submissions
+// in the window, and false if there are fewer than minSubmissions of them.
+func (h *logHealth) latencyQuantile(q float64) (time.Duration, bool) {
+	var latencies []time.Duration
+	for _, s := range h.window {
+		if !s.failed {
+			latencies = append(latencies, s.latency)
+		}
+	}
+	if len(latencies) < minSubmissions {
+		return 0, false
+	}
+	slices.Sort(latencies)
+	return latencies[int(q*float64(len(latencies)-1))], true
+}
+
+// healthTracker tracks the health of the logs to which a CTPolicy submits for
+// SCTs, keyed by log URL, and uses it to decide the order in which to try them.
+type healthTracker struct {
+	sync.Mutex
+	logs map[string]*logHealth
+	// slowLatency is the 90th percentile latency above which a log is
+	// considered slow, and tried after logs which aren't.
+	slowLatency time.Duration
+
+	stateGauge     *prometheus.GaugeVec
+	errorRateGauge *prometheus.GaugeVec
+	latencyGauge   *prometheus.GaugeVec
+	tripCounter    *prometheus.CounterVec
+}
+
+func newHealthTracker(slowLatency time.Duration, stats prometheus.Registerer) *healthTracker {
+	stateGauge := promauto.With(stats).NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ct_log_circuit_state",
+		Help: "State of each CT log's circuit breaker, by log URL: 0 is closed (healthy), 1 is half-open, and 2 is open (unhealthy).",
+	}, []string{"url"})
+
+	errorRateGauge := promauto.With(stats).NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ct_log_error_rate",
+		Help: "Fraction of recent SCT submissions to each CT log which failed, by log URL.",
+	}, []string{"url"})
+
+	latencyGauge := promauto.With(stats).NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ct_log_latency_seconds",
+		Help: "Latency quantiles of recent successful SCT submissions to each CT log, by log URL and quantile.",
+	}, []string{"url", "quantile"})
+
+	tripCounter := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "ct_log_circuit_trips",
+		Help: "Counter of times each CT log's circuit breaker has opened, by log URL.",
+	}, []string{"url"})
+
+	return &healthTracker{
+		logs:           make(map[string]*logHealth),
+		slowLatency:    slowLatency,
+		stateGauge:     stateGauge,
+		errorRateGauge: errorRateGauge,
+		latencyGauge:   latencyGauge,
+		tripCounter:    tripCounter,
+	}
+}
+
+// get returns the health of the given log, moving its circuit from open to
+// half-open if openDuration has elapsed. It must be called with the lock held.
+func (ht *healthTracker) get(url string, now time.Time) *logHealth {
+	h, ok := ht.logs[url]
+	if !ok {
+		h = &logHealth{}
+		ht.logs[url] = h
+	}
+	if h.state == circuitOpen && now.Sub(h.openedAt) >= openDuration {
+		h.state = circuitHalfOpen
+		ht.stateGauge.WithLabelValues(url).Set(float64(circuitHalfOpen))
+	}
+	return h
+}
+
+// record updates the health of the given log with the outcome of a submission
+// to it.
+func (ht *healthTracker) record(l loglist.Log, err error, latency time.Duration) {
+	ht.Lock()
+	defer ht.Unlock()
+
+	now := time.Now()
+	h := ht.get(l.Url, now)
+	h.add(submission{failed: err != nil, latency: latency})
+
+	switch h.state {
+	case circuitHalfOpen:
+		if err != nil {
+			h.state = circuitOpen
+			h.openedAt = now
+			ht.tripCounter.WithLabelValues(l.Url).Inc()
+		} else {
+			// Start afresh, so that the failures which opened the circuit don't
+			// immediately open it again.
+			h.state = circuitClosed
+			h.window = []submission{{latency: latency}}
+			h.next = 0
+		}
+	case circuitClosed:
+		if len(h.window) >= minSubmissions && h.errorRate() >= tripErrorRate {
+			h.state = circuitOpen
+			h.openedAt = now
+			ht.tripCounter.WithLabelValues(l.Url).Inc()
+		}
+	}
+
+	ht.stateGauge.WithLabelValues(l.Url).Set(float64(h.state))
+	ht.errorRateGauge.WithLabelValues(l.Url).Set(h.errorRate())
+	for _, q := range []struct {
+		label string
+		value float64
+	}{{"0.5", 0.5}, {"0.9", 0.9}, {"0.99", 0.99}} {
+		latency, ok := h.latencyQuantile(q.value)
+		if ok {
+			ht.latencyGauge.WithLabelValues(l.Url, q.label).Set(latency.Seconds())
+		}
+	}
+}
+
+// order returns the given logs reordered by health, preserving their relative
+// order otherwise. Healthy logs come first, followed by logs which are slow or
+// whose circuits are half-open, followed by logs whose circuits are open.
+//
+// No log is removed, so that open logs remain available as a last resort if
+// the others can't provide a compliant set of SCTs. If the first two logs are
+// both tiled, or both not, and a log of the other kind is as healthy as the
+// second, it is moved into second place, as loglist.List.Shuffle would.
+func (ht *healthTracker) order(logs loglist.List) loglist.List {
+	ht.Lock()
+	now := time.Now()
+	tiers := make(map[string]int, len(logs))
+	for _, l := range logs {
+		h := ht.get(l.Url, now)
+		switch h.state {
+		case circuitOpen:
+			tiers[l.Url] = 2
+		case circuitHalfOpen:
+			tiers[l.Url] = 1
+		default:
+			p90, ok := h.latencyQuantile(0.9)
+			if ok && p90 > ht.slowLatency {
+				tiers[l.Url] = 1
+			}
+		}
+	}
+	ht.Unlock()
+
+	res := slices.Clone(logs)
+	slices.SortStableFunc(res, func(a, b loglist.Log) int {
+		return tiers[a.Url] - tiers[b.Url]
+	})
+	if len(res) > 2 && res[0].Tiled == res[1].Tiled {
+		for i := 2; i < len(res) && tiers[res[i].Url] == tiers[res[1].Url]; i++ {
+			if res[0].Tiled != res[i].Tiled {
+				res[1], res[i] = res[i], res[1]
+				break
+			}
+		}
+	}
+	return res
+}
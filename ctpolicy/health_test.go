@@ -0,0 +1,138 @@
+package ctpolicy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ht := newHealthTracker(time.Second, metrics.NoopRegisterer)
+		logA := loglist.Log{Name: "LogA1", Url: "UrlA1"}
+		logB := loglist.Log{Name: "LogB1", Url: "UrlB1"}
+		bad := errors.New("BAD")
+
+		// A few failures aren't enough to open the circuit.
+		for range minSubmissions - 1 {
+			ht.record(logA, bad, time.Millisecond)
+		}
+		test.AssertMetricWithLabelsEquals(t, ht.stateGauge, prometheus.Labels{"url": "UrlA1"}, float64(circuitClosed))
+		test.AssertDeepEquals(t, ht.order(loglist.List{logA, logB}), loglist.List{logA, logB})
+
+		ht.record(logA, bad, time.Millisecond)
+		test.AssertMetricWithLabelsEquals(t, ht.stateGauge, prometheus.Labels{"url": "UrlA1"}, float64(circuitOpen))
+		test.AssertMetricWithLabelsEquals(t, ht.errorRateGauge, prometheus.Labels{"url": "UrlA1"}, 1)
+		test.AssertMetricWithLabelsEquals(t, ht.tripCounter, prometheus.Labels{"url": "UrlA1"}, 1)
+		test.AssertDeepEquals(t, ht.order(loglist.List{logA, logB}), loglist.List{logB, logA})
+
+		// After openDuration the circuit is half-open, and one failure reopens it.
+		time.Sleep(openDuration)
+		test.AssertDeepEquals(t, ht.order(loglist.List{logA, logB}), loglist.List{logB, logA})
+		test.AssertMetricWithLabelsEquals(t, ht.stateGauge, prometheus.Labels{"url": "UrlA1"}, float64(circuitHalfOpen))
+		ht.record(logA, bad, time.Millisecond)
+		test.AssertMetricWithLabelsEquals(t, ht.stateGauge, prometheus.Labels{"url": "UrlA1"}, float64(circuitOpen))
+		test.AssertMetricWithLabelsEquals(t, ht.tripCounter, prometheus.Labels{"url": "UrlA1"}, 2)
+
+		// One success closes it, and forgets the earlier failures.
+		time.Sleep(openDuration)
+		ht.record(logA, nil, time.Millisecond)
+		test.AssertMetricWithLabelsEquals(t, ht.stateGauge, prometheus.Labels{"url": "UrlA1"}, float64(circuitClosed))
+		test.AssertMetricWithLabelsEquals(t, ht.errorRateGauge, prometheus.Labels{"url": "UrlA1"}, 0)
+		test.AssertDeepEquals(t, ht.order(loglist.List{logA, logB}), loglist.List{logA, logB})
+	})
+}
+
+func TestCircuitBreakerWindow(t *testing.T) {
+	ht := newHealthTracker(time.Second, metrics.NoopRegisterer)
+	logA := loglist.Log{Name: "LogA1", Url: "UrlA1"}
+
+	// Failures which are outside the window don't count towards the error rate.
+	for range healthWindow / 2 {
+		ht.record(logA, errors.New("BAD"), time.Millisecond)
+		ht.record(logA, nil, time.Millisecond)
+		ht.record(logA, nil, time.Millisecond)
+	}
+	test.AssertEquals(t, len(ht.logs["UrlA1"].window), healthWindow)
+	test.AssertMetricWithLabelsEquals(t, ht.stateGauge, prometheus.Labels{"url": "UrlA1"}, float64(circuitClosed))
+	for range healthWindow {
+		ht.record(logA, nil, time.Millisecond)
+	}
+	test.AssertMetricWithLabelsEquals(t, ht.errorRateGauge, prometheus.Labels{"url": "UrlA1"}, 0)
+}
+
+func TestOrderSlowLogs(t *testing.T) {
+	ht := newHealthTracker(time.Second, metrics.NoopRegisterer)
+	slow := loglist.Log{Name: "LogA1", Url: "UrlA1"}
+	fast := loglist.Log{Name: "LogB1", Url: "UrlB1"}
+
+	for i := range minSubmissions {
+		ht.record(slow, nil, time.Duration(i+1)*time.Second)
+		ht.record(fast, nil, 100*time.Millisecond)
+	}
+	test.AssertMetricWithLabelsEquals(t, ht.latencyGauge, prometheus.Labels{"url": "UrlA1", "quantile": "0.5"}, 5)
+	test.AssertMetricWithLabelsEquals(t, ht.latencyGauge, prometheus.Labels{"url": "UrlA1", "quantile": "0.9"}, 9)
+	test.AssertDeepEquals(t, ht.order(loglist.List{slow, fast}), loglist.List{fast, slow})
+}
+
+func TestOrderKeepsTiledMix(t *testing.T) {
+	ht := newHealthTracker(time.Second, metrics.NoopRegisterer)
+	tiled1 := loglist.Log{Name: "T1", Url: "UrlT1", Tiled: true}
+	tiled2 := loglist.Log{Name: "T2", Url: "UrlT2", Tiled: true}
+	rfc1 := loglist.Log{Name: "R1", Url: "UrlR1"}
+	rfc2 := loglist.Log{Name: "R2", Url: "UrlR2"}
+
+	for range minSubmissions {
+		ht.record(rfc1, errors.New("BAD"), time.Millisecond)
+	}
+
+	// The only healthy non-tiled log is brought forward to pair with a tiled
+	// one, but the unhealthy one isn't.
+	test.AssertDeepEquals(t,
+		ht.order(loglist.List{tiled1, rfc1, tiled2, rfc2}),
+		loglist.List{tiled1, rfc2, tiled2, rfc1})
+	test.AssertDeepEquals(t,
+		ht.order(loglist.List{tiled1, tiled2, rfc1}),
+		loglist.List{tiled1, tiled2, rfc1})
+}
+
+func TestGetSCTsSkipsOpenCircuits(t *testing.T) {
+	logs := loglist.List{
+		{Name: "LogA1", Operator: "OperA", Url: "UrlA1", Key: []byte("KeyA1")},
+		{Name: "LogB1", Operator: "OperB", Url: "UrlB1", Key: []byte("KeyB1")},
+		{Name: "LogC1", Operator: "OperC", Url: "UrlC1", Key: []byte("KeyC1")},
+	}
+	ctp := New(&mockFailOnePub{badURL: "UrlA1"}, logs, nil, nil, time.Hour, blog.NewMock(), metrics.NoopRegisterer)
+	for range minSubmissions {
+		ctp.health.record(logs[0], errors.New("BAD"), time.Millisecond)
+	}
+
+	// With the stagger set to an hour, only the first two logs are tried, and
+	// the log with the open circuit is never among them.
+	for range 50 {
+		_, err := ctp.GetSCTs(context.Background(), []byte{0}, time.Time{})
+		test.AssertNotError(t, err, "GetSCTs failed")
+	}
+	test.AssertMetricWithLabelsEquals(t, ctp.winnerCounter, prometheus.Labels{"url": "UrlA1", "result": failed}, 0)
+	test.AssertMetricWithLabelsEquals(t, ctp.winnerCounter, prometheus.Labels{"url": "UrlB1", "result": succeeded}, 50)
+
+	// If the healthy logs can't provide enough SCTs, the log with the open
+	// circuit is still tried.
+	ctp = New(&mockFailOnePub{badURL: "UrlB1"}, logs, nil, nil, time.Hour, blog.NewMock(), metrics.NoopRegisterer)
+	for range minSubmissions {
+		ctp.health.record(logs[0], errors.New("BAD"), time.Millisecond)
+	}
+	_, err := ctp.GetSCTs(context.Background(), []byte{0}, time.Time{})
+	test.AssertNotError(t, err, "GetSCTs failed")
+	test.AssertMetricWithLabelsEquals(t, ctp.winnerCounter, prometheus.Labels{"url": "UrlB1", "result": failed}, 1)
+	test.AssertMetricWithLabelsEquals(t, ctp.winnerCounter, prometheus.Labels{"url": "UrlA1", "result": succeeded}, 1)
+}
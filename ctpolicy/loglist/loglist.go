@@ -1,6 +1,11 @@
 package loglist
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
 	_ "embed"
 	"encoding/base64"
 	"errors"
@@ -49,6 +54,14 @@ type Log struct {
 	State          loglist3.LogStatus
 	Tiled          bool
 	Type           string
+
+	// MonitoringURL is the prefix of the log's monitoring APIs. For RFC 6962
+	// logs it is the same as Url; for tiled logs it is the static-ct-api
+	// monitoring prefix, from which checkpoints and tiles are fetched.
+	MonitoringURL string
+	// MMD is the log's Maximum Merge Delay, within which it promises to
+	// incorporate every entry for which it has issued an SCT.
+	MMD time.Duration
 }
 
 // usableForPurpose returns true if the log state is acceptable for the given
@@ -80,12 +93,12 @@ func New(path string) (List, error) {
 		return nil, fmt.Errorf("failed to read CT Log List: %w", err)
 	}
 
-	return newHelper(file)
+	return Parse(file)
 }
 
-// newHelper is a helper to allow the core logic of `New()` to be unit tested
-// without having to write files to disk.
-func newHelper(file []byte) (List, error) {
+// Parse returns a LogList of all operators and all logs parsed from the given
+// JSON, which must conform to the same schema as the file read by New.
+func Parse(file []byte) (List, error) {
 	parsed, err := loglist3.NewFromJSON(file)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse CT Log List: %w", err)
@@ -95,14 +108,16 @@ func newHelper(file []byte) (List, error) {
 	for _, op := range parsed.Operators {
 		for _, log := range op.Logs {
 			info := Log{
-				Operator: op.Name,
-				Name:     log.Description,
-				Id:       base64.StdEncoding.EncodeToString(log.LogID),
-				Key:      log.Key,
-				Url:      log.URL,
-				State:    log.State.LogStatus(),
-				Tiled:    false,
-				Type:     log.Type,
+				Operator:      op.Name,
+				Name:          log.Description,
+				Id:            base64.StdEncoding.EncodeToString(log.LogID),
+				Key:           log.Key,
+				Url:           log.URL,
+				MonitoringURL: log.URL,
+				MMD:           time.Duration(log.MMD) * time.Second,
+				State:         log.State.LogStatus(),
+				Tiled:         false,
+				Type:          log.Type,
 			}
 
 			if log.TemporalInterval != nil {
@@ -115,14 +130,16 @@ func newHelper(file []byte) (List, error) {
 
 		for _, log := range op.TiledLogs {
 			info := Log{
-				Operator: op.Name,
-				Name:     log.Description,
-				Id:       base64.StdEncoding.EncodeToString(log.LogID),
-				Key:      log.Key,
-				Url:      log.SubmissionURL,
-				State:    log.State.LogStatus(),
-				Tiled:    true,
-				Type:     log.Type,
+				Operator:      op.Name,
+				Name:          log.Description,
+				Id:            base64.StdEncoding.EncodeToString(log.LogID),
+				Key:           log.Key,
+				Url:           log.SubmissionURL,
+				MonitoringURL: log.MonitoringURL,
+				MMD:           time.Duration(log.MMD) * time.Second,
+				State:         log.State.LogStatus(),
+				Tiled:         true,
+				Type:          log.Type,
 			}
 
 			if log.TemporalInterval != nil {
@@ -137,6 +154,33 @@ func newHelper(file []byte) (List, error) {
 	return result, nil
 }
 
+// VerifySignature checks a detached signature over a log list, such as those
+// which Chrome and Apple publish alongside their log lists, using the given
+// public key. RSA signatures must use PKCS #1 v1.5 and ECDSA signatures must be
+// ASN.1-encoded, both over a SHA-256 digest of the list, while Ed25519
+// signatures are over the list itself.
+func VerifySignature(file []byte, sig []byte, key crypto.PublicKey) error {
+	digest := sha256.Sum256(file)
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig)
+		if err != nil {
+			return fmt.Errorf("invalid log list signature: %w", err)
+		}
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest[:], sig) {
+			return errors.New("invalid log list signature")
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, file, sig) {
+			return errors.New("invalid log list signature")
+		}
+	default:
+		return fmt.Errorf("unsupported log list signing key type %T", key)
+	}
+	return nil
+}
+
 // SubsetForPurpose returns a new log list containing only those logs whose
 // names match those in the given list, and whose state is acceptable for the
 // given purpose. It returns an error if any of the given names are not found
@@ -1,6 +1,13 @@
 package loglist
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
 	"testing"
 	"time"
 
@@ -229,3 +236,46 @@ func TestGetByID(t *testing.T) {
 	_, err = input.GetByID("Other ID")
 	test.AssertError(t, err, "should not have found log")
 }
+
+func TestVerifySignature(t *testing.T) {
+	file := []byte(`{"operators": []}`)
+	digest := sha256.Sum256(file)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "generating RSA key")
+	rsaSig, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	test.AssertNotError(t, err, "signing with RSA key")
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating ECDSA key")
+	ecdsaSig, err := ecdsa.SignASN1(rand.Reader, ecdsaKey, digest[:])
+	test.AssertNotError(t, err, "signing with ECDSA key")
+
+	ed25519Pub, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "generating Ed25519 key")
+	ed25519Sig := ed25519.Sign(ed25519Key, file)
+
+	for _, tc := range []struct {
+		name    string
+		file    []byte
+		sig     []byte
+		key     crypto.PublicKey
+		wantErr bool
+	}{
+		{"RSA", file, rsaSig, rsaKey.Public(), false},
+		{"ECDSA", file, ecdsaSig, ecdsaKey.Public(), false},
+		{"Ed25519", file, ed25519Sig, ed25519Pub, false},
+		{"modified list", []byte(`{"operators": [{}]}`), rsaSig, rsaKey.Public(), true},
+		{"wrong key", file, ecdsaSig, rsaKey.Public(), true},
+		{"unsupported key", file, ecdsaSig, "not a key", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifySignature(tc.file, tc.sig, tc.key)
+			if tc.wantErr {
+				test.AssertError(t, err, "signature should not verify")
+			} else {
+				test.AssertNotError(t, err, "signature should verify")
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package ctpolicy
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+)
+
+// maxLogListSize is the largest log list, or log list signature, which Reload
+// will download. Chrome's full log list is currently around 100KiB.
+const maxLogListSize = 10 << 20
+
+// defaultHTTPClient is used to fetch URLs if a LogListSource has no HTTPClient.
+// Its timeout keeps a hung fetch from stalling the refresher indefinitely.
+var defaultHTTPClient = &http.Client{Timeout: time.Minute}
+
+// LogListSource describes where a CTPolicy reloads its log list from, and how
+// it selects logs from that list.
+type LogListSource struct {
+	// Location is the path of a log list file on disk, or an http:// or
+	// https:// URL from which the log list is fetched.
+	Location string
+	// SignatureLocation is the path or URL of a detached signature over the log
+	// list, such as those published by Chrome and Apple. It is only used if
+	// SignatureKey is set.
+	SignatureLocation string
+	// SignatureKey is the public key used to verify the log list's signature.
+	// It is required if Location is a URL. If nil, the log list is not verified.
+	SignatureKey crypto.PublicKey
+	// SCTLogs, InfoLogs, and FinalLogs are the names of the logs to select from
+	// the log list, as in ctconfig.CTConfig.
+	SCTLogs   []string
+	InfoLogs  []string
+	FinalLogs []string
+	// SubmitToTestLogs enables inclusion of "test" logs when obtaining SCTs.
+	SubmitToTestLogs bool
+	// MinOperators is the number of distinct operators which the SCT logs must
+	// have for a reloaded list to be accepted. Values below 2 are treated as 2.
+	MinOperators int
+	// HTTPClient is used to fetch URLs. If nil, a client with a one minute
+	// timeout is used.
+	HTTPClient *http.Client
+}
+
+// isURL returns true if the given location is fetched over HTTP, rather than
+// read from disk.
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// Validate returns an error if src is a URL but has no SignatureKey: a log list
+// fetched over the network must be signed.
+func (src LogListSource) Validate() error {
+	if isURL(src.Location) && src.SignatureKey == nil {
+		return fmt.Errorf("log list fetched from %q must have a signature key", src.Location)
+	}
+	return nil
+}
+
+// fetch reads the file at, or downloads the contents of, the given location.
+func (src LogListSource) fetch(ctx context.Context, location string) ([]byte, error) {
+	if !isURL(location) {
+		return os.ReadFile(location)
+	}
+
+	client := src.HTTPClient
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %q: unexpected status %d", location, resp.StatusCode)
+	}
+	return io.ReadAll(core.ErrOnLimitReader(resp.Body, maxLogListSize))
+}
+
+// errRejected wraps errors for log lists which were fetched successfully, but
+// which are unacceptable.
+var errRejected = errors.New("log list rejected")
+
+// Reload fetches the log list described by src, verifies its signature if src
+// has a SignatureKey, which it must if src is a URL, and selects the configured
+// logs from it. If the selected SCT logs span at least src.MinOperators
+// operators, they replace the logs currently used by the CTPolicy, along with
+// the selected informational and final logs. Otherwise, the CTPolicy is
+// unchanged and an error is returned.
+//
+// Configured names which are absent from the reloaded list are skipped rather
+// than causing the reload to fail, so that a log being removed from the list
+// doesn't prevent other changes from being picked up.
+func (ctp *CTPolicy) Reload(ctx context.Context, src LogListSource) error {
+	err := ctp.reload(ctx, src)
+	switch {
+	case err == nil:
+		ctp.reloadCounter.WithLabelValues(succeeded).Inc()
+		ctp.lastReloadGauge.SetToCurrentTime()
+	case errors.Is(err, errRejected):
+		ctp.reloadCounter.WithLabelValues("rejected").Inc()
+	default:
+		ctp.reloadCounter.WithLabelValues(failed).Inc()
+	}
+	return err
+}
+
+func (ctp *CTPolicy) reload(ctx context.Context, src LogListSource) error {
+	err := src.Validate()
+	if err != nil {
+		return err
+	}
+
+	file, err := src.fetch(ctx, src.Location)
+	if err != nil {
+		return fmt.Errorf("fetching log list: %w", err)
+	}
+
+	if src.SignatureKey != nil {
+		sig, err := src.fetch(ctx, src.SignatureLocation)
+		if err != nil {
+			return fmt.Errorf("fetching log list signature: %w", err)
+		}
+		err = loglist.VerifySignature(file, sig, src.SignatureKey)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errRejected, err)
+		}
+	}
+
+	allLogs, err := loglist.Parse(file)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errRejected, err)
+	}
+
+	sctLogs, err := allLogs.SubsetForPurpose(ctp.presentNames(allLogs, src.SCTLogs), loglist.Issuance, src.SubmitToTestLogs)
+	if err != nil {
+		return fmt.Errorf("%w: selecting SCT logs: %w", errRejected, err)
+	}
+	infoLogs, err := allLogs.SubsetForPurpose(ctp.presentNames(allLogs, src.InfoLogs), loglist.Informational, true)
+	if err != nil {
+		return fmt.Errorf("%w: selecting informational logs: %w", errRejected, err)
+	}
+	finalLogs, err := allLogs.SubsetForPurpose(ctp.presentNames(allLogs, src.FinalLogs), loglist.Informational, true)
+	if err != nil {
+		return fmt.Errorf("%w: selecting final logs: %w", errRejected, err)
+	}
+
+	operators := make(map[string]struct{})
+	for _, log := range sctLogs {
+		operators[log.Operator] = struct{}{}
+	}
+	if len(operators) < max(src.MinOperators, 2) {
+		return fmt.Errorf("%w: SCT logs have %d operators, need at least %d", errRejected, len(operators), max(src.MinOperators, 2))
+	}
+
+	old := ctp.logs.Load()
+	ctp.setLogs(sctLogs, infoLogs, finalLogs)
+	if len(old.sct) != len(sctLogs) || len(old.info) != len(infoLogs) || len(old.final) != len(finalLogs) {
+		ctp.log.Infof("Reloaded CT log list: now %d SCT logs (was %d), %d informational logs (was %d), %d final logs (was %d)",
+			len(sctLogs), len(old.sct), len(infoLogs), len(old.info), len(finalLogs), len(old.final))
+	}
+	return nil
+}
+
+// presentNames returns those of the given log names which appear in the given
+// log list, warning about any which don't.
+func (ctp *CTPolicy) presentNames(logs loglist.List, names []string) []string {
+	var res []string
+	for _, name := range names {
+		if !slices.ContainsFunc(logs, func(log loglist.Log) bool { return log.Name == name }) {
+			ctp.log.Warningf("Configured CT log %q is not in the reloaded log list", name)
+			continue
+		}
+		res = append(res, name)
+	}
+	return res
+}
+
+// NewRefresher periodically reloads the CTPolicy's log list from src, until the
+// returned function is called. Failed reloads are logged, and leave the
+// current logs in place.
+func (ctp *CTPolicy) NewRefresher(src LogListSource, interval time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				err := ctp.Reload(ctx, src)
+				if err != nil {
+					ctp.log.Errf("reloading CT log list: %v", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return cancel
+}
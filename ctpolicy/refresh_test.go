@@ -0,0 +1,169 @@
+package ctpolicy
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+const testLogList = "../test/ct-test-srv/log_list.json"
+
+func logNames(logs loglist.List) []string {
+	var names []string
+	for _, log := range logs {
+		names = append(names, log.Name)
+	}
+	return names
+}
+
+func TestReload(t *testing.T) {
+	t.Parallel()
+	file, err := os.ReadFile(testLogList)
+	test.AssertNotError(t, err, "reading log list")
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "generating key")
+	digest := sha256.Sum256(file)
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	test.AssertNotError(t, err, "signing log list")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/log_list.json":
+			w.Write(file)
+		case "/log_list.sig":
+			w.Write(sig)
+		case "/bad.sig":
+			w.Write([]byte("bad signature"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	initial := loglist.List{
+		{Name: "LogA1", Operator: "OperA", Url: "UrlA1", Key: []byte("KeyA1")},
+		{Name: "LogB1", Operator: "OperB", Url: "UrlB1", Key: []byte("KeyB1")},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		src        LogListSource
+		wantResult string
+		wantSCT    []string
+	}{
+		{
+			name: "file",
+			src: LogListSource{
+				Location: testLogList,
+				SCTLogs:  []string{"A1 Current", "B1", "E1"},
+				InfoLogs: []string{"F1"},
+			},
+			wantResult: succeeded,
+			// E1 is retired, so isn't used for SCTs.
+			wantSCT: []string{"A1 Current", "B1"},
+		},
+		{
+			name: "signed URL",
+			src: LogListSource{
+				Location:          srv.URL + "/log_list.json",
+				SignatureLocation: srv.URL + "/log_list.sig",
+				SignatureKey:      key.Public(),
+				SCTLogs:           []string{"B1", "C1", "D1"},
+			},
+			wantResult: succeeded,
+			wantSCT:    []string{"B1", "C1", "D1"},
+		},
+		{
+			name: "missing name is skipped",
+			src: LogListSource{
+				Location: testLogList,
+				SCTLogs:  []string{"B1", "C1", "Retired and removed"},
+			},
+			wantResult: succeeded,
+			wantSCT:    []string{"B1", "C1"},
+		},
+		{
+			name: "bad signature",
+			src: LogListSource{
+				Location:          srv.URL + "/log_list.json",
+				SignatureLocation: srv.URL + "/bad.sig",
+				SignatureKey:      key.Public(),
+				SCTLogs:           []string{"B1", "C1"},
+			},
+			wantResult: "rejected",
+		},
+		{
+			name: "too few operators",
+			src: LogListSource{
+				Location:     testLogList,
+				SCTLogs:      []string{"A1 Current", "B1", "C1"},
+				MinOperators: 4,
+			},
+			wantResult: "rejected",
+		},
+		{
+			name: "only one usable operator",
+			src: LogListSource{
+				Location: testLogList,
+				SCTLogs:  []string{"A1 Current", "E1"},
+			},
+			wantResult: "rejected",
+		},
+		{
+			name: "missing signature",
+			src: LogListSource{
+				Location:          srv.URL + "/log_list.json",
+				SignatureLocation: srv.URL + "/missing.sig",
+				SignatureKey:      key.Public(),
+				SCTLogs:           []string{"B1", "C1"},
+			},
+			wantResult: failed,
+		},
+		{
+			name: "unsigned URL",
+			src: LogListSource{
+				Location: srv.URL + "/log_list.json",
+				SCTLogs:  []string{"B1", "C1"},
+			},
+			wantResult: failed,
+		},
+		{
+			name: "missing file",
+			src: LogListSource{
+				Location: "/does/not/exist.json",
+				SCTLogs:  []string{"B1", "C1"},
+			},
+			wantResult: failed,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctp := New(&mockPub{}, initial, nil, nil, 0, blog.NewMock(), metrics.NoopRegisterer)
+
+			err := ctp.Reload(context.Background(), tc.src)
+			if tc.wantResult == succeeded {
+				test.AssertNotError(t, err, "reloading log list")
+				test.AssertDeepEquals(t, logNames(ctp.logs.Load().sct), tc.wantSCT)
+				test.AssertMetricWithLabelsEquals(t, ctp.shardExpiryGauge, prometheus.Labels{"operator": "OperA", "logID": "LogA1"}, 0)
+			} else {
+				test.AssertError(t, err, "reloading log list should fail")
+				test.AssertDeepEquals(t, ctp.logs.Load().sct, initial)
+			}
+			test.AssertMetricWithLabelsEquals(t, ctp.reloadCounter, prometheus.Labels{"result": tc.wantResult}, 1)
+		})
+	}
+}
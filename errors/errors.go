@@ -266,6 +266,14 @@ func LimitOverrideRequestsPerIPAddressError(retryAfter time.Duration, msg string
 	}
 }
 
+func CAAChecksPerAccountError(retryAfter time.Duration, msg string, args ...any) error {
+	return &BoulderError{
+		Type:       RateLimit,
+		Detail:     fmt.Sprintf(msg, args...),
+		RetryAfter: retryAfter,
+	}
+}
+
 func RejectedIdentifierError(msg string, args ...any) error {
 	return newf(RejectedIdentifier, msg, args...)
 }
@@ -0,0 +1,136 @@
+package events
+
+import (
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+
+	eventspb "github.com/letsencrypt/boulder/events/proto"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// ExporterImpl implements the IssuanceEvents gRPC service. Each subscriber is
+// served by tailing the issuanceEvents table, via the SA, starting from the
+// creation time it provides.
+//
+// Delivery is at-least-once: the exporter keeps no state of its own, so a
+// subscriber which reconnects using the creation time of the last event it
+// processed will receive every event after that one, including any which were
+// sent but never processed before the previous stream broke, and any created
+// within the lookback before it.
+type ExporterImpl struct {
+	eventspb.UnsafeIssuanceEventsServer
+
+	sa           EventSource
+	pollInterval time.Duration
+	lookback     time.Duration
+	batchSize    int64
+
+	eventsSent  *prometheus.CounterVec
+	lateEvents  prometheus.Counter
+	subscribers prometheus.Gauge
+	clk         clock.Clock
+	log         blog.Logger
+}
+
+var _ eventspb.IssuanceEventsServer = (*ExporterImpl)(nil)
+
+// NewExporterImpl returns an ExporterImpl which polls the SA for new events
+// every pollInterval, fetching at most batchSize events at a time.
+//
+// An event becomes visible only when the transaction which recorded it
+// commits, and the SA's read replicas have caught up, so events are not
+// necessarily visible in order of creation. Each poll re-reads the events
+// created within lookback of the latest event sent, so that events which
+// become visible up to lookback late are still sent. See Tail.
+func NewExporterImpl(sa EventSource, pollInterval, lookback time.Duration, batchSize int64, stats prometheus.Registerer, clk clock.Clock, logger blog.Logger) *ExporterImpl {
+	eventsSent := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "event_exporter_events_sent",
+		Help: "Total number of issuance events sent to subscribers, by event type",
+	}, []string{"type"})
+
+	lateEvents := promauto.With(stats).NewCounter(prometheus.CounterOpts{
+		Name: "event_exporter_late_events",
+		Help: "Total number of issuance events sent after a newer event, because they became visible late",
+	})
+
+	subscribers := promauto.With(stats).NewGauge(prometheus.GaugeOpts{
+		Name: "event_exporter_subscribers",
+		Help: "Number of currently connected issuance event subscribers",
+	})
+
+	return &ExporterImpl{
+		sa:           sa,
+		pollInterval: pollInterval,
+		lookback:     lookback,
+		batchSize:    batchSize,
+		eventsSent:   eventsSent,
+		lateEvents:   lateEvents,
+		subscribers:  subscribers,
+		clk:          clk,
+		log:          logger,
+	}
+}
+
+// Subscribe streams all events created after req.After to the subscriber,
+// roughly in order of creation, until the subscriber disconnects or an error
+// occurs. If req.After is unset, it starts from the oldest retained event.
+func (impl *ExporterImpl) Subscribe(req *eventspb.SubscribeRequest, stream grpc.ServerStreamingServer[eventspb.Event]) error {
+	impl.subscribers.Inc()
+	defer impl.subscribers.Dec()
+
+	var start time.Time
+	if req.After != nil {
+		start = req.After.AsTime()
+	}
+	tail := NewTail(impl.sa, start, impl.lookback, impl.batchSize, impl.clk)
+
+	ctx := stream.Context()
+	for ctx.Err() == nil {
+		watermark := tail.Watermark()
+		err := tail.Poll(ctx, func(event *eventspb.Event) error {
+			err := stream.Send(event)
+			if err != nil {
+				return err
+			}
+			impl.eventsSent.WithLabelValues(eventType(event)).Inc()
+			if event.Created.AsTime().Before(watermark) {
+				impl.lateEvents.Inc()
+			}
+			return nil
+		})
+		if err != nil {
+			if ctx.Err() == nil {
+				impl.log.Warningf("sending issuance events created after %s: %s", tail.Watermark(), err)
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-impl.clk.After(impl.pollInterval):
+		}
+	}
+	return ctx.Err()
+}
+
+// eventType returns a short name for the type of the event's payload, for use
+// as a metric label.
+func eventType(event *eventspb.Event) string {
+	switch event.Payload.(type) {
+	case *eventspb.Event_OrderFinalized:
+		return "orderFinalized"
+	case *eventspb.Event_CertificateRevoked:
+		return "certificateRevoked"
+	case *eventspb.Event_IdentifiersPaused:
+		return "identifiersPaused"
+	case *eventspb.Event_AccountDeactivated:
+		return "accountDeactivated"
+	default:
+		return "unknown"
+	}
+}
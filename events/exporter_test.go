@@ -0,0 +1,145 @@
+package events
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventspb "github.com/letsencrypt/boulder/events/proto"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/mocks"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockSA serves events from a slice, honoring CreatedAfter, AfterID,
+// CreatedBefore, and Limit as the SA would, and records the requests it
+// receives.
+type mockSA struct {
+	events   []*eventspb.Event
+	requests []*sapb.GetIssuanceEventsRequest
+	err      error
+}
+
+func (msa *mockSA) GetIssuanceEvents(_ context.Context, req *sapb.GetIssuanceEventsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[eventspb.Event], error) {
+	msa.requests = append(msa.requests, req)
+	if msa.err != nil {
+		return nil, msa.err
+	}
+	sorted := slices.SortedFunc(slices.Values(msa.events), func(a, b *eventspb.Event) int {
+		return cmp.Or(a.Created.AsTime().Compare(b.Created.AsTime()), cmp.Compare(a.Id, b.Id))
+	})
+	after := req.CreatedAfter.AsTime()
+	var results []*eventspb.Event
+	for _, event := range sorted {
+		created := event.Created.AsTime()
+		if created.Before(after) || (created.Equal(after) && event.Id <= req.AfterID) {
+			continue
+		}
+		if !created.Before(req.CreatedBefore.AsTime()) || int64(len(results)) >= req.Limit {
+			break
+		}
+		results = append(results, event)
+	}
+	return &mocks.ServerStreamClient[eventspb.Event]{Results: results}, nil
+}
+
+// fakeStream collects the events sent to it, and cancels its context once it
+// has received wantEvents events.
+type fakeStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	cancel     context.CancelFunc
+	sent       []*eventspb.Event
+	wantEvents int
+}
+
+func newFakeStream(wantEvents int) *fakeStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &fakeStream{ctx: ctx, cancel: cancel, wantEvents: wantEvents}
+}
+
+func (fs *fakeStream) Send(event *eventspb.Event) error {
+	fs.sent = append(fs.sent, event)
+	if len(fs.sent) >= fs.wantEvents {
+		fs.cancel()
+	}
+	return nil
+}
+
+func (fs *fakeStream) Context() context.Context {
+	return fs.ctx
+}
+
+func deactivated(id int64, created time.Time) *eventspb.Event {
+	return &eventspb.Event{
+		Id:      id,
+		Created: timestamppb.New(created),
+		Payload: &eventspb.Event_AccountDeactivated{
+			AccountDeactivated: &eventspb.AccountDeactivated{RegistrationID: id},
+		},
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	fc := clock.NewFake()
+	fc.Set(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	t0 := fc.Now()
+	msa := &mockSA{events: []*eventspb.Event{
+		deactivated(1, t0.Add(-time.Hour)),
+		deactivated(2, t0.Add(-10*time.Second)),
+		deactivated(3, t0.Add(-3*time.Second)),
+		deactivated(4, t0.Add(-2*time.Second)),
+		deactivated(5, t0.Add(-time.Second)),
+	}}
+	impl := NewExporterImpl(msa, time.Second, time.Minute, 2, metrics.NoopRegisterer, fc, blog.NewMock())
+
+	// Resume after event 1. Event 1 is sent again, since it was created within
+	// the lookback of the resumption time, followed by events 2 through 5, in
+	// batches of two, without waiting for the poll interval in between.
+	stream := newFakeStream(5)
+	err := impl.Subscribe(&eventspb.SubscribeRequest{After: timestamppb.New(t0.Add(-time.Hour))}, stream)
+	test.AssertErrorIs(t, err, context.Canceled)
+
+	var ids []int64
+	for _, event := range stream.sent {
+		ids = append(ids, event.Id)
+	}
+	test.AssertDeepEquals(t, ids, []int64{1, 2, 3, 4, 5})
+	test.AssertMetricWithLabelsEquals(t, impl.eventsSent, prometheus.Labels{"type": "accountDeactivated"}, 5)
+	test.AssertMetricWithLabelsEquals(t, impl.lateEvents, prometheus.Labels{}, 0)
+	test.AssertMetricWithLabelsEquals(t, impl.subscribers, prometheus.Labels{}, 0)
+
+	test.AssertEquals(t, len(msa.requests), 3)
+	test.AssertEquals(t, msa.requests[0].CreatedAfter.AsTime(), t0.Add(-time.Hour-time.Minute))
+	test.AssertEquals(t, msa.requests[0].AfterID, int64(0))
+	test.AssertEquals(t, msa.requests[1].AfterID, int64(2))
+	test.AssertEquals(t, msa.requests[2].AfterID, int64(4))
+	for _, req := range msa.requests {
+		test.AssertEquals(t, req.Limit, int64(2))
+		test.AssertEquals(t, req.CreatedBefore.AsTime(), t0)
+	}
+}
+
+func TestSubscribeSAError(t *testing.T) {
+	t.Parallel()
+
+	msa := &mockSA{err: errors.New("oops")}
+	impl := NewExporterImpl(msa, time.Second, time.Minute, 2, metrics.NoopRegisterer, clock.NewFake(), blog.NewMock())
+
+	err := impl.Subscribe(&eventspb.SubscribeRequest{}, newFakeStream(1))
+	test.AssertError(t, err, "Subscribe should have failed")
+	test.AssertEquals(t, len(msa.requests), 1)
+	test.AssertBoxedNil(t, msa.requests[0].CreatedAfter, "CreatedAfter should be unset when starting from the oldest event")
+}
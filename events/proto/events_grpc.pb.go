@@ -0,0 +1,140 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.20.1
+// source: events.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	IssuanceEvents_Subscribe_FullMethodName = "/events.IssuanceEvents/Subscribe"
+)
+
+// IssuanceEventsClient is the client API for IssuanceEvents service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// IssuanceEvents streams issuance-related events to downstream consumers.
+type IssuanceEventsClient interface {
+	// Subscribe streams every event created after the given time, roughly in
+	// order of creation, and then continues streaming new events as they are
+	// recorded. Events are delivered at least once: a consumer which resumes
+	// from the creation time of the last event it processed will not miss any
+	// events, but may see some more than once, and should discard events whose
+	// IDs it has already processed.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+}
+
+type issuanceEventsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIssuanceEventsClient(cc grpc.ClientConnInterface) IssuanceEventsClient {
+	return &issuanceEventsClient{cc}
+}
+
+func (c *issuanceEventsClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IssuanceEvents_ServiceDesc.Streams[0], IssuanceEvents_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssuanceEvents_SubscribeClient = grpc.ServerStreamingClient[Event]
+
+// IssuanceEventsServer is the server API for IssuanceEvents service.
+// All implementations must embed UnimplementedIssuanceEventsServer
+// for forward compatibility.
+//
+// IssuanceEvents streams issuance-related events to downstream consumers.
+type IssuanceEventsServer interface {
+	// Subscribe streams every event created after the given time, roughly in
+	// order of creation, and then continues streaming new events as they are
+	// recorded. Events are delivered at least once: a consumer which resumes
+	// from the creation time of the last event it processed will not miss any
+	// events, but may see some more than once, and should discard events whose
+	// IDs it has already processed.
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Event]) error
+	mustEmbedUnimplementedIssuanceEventsServer()
+}
+
+// UnimplementedIssuanceEventsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIssuanceEventsServer struct{}
+
+func (UnimplementedIssuanceEventsServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedIssuanceEventsServer) mustEmbedUnimplementedIssuanceEventsServer() {}
+func (UnimplementedIssuanceEventsServer) testEmbeddedByValue()                        {}
+
+// UnsafeIssuanceEventsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IssuanceEventsServer will
+// result in compilation errors.
+type UnsafeIssuanceEventsServer interface {
+	mustEmbedUnimplementedIssuanceEventsServer()
+}
+
+func RegisterIssuanceEventsServer(s grpc.ServiceRegistrar, srv IssuanceEventsServer) {
+	// If the following call pancis, it indicates UnimplementedIssuanceEventsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IssuanceEvents_ServiceDesc, srv)
+}
+
+func _IssuanceEvents_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IssuanceEventsServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssuanceEvents_SubscribeServer = grpc.ServerStreamingServer[Event]
+
+// IssuanceEvents_ServiceDesc is the grpc.ServiceDesc for IssuanceEvents service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IssuanceEvents_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "events.IssuanceEvents",
+	HandlerType: (*IssuanceEventsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _IssuanceEvents_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "events.proto",
+}
@@ -0,0 +1,134 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventspb "github.com/letsencrypt/boulder/events/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// EventSource is the subset of the SA's read-only gRPC client used to read
+// issuance events.
+type EventSource interface {
+	GetIssuanceEvents(ctx context.Context, req *sapb.GetIssuanceEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[eventspb.Event], error)
+}
+
+// Tail reads the events recorded in the issuanceEvents table, in order of
+// creation, without skipping events which become visible late.
+//
+// Neither event IDs nor creation times are allocated in the order in which
+// events become visible to the SA's read replicas: a transaction may commit
+// well after it recorded its event, and replication may be delayed. So rather
+// than reading strictly after the latest event it has read, a Tail re-reads
+// every event created within lookback of that event, and discards those it
+// has already read. An event which becomes visible more than lookback after
+// it was created, relative to newer events, is still skipped.
+type Tail struct {
+	sa        EventSource
+	lookback  time.Duration
+	batchSize int64
+	clk       clock.Clock
+
+	// watermark is the latest creation time of any event read.
+	watermark time.Time
+	// seen holds the creation time of each event read which was created within
+	// lookback of the watermark, by ID.
+	seen map[int64]time.Time
+}
+
+// NewTail returns a Tail which reads events created after start, or every
+// retained event if start is zero, in batches of at most batchSize events.
+// Events created within lookback before start are read too, since they may
+// not have been visible when start was recorded.
+func NewTail(sa EventSource, start time.Time, lookback time.Duration, batchSize int64, clk clock.Clock) *Tail {
+	return &Tail{
+		sa:        sa,
+		lookback:  lookback,
+		batchSize: batchSize,
+		clk:       clk,
+		watermark: start,
+		seen:      make(map[int64]time.Time),
+	}
+}
+
+// Watermark returns the latest creation time of any event read so far, or the
+// start time if none have been read. Any event read later which was created
+// before the watermark became visible late.
+func (t *Tail) Watermark() time.Time {
+	return t.watermark
+}
+
+// Poll calls fn, in order of creation, for each visible event which hasn't
+// already been read. If fn returns an error, Poll stops and returns it, and
+// the event will be read again by the next call to Poll.
+func (t *Tail) Poll(ctx context.Context, fn func(*eventspb.Event) error) error {
+	createdBefore := timestamppb.New(t.clk.Now())
+	var createdAfter *timestamppb.Timestamp
+	if !t.watermark.IsZero() {
+		createdAfter = timestamppb.New(t.watermark.Add(-t.lookback))
+	}
+	var afterID int64
+
+	for {
+		events, err := t.sa.GetIssuanceEvents(ctx, &sapb.GetIssuanceEventsRequest{
+			CreatedAfter:  createdAfter,
+			AfterID:       afterID,
+			CreatedBefore: createdBefore,
+			Limit:         t.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		var read int64
+		for {
+			event, err := events.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			read++
+			createdAfter = event.Created
+			afterID = event.Id
+
+			_, ok := t.seen[event.Id]
+			if ok {
+				continue
+			}
+			err = fn(event)
+			if err != nil {
+				return err
+			}
+			created := event.Created.AsTime()
+			t.seen[event.Id] = created
+			if created.After(t.watermark) {
+				t.watermark = created
+			}
+		}
+		t.forget()
+
+		// A short batch means that there are no more events to read yet.
+		if read < t.batchSize {
+			return nil
+		}
+	}
+}
+
+// forget discards the IDs of events which will no longer be re-read.
+func (t *Tail) forget() {
+	cutoff := t.watermark.Add(-t.lookback)
+	for id, created := range t.seen {
+		if created.Before(cutoff) {
+			delete(t.seen, id)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	eventspb "github.com/letsencrypt/boulder/events/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestTail(t *testing.T) {
+	t.Parallel()
+
+	fc := clock.NewFake()
+	fc.Set(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	t0 := fc.Now()
+	msa := &mockSA{events: []*eventspb.Event{
+		deactivated(7, t0.Add(-2*time.Second)),
+		deactivated(9, t0.Add(-time.Second)),
+	}}
+	tail := NewTail(msa, time.Time{}, time.Minute, 10, fc)
+
+	poll := func() []int64 {
+		t.Helper()
+		var ids []int64
+		err := tail.Poll(context.Background(), func(event *eventspb.Event) error {
+			ids = append(ids, event.Id)
+			return nil
+		})
+		test.AssertNotError(t, err, "Poll failed")
+		return ids
+	}
+
+	test.AssertDeepEquals(t, poll(), []int64{7, 9})
+	test.AssertEquals(t, tail.Watermark(), t0.Add(-time.Second))
+
+	// With nothing new, nothing is read, although the lookback is re-read.
+	test.AssertEquals(t, len(poll()), 0)
+	test.AssertEquals(t, msa.requests[len(msa.requests)-1].CreatedAfter.AsTime(), t0.Add(-time.Second-time.Minute))
+
+	// An event which becomes visible after a newer one is still read, as long
+	// as it was created within the lookback of the newest event read. An
+	// event created before that is skipped.
+	fc.Add(time.Minute)
+	msa.events = append(msa.events,
+		deactivated(8, t0.Add(-3*time.Second)),
+		deactivated(3, t0.Add(-2*time.Minute)),
+		deactivated(10, t0.Add(30*time.Second)),
+	)
+	test.AssertDeepEquals(t, poll(), []int64{8, 10})
+	test.AssertEquals(t, tail.Watermark(), t0.Add(30*time.Second))
+
+	// Events created at or after the time of the poll aren't read yet.
+	msa.events = append(msa.events, deactivated(11, fc.Now()))
+	test.AssertEquals(t, len(poll()), 0)
+	fc.Add(time.Second)
+	test.AssertDeepEquals(t, poll(), []int64{11})
+
+	// Events which were forgotten, since they were created more than the
+	// lookback before the watermark, are no longer re-read.
+	test.AssertEquals(t, len(tail.seen), 2)
+
+	// An event whose callback fails is read again by the next poll.
+	msa.events = append(msa.events, deactivated(12, fc.Now().Add(-time.Millisecond)))
+	err := tail.Poll(context.Background(), func(*eventspb.Event) error {
+		return errors.New("oops")
+	})
+	test.AssertError(t, err, "Poll should have failed")
+	test.AssertDeepEquals(t, poll(), []int64{12})
+
+	// SA errors are returned.
+	msa.err = errors.New("oops")
+	err = tail.Poll(context.Background(), func(*eventspb.Event) error { return nil })
+	test.AssertError(t, err, "Poll should have failed")
+}
@@ -94,6 +94,18 @@ type Config struct {
 	// so we can avoid the possibility of Authz re-use by the original
 	// requester via Authz revocation.
 	RevokeAuthzsUponRevokeCert bool
+
+	// WriteIssuanceEvents controls whether the SA records order finalization,
+	// certificate revocation, identifier pausing, and account deactivation
+	// events in the issuanceEvents table, from which they are exported by the
+	// event-exporter. This requires a database change to work.
+	WriteIssuanceEvents bool
+
+	// RateLimitOverrideExpiry controls whether the SA stores and honors the
+	// optional expiry time of rate limit overrides. Expired overrides are not
+	// returned by GetEnabledRateLimitOverrides. This requires a database change
+	// to work.
+	RateLimitOverrideExpiry bool
 }
 
 var fMu = new(sync.RWMutex)
@@ -3,13 +3,16 @@ package issuance
 import (
 	"crypto/rand"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	"github.com/zmap/zlint/v3/lint"
 
 	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/crl/delta"
 	"github.com/letsencrypt/boulder/crl/idp"
 	"github.com/letsencrypt/boulder/linter"
 )
@@ -24,11 +27,17 @@ type CRLProfileConfig struct {
 	// IgnoredLints is a list of lint names that we know will fail for this
 	// profile, and which we know it is safe to ignore.
 	IgnoredLints []string
+
+	// DeltaCRLs allows the issuance of delta CRLs, and of full CRLs which point
+	// to their shard's delta CRLs with a Freshest CRL extension. If false, both
+	// are rejected, and so are CRLs which would fail linter.DeltaCRLLints.
+	DeltaCRLs bool
 }
 
 type CRLProfile struct {
 	validityInterval time.Duration
 	maxBackdate      time.Duration
+	deltaCRLs        bool
 
 	lints lint.Registry
 }
@@ -45,7 +54,11 @@ func NewCRLProfile(config CRLProfileConfig) (*CRLProfile, error) {
 		return nil, fmt.Errorf("crl max backdate must be non-negative, got %q", config.MaxBackdate)
 	}
 
-	reg, err := linter.NewRegistry(config.IgnoredLints)
+	ignoredLints := config.IgnoredLints
+	if config.DeltaCRLs {
+		ignoredLints = append(slices.Clone(ignoredLints), linter.DeltaCRLLints...)
+	}
+	reg, err := linter.NewRegistry(ignoredLints)
 	if err != nil {
 		return nil, fmt.Errorf("creating lint registry: %w", err)
 	}
@@ -60,6 +73,7 @@ func NewCRLProfile(config CRLProfileConfig) (*CRLProfile, error) {
 	return &CRLProfile{
 		validityInterval: config.ValidityInterval.Duration,
 		maxBackdate:      config.MaxBackdate.Duration,
+		deltaCRLs:        config.DeltaCRLs,
 		lints:            reg,
 	}, nil
 }
@@ -71,6 +85,17 @@ type CRLRequest struct {
 	ThisUpdate time.Time
 
 	Entries []x509.RevocationListEntry
+
+	// DeltaBase, if set, makes this a delta CRL which lists only the changes
+	// since the full CRL with this CRL Number.
+	DeltaBase *big.Int
+	// FreshestCRL, if true, makes this full CRL point to its shard's delta CRLs
+	// with a Freshest CRL extension. It cannot be combined with DeltaBase.
+	FreshestCRL bool
+	// NextUpdate is the delta CRL's NextUpdate, which must be set if DeltaBase
+	// is, and no later than a full CRL's. Full CRLs' NextUpdate is determined by
+	// the profile instead.
+	NextUpdate time.Time
 }
 
 // crlURL combines the CRL URL base with a shard, and adds a suffix.
@@ -78,6 +103,11 @@ func (i *Issuer) crlURL(shard int) string {
 	return fmt.Sprintf("%s%d.crl", i.crlURLBase, shard)
 }
 
+// deltaCRLURL is like crlURL, but for the shard's delta CRLs.
+func (i *Issuer) deltaCRLURL(shard int) string {
+	return fmt.Sprintf("%s%d-delta.crl", i.crlURLBase, shard)
+}
+
 func (i *Issuer) IssueCRL(prof *CRLProfile, req *CRLRequest) ([]byte, error) {
 	backdatedBy := i.clk.Now().Sub(req.ThisUpdate)
 	if backdatedBy > prof.maxBackdate {
@@ -87,6 +117,16 @@ func (i *Issuer) IssueCRL(prof *CRLProfile, req *CRLRequest) ([]byte, error) {
 		return nil, fmt.Errorf("ThisUpdate is in the future (%s>%s)", req.ThisUpdate, i.clk.Now())
 	}
 
+	if (req.DeltaBase != nil || req.FreshestCRL) && !prof.deltaCRLs {
+		return nil, errors.New("CRL profile does not allow delta CRLs")
+	}
+	if req.DeltaBase != nil && req.FreshestCRL {
+		return nil, errors.New("delta CRLs cannot have a Freshest CRL extension")
+	}
+	if req.DeltaBase != nil && req.DeltaBase.Cmp(req.Number) >= 0 {
+		return nil, fmt.Errorf("delta CRL's base (%s) must precede it (%s)", req.DeltaBase, req.Number)
+	}
+
 	template := &x509.RevocationList{
 		RevokedCertificateEntries: req.Entries,
 		Number:                    req.Number,
@@ -94,6 +134,20 @@ func (i *Issuer) IssueCRL(prof *CRLProfile, req *CRLRequest) ([]byte, error) {
 		NextUpdate:                req.ThisUpdate.Add(-time.Second).Add(prof.validityInterval),
 	}
 
+	// A delta CRL is replaced far more often than a full CRL, so its NextUpdate
+	// is chosen by the caller, within the bounds of the profile.
+	if req.DeltaBase != nil {
+		if !req.NextUpdate.After(req.ThisUpdate) {
+			return nil, fmt.Errorf("delta CRL's NextUpdate (%s) must follow its ThisUpdate (%s)", req.NextUpdate, req.ThisUpdate)
+		}
+		if req.NextUpdate.After(template.NextUpdate) {
+			return nil, fmt.Errorf("delta CRL's NextUpdate (%s) is later than a full CRL's (%s)", req.NextUpdate, template.NextUpdate)
+		}
+		template.NextUpdate = req.NextUpdate
+	} else if !req.NextUpdate.IsZero() {
+		return nil, errors.New("only delta CRLs can set their NextUpdate")
+	}
+
 	if i.crlURLBase == "" {
 		return nil, fmt.Errorf("CRL must contain an issuingDistributionPoint")
 	}
@@ -108,6 +162,24 @@ func (i *Issuer) IssueCRL(prof *CRLProfile, req *CRLRequest) ([]byte, error) {
 	}
 	template.ExtraExtensions = append(template.ExtraExtensions, idp)
 
+	// A delta CRL has the same scope, and therefore the same IDP, as its base.
+	if req.DeltaBase != nil {
+		indicator, err := delta.MakeIndicatorExt(req.DeltaBase)
+		if err != nil {
+			return nil, fmt.Errorf("creating Delta CRL Indicator extension: %w", err)
+		}
+		template.ExtraExtensions = append(template.ExtraExtensions, indicator)
+	}
+	if req.FreshestCRL {
+		freshest, err := delta.MakeFreshestCRLExt([]string{
+			i.deltaCRLURL(int(req.Shard)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating Freshest CRL extension: %w", err)
+		}
+		template.ExtraExtensions = append(template.ExtraExtensions, freshest)
+	}
+
 	err = i.Linter.CheckCRL(template, prof.lints)
 	if err != nil {
 		return nil, err
@@ -13,6 +13,7 @@ import (
 	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
 
 	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/crl/delta"
 	"github.com/letsencrypt/boulder/crl/idp"
 	"github.com/letsencrypt/boulder/test"
 )
@@ -58,6 +59,20 @@ func TestNewCRLProfile(t *testing.T) {
 			},
 			expectedErr: "",
 		},
+		{
+			name: "delta CRLs",
+			config: CRLProfileConfig{
+				ValidityInterval: config.Duration{Duration: 7 * 24 * time.Hour},
+				MaxBackdate:      config.Duration{Duration: time.Hour},
+				DeltaCRLs:        true,
+			},
+			expected: &CRLProfile{
+				validityInterval: 7 * 24 * time.Hour,
+				maxBackdate:      time.Hour,
+				deltaCRLs:        true,
+			},
+			expectedErr: "",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -76,6 +91,7 @@ func TestNewCRLProfile(t *testing.T) {
 				}
 				test.AssertEquals(t, actual.validityInterval, tc.expected.validityInterval)
 				test.AssertEquals(t, actual.maxBackdate, tc.expected.maxBackdate)
+				test.AssertEquals(t, actual.deltaCRLs, tc.expected.deltaCRLs)
 				test.AssertNotNil(t, actual.lints, "lint registry should be populated")
 			}
 		})
@@ -172,6 +188,107 @@ func TestIssueCRL(t *testing.T) {
 	test.Assert(t, !found, "Violation of RFC 5280 Section 5.1.2.6")
 }
 
+func TestIssueDeltaCRL(t *testing.T) {
+	clk := clock.NewFake()
+	clk.Set(time.Now())
+
+	issuer, err := newIssuer(defaultIssuerConfig(), issuerCert, issuerSigner, clk)
+	test.AssertNotError(t, err, "creating test issuer")
+
+	noDeltaProfile, err := NewCRLProfile(CRLProfileConfig{
+		ValidityInterval: config.Duration{Duration: 7 * 24 * time.Hour},
+		MaxBackdate:      config.Duration{Duration: time.Hour},
+	})
+	test.AssertNotError(t, err, "creating test profile")
+	deltaProfile, err := NewCRLProfile(CRLProfileConfig{
+		ValidityInterval: config.Duration{Duration: 7 * 24 * time.Hour},
+		MaxBackdate:      config.Duration{Duration: time.Hour},
+		DeltaCRLs:        true,
+	})
+	test.AssertNotError(t, err, "creating test profile")
+
+	defaultRequest := CRLRequest{
+		Number:     big.NewInt(123),
+		Shard:      100,
+		ThisUpdate: clk.Now().Add(-time.Second),
+		Entries: []x509.RevocationListEntry{
+			{
+				SerialNumber:   big.NewInt(987),
+				RevocationTime: clk.Now().Add(-time.Hour),
+				ReasonCode:     1,
+			},
+		},
+	}
+
+	// Profiles which don't allow deltas reject both kinds of request.
+	req := defaultRequest
+	req.DeltaBase = big.NewInt(100)
+	_, err = issuer.IssueCRL(noDeltaProfile, &req)
+	test.AssertError(t, err, "delta CRL should be rejected")
+	test.AssertContains(t, err.Error(), "does not allow delta CRLs")
+
+	req = defaultRequest
+	req.FreshestCRL = true
+	_, err = issuer.IssueCRL(noDeltaProfile, &req)
+	test.AssertError(t, err, "Freshest CRL should be rejected")
+	test.AssertContains(t, err.Error(), "does not allow delta CRLs")
+
+	// A full CRL points to its shard's delta CRLs.
+	res, err := issuer.IssueCRL(deltaProfile, &req)
+	test.AssertNotError(t, err, "issuing full CRL with Freshest CRL")
+	parsedRes, err := x509.ParseRevocationList(res)
+	test.AssertNotError(t, err, "parsing test crl")
+	freshest, err := delta.GetFreshestCRLURIs(parsedRes.Extensions)
+	test.AssertNotError(t, err, "getting Freshest CRL URIs from test CRL")
+	test.AssertDeepEquals(t, freshest, []string{"http://crl-url.example.org/100-delta.crl"})
+	base, err := delta.GetBaseCRLNumber(parsedRes.Extensions)
+	test.AssertNotError(t, err, "getting base CRL number from test CRL")
+	test.Assert(t, base == nil, "full CRL should not have a Delta CRL Indicator")
+
+	// A full CRL's NextUpdate comes from the profile.
+	req.NextUpdate = req.ThisUpdate.Add(time.Hour)
+	_, err = issuer.IssueCRL(deltaProfile, &req)
+	test.AssertError(t, err, "full CRL cannot set NextUpdate")
+	test.AssertContains(t, err.Error(), "only delta CRLs")
+
+	// A delta CRL's NextUpdate must be set, and no later than a full CRL's.
+	req = defaultRequest
+	req.DeltaBase = big.NewInt(100)
+	_, err = issuer.IssueCRL(deltaProfile, &req)
+	test.AssertError(t, err, "delta CRL must set NextUpdate")
+	test.AssertContains(t, err.Error(), "must follow its ThisUpdate")
+
+	req.NextUpdate = req.ThisUpdate.Add(8 * 24 * time.Hour)
+	_, err = issuer.IssueCRL(deltaProfile, &req)
+	test.AssertError(t, err, "delta CRL cannot outlive a full CRL")
+	test.AssertContains(t, err.Error(), "later than a full CRL's")
+
+	// A delta CRL has the same IDP as its base, and refers to its base's number.
+	req.NextUpdate = req.ThisUpdate.Add(time.Hour)
+	res, err = issuer.IssueCRL(deltaProfile, &req)
+	test.AssertNotError(t, err, "issuing delta CRL")
+	parsedRes, err = x509.ParseRevocationList(res)
+	test.AssertNotError(t, err, "parsing test crl")
+	test.AssertEquals(t, parsedRes.NextUpdate, req.NextUpdate.Truncate(time.Second).UTC())
+	base, err = delta.GetBaseCRLNumber(parsedRes.Extensions)
+	test.AssertNotError(t, err, "getting base CRL number from test CRL")
+	test.AssertDeepEquals(t, base, big.NewInt(100))
+	idps, err := idp.GetIDPURIs(parsedRes.Extensions)
+	test.AssertNotError(t, err, "getting IDP URIs from test CRL")
+	test.AssertDeepEquals(t, idps, []string{"http://crl-url.example.org/100.crl"})
+
+	req.DeltaBase = big.NewInt(123)
+	_, err = issuer.IssueCRL(deltaProfile, &req)
+	test.AssertError(t, err, "delta CRL must follow its base")
+	test.AssertContains(t, err.Error(), "must precede it")
+
+	req.DeltaBase = big.NewInt(100)
+	req.FreshestCRL = true
+	_, err = issuer.IssueCRL(deltaProfile, &req)
+	test.AssertError(t, err, "delta CRL cannot have Freshest CRL")
+	test.AssertContains(t, err.Error(), "cannot have a Freshest CRL")
+}
+
 // revokedCertificatesFieldExists is a modified version of
 // x509.ParseRevocationList that takes a given sequence of bytes representing a
 // CRL and parses away layers until the optional `revokedCertificates` field of
@@ -26,6 +26,14 @@ import (
 
 var ErrLinting = fmt.Errorf("failed lint(s)")
 
+// DeltaCRLLints are the lints which forbid delta CRLs and Freshest CRL
+// extensions. They must be skipped when linting CRLs issued under a profile
+// which allows delta CRLs. The Baseline Requirements, Section 7.2.2, only
+// discourage those extensions, so e_crl_extensions_validity merely warns about
+// them; the criticality of the other extensions it checks is also checked by
+// e_crl_has_number, e_crl_has_aki, and e_crl_has_idp.
+var DeltaCRLLints = []string{"e_crl_is_not_delta", "e_crl_extensions_validity"}
+
 // Check accomplishes the entire process of linting: it generates a throwaway
 // signing key, uses that to create a linting cert, and runs a default set of
 // lints (everything except for the ETSI and EV lints) against it. If the
@@ -0,0 +1,106 @@
+package rfc
+
+import (
+	"math/big"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+
+	"github.com/letsencrypt/boulder/linter/lints"
+)
+
+type crlDeltaIsWellFormed struct{}
+
+/************************************************
+RFC 5280: 5.2.4
+The delta CRL indicator is a critical CRL extension that identifies a CRL as
+being a delta CRL.
+...
+The value of BaseCRLNumber identifies the CRL number of the complete CRL that
+was used as the foundation in the generation of this delta CRL.
+
+RFC 5280: 5.2.6
+The freshest CRL extension identifies how delta CRL information for this
+complete CRL is obtained. The extension MUST be marked as non-critical by
+conforming CRL issuers. This extension MUST NOT appear in delta CRLs.
+
+A delta CRL is necessarily issued after its base CRL, and CRL numbers increase
+monotonically (Section 5.2.3), so we also require that the BaseCRLNumber be
+less than the delta CRL's own CRL number.
+************************************************/
+
+func init() {
+	lint.RegisterRevocationListLint(&lint.RevocationListLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_crl_delta_is_well_formed",
+			Description:   "Delta CRLs and Freshest CRL extensions must be well-formed",
+			Citation:      "RFC 5280: 5.2.4, 5.2.6",
+			Source:        lint.RFC5280,
+			EffectiveDate: util.RFC5280Date,
+		},
+		Lint: NewCrlDeltaIsWellFormed,
+	})
+}
+
+func NewCrlDeltaIsWellFormed() lint.RevocationListLintInterface {
+	return &crlDeltaIsWellFormed{}
+}
+
+var (
+	deltaCRLIndicatorOID = asn1.ObjectIdentifier{2, 5, 29, 27} // id-ce-deltaCRLIndicator
+	freshestCRLOID       = asn1.ObjectIdentifier{2, 5, 29, 46} // id-ce-freshestCRL
+)
+
+func (l *crlDeltaIsWellFormed) CheckApplies(c *x509.RevocationList) bool {
+	return lints.GetExtWithOID(c.Extensions, deltaCRLIndicatorOID) != nil ||
+		lints.GetExtWithOID(c.Extensions, freshestCRLOID) != nil
+}
+
+func (l *crlDeltaIsWellFormed) Execute(c *x509.RevocationList) *lint.LintResult {
+	freshest := lints.GetExtWithOID(c.Extensions, freshestCRLOID)
+	if freshest != nil && freshest.Critical {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "Freshest CRL MUST be marked as non-critical",
+		}
+	}
+
+	indicator := lints.GetExtWithOID(c.Extensions, deltaCRLIndicatorOID)
+	if indicator == nil {
+		return &lint.LintResult{Status: lint.Pass}
+	}
+
+	if !indicator.Critical {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "Delta CRL Indicator MUST be marked as critical",
+		}
+	}
+
+	if freshest != nil {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "Freshest CRL MUST NOT appear in delta CRLs",
+		}
+	}
+
+	var base *big.Int
+	rest, err := asn1.Unmarshal(indicator.Value, &base)
+	if err != nil || len(rest) != 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "Delta CRL Indicator must contain a BaseCRLNumber",
+		}
+	}
+
+	if c.Number == nil || base.Cmp(c.Number) >= 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "BaseCRLNumber must be less than the delta CRL's CRL Number",
+		}
+	}
+
+	return &lint.LintResult{Status: lint.Pass}
+}
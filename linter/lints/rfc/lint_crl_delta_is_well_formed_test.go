@@ -0,0 +1,69 @@
+package rfc
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+
+	"github.com/letsencrypt/boulder/linter/lints/test"
+)
+
+func TestCrlDeltaIsWellFormed(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		want       lint.LintStatus
+		wantSubStr string
+	}{
+		{
+			name: "good",
+			want: lint.Pass,
+		},
+		{
+			name: "delta_good",
+			want: lint.Pass,
+		},
+		{
+			name: "freshest_good",
+			want: lint.Pass,
+		},
+		{
+			name:       "delta_noncritical",
+			want:       lint.Error,
+			wantSubStr: "MUST be marked as critical",
+		},
+		{
+			name:       "delta_base_too_new",
+			want:       lint.Error,
+			wantSubStr: "must be less than",
+		},
+		{
+			name:       "delta_freshest",
+			want:       lint.Error,
+			wantSubStr: "MUST NOT appear in delta CRLs",
+		},
+		{
+			name:       "freshest_critical",
+			want:       lint.Error,
+			wantSubStr: "MUST be marked as non-critical",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := NewCrlDeltaIsWellFormed()
+			c := test.LoadPEMCRL(t, fmt.Sprintf("testdata/crl_%s.pem", tc.name))
+			r := l.Execute(c)
+
+			if r.Status != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, r.Status)
+			}
+			if !strings.Contains(r.Details, tc.wantSubStr) {
+				t.Errorf("expected %q, got %q", tc.wantSubStr, r.Details)
+			}
+		})
+	}
+}
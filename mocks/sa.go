@@ -17,6 +17,7 @@ import (
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	berrors "github.com/letsencrypt/boulder/errors"
+	eventspb "github.com/letsencrypt/boulder/events/proto"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
@@ -234,6 +235,11 @@ func (sa *StorageAuthorityReadOnly) GetEnabledRateLimitOverrides(_ context.Conte
 	return nil, nil
 }
 
+// GetIssuanceEvents is a mock
+func (sa *StorageAuthorityReadOnly) GetIssuanceEvents(_ context.Context, _ *sapb.GetIssuanceEventsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[eventspb.Event], error) {
+	return &ServerStreamClient[eventspb.Event]{}, nil
+}
+
 // FQDNSetTimestampsForWindow is a mock
 func (sa *StorageAuthorityReadOnly) FQDNSetTimestampsForWindow(_ context.Context, _ *sapb.CountFQDNSetsRequest, _ ...grpc.CallOption) (*sapb.Timestamps, error) {
 	return &sapb.Timestamps{}, nil
@@ -354,6 +360,16 @@ func (sa *StorageAuthorityReadOnly) GetSerialsByKey(ctx context.Context, _ *sapb
 	return &ServerStreamClient[sapb.Serial]{}, nil
 }
 
+// GetOrdersByAccount is a mock
+func (sa *StorageAuthorityReadOnly) GetOrdersByAccount(ctx context.Context, _ *sapb.GetOrdersByAccountRequest, _ ...grpc.CallOption) (sapb.StorageAuthorityReadOnly_GetOrdersByAccountClient, error) {
+	return &ServerStreamClient[corepb.Order]{}, nil
+}
+
+// GetSerialsByName is a mock
+func (sa *StorageAuthorityReadOnly) GetSerialsByName(ctx context.Context, _ *sapb.GetSerialsByNameRequest, _ ...grpc.CallOption) (sapb.StorageAuthorityReadOnly_GetSerialsByNameClient, error) {
+	return &ServerStreamClient[sapb.Serial]{}, nil
+}
+
 // GetSerialsByAccount is a mock
 func (sa *StorageAuthorityReadOnly) GetSerialsByAccount(ctx context.Context, _ *sapb.RegistrationID, _ ...grpc.CallOption) (sapb.StorageAuthorityReadOnly_GetSerialsByAccountClient, error) {
 	return &ServerStreamClient[sapb.Serial]{}, nil
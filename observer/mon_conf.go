@@ -14,7 +14,7 @@ import (
 // MonConf is exported to receive YAML configuration in `ObsConf`.
 type MonConf struct {
 	Period   config.Duration  `yaml:"period"`
-	Kind     string           `yaml:"kind" validate:"required,oneof=DNS HTTP CRL TLS AIA CCADB"`
+	Kind     string           `yaml:"kind" validate:"required,oneof=DNS HTTP CRL TLS AIA CCADB ACME CT"`
 	Settings probers.Settings `yaml:"settings" validate:"min=1,dive"`
 }
 
@@ -46,7 +46,7 @@ func (c MonConf) unmarshalConfigurer() (probers.Configurer, error) {
 // makeMonitor constructs a `monitor` object from the contents of the
 // bound `MonConf`. If the `MonConf` cannot be validated, an error
 // appropriate for end-user consumption is returned instead.
-func (c MonConf) makeMonitor(collectors map[string]prometheus.Collector) (*monitor, error) {
+func (c MonConf) makeMonitor(collectors map[string]prometheus.Collector, historySize int) (*monitor, error) {
 	err := c.validatePeriod()
 	if err != nil {
 		return nil, err
@@ -59,5 +59,5 @@ func (c MonConf) makeMonitor(collectors map[string]prometheus.Collector) (*monit
 	if err != nil {
 		return nil, err
 	}
-	return &monitor{c.Period.Duration, prober}, nil
+	return &monitor{c.Period.Duration, prober, newHistory(historySize)}, nil
 }
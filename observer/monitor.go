@@ -11,8 +11,9 @@ import (
 )
 
 type monitor struct {
-	period time.Duration
-	prober probers.Prober
+	period  time.Duration
+	prober  probers.Prober
+	history *history
 }
 
 // start spins off a 'Prober' goroutine approximately once per `m.period`,
@@ -43,6 +44,13 @@ func (m monitor) start(ctx context.Context, logger blog.Logger) {
 				m.prober.Name(), m.prober.Kind(), strconv.FormatBool(err == nil),
 			).Observe(dur.Seconds())
 
+			// Keep the result to be served by the status API.
+			res := result{Time: start, LatencySeconds: dur.Seconds(), Success: err == nil}
+			if err != nil {
+				res.Error = err.Error()
+			}
+			m.history.add(res)
+
 			// Log the outcome of the probe attempt.
 			if err != nil {
 				logger.Errf("kind=[%s] success=[%t] duration=[%f] name=[%s] error=[%s]",
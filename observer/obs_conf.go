@@ -25,6 +25,8 @@ var (
 // ObsConf is exported to receive YAML configuration.
 type ObsConf struct {
 	DebugAddr     string           `yaml:"debugaddr" validate:"omitempty,hostname_port"`
+	StatusAddr    string           `yaml:"statusaddr" validate:"omitempty,hostname_port"`
+	HistorySize   int              `yaml:"historysize" validate:"omitempty,min=1"`
 	Buckets       []float64        `yaml:"buckets" validate:"min=1,dive"`
 	Syslog        cmd.SyslogConfig `yaml:"syslog"`
 	OpenTelemetry cmd.OpenTelemetryConfig
@@ -34,6 +36,10 @@ type ObsConf struct {
 func (c *ObsConf) makeMonitors(metrics prometheus.Registerer) ([]*monitor, []error, error) {
 	var errs []error
 	var monitors []*monitor
+	historySize := c.HistorySize
+	if historySize < 1 {
+		historySize = defaultHistorySize
+	}
 	proberSpecificMetrics := make(map[string]map[string]prometheus.Collector)
 	for e, m := range c.MonConfs {
 		entry := strconv.Itoa(e + 1)
@@ -64,7 +70,7 @@ func (c *ObsConf) makeMonitors(metrics prometheus.Registerer) ([]*monitor, []err
 			}
 		}
 
-		monitor, err := m.makeMonitor(proberSpecificMetrics[kind])
+		monitor, err := m.makeMonitor(proberSpecificMetrics[kind], historySize)
 		if err != nil {
 			// append validation error to errs
 			errs = append(errs, fmt.Errorf("'monitors' entry #%s couldn't be validated: %w", entry, err))
@@ -115,5 +121,5 @@ func (c *ObsConf) MakeObserver() (*Observer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Observer{logger, monitors, shutdown}, nil
+	return &Observer{logger, monitors, c.StatusAddr, shutdown}, nil
 }
@@ -70,3 +70,24 @@ func TestObsConf_makeMonitors(t *testing.T) {
 		})
 	}
 }
+
+func TestObsConf_makeMonitorsHistorySize(t *testing.T) {
+	monConf := &MonConf{
+		config.Duration{Duration: time.Second}, mockConf, probers.Settings{"valid": true, "pname": "foo", "pkind": "bar"}}
+	for _, tc := range []struct {
+		historySize int
+		want        int
+	}{
+		{0, defaultHistorySize},
+		{3, 3},
+	} {
+		c := &ObsConf{HistorySize: tc.historySize, MonConfs: []*MonConf{monConf}}
+		monitors, _, err := c.makeMonitors(metrics.NoopRegisterer)
+		if err != nil {
+			t.Fatalf("ObsConf.makeMonitors() err = %v", err)
+		}
+		if got := cap(monitors[0].history.results); got != tc.want {
+			t.Errorf("ObsConf.makeMonitors() history size = %d, want %d", got, tc.want)
+		}
+	}
+}
@@ -2,12 +2,18 @@ package observer
 
 import (
 	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
 
 	"github.com/letsencrypt/boulder/cmd"
 	blog "github.com/letsencrypt/boulder/log"
+	_ "github.com/letsencrypt/boulder/observer/probers/acme"
 	_ "github.com/letsencrypt/boulder/observer/probers/aia"
 	_ "github.com/letsencrypt/boulder/observer/probers/ccadb"
 	_ "github.com/letsencrypt/boulder/observer/probers/crl"
+	_ "github.com/letsencrypt/boulder/observer/probers/ct"
 	_ "github.com/letsencrypt/boulder/observer/probers/dns"
 	_ "github.com/letsencrypt/boulder/observer/probers/http"
 	_ "github.com/letsencrypt/boulder/observer/probers/tls"
@@ -15,12 +21,14 @@ import (
 
 // Observer is the steward of goroutines started for each `monitor`.
 type Observer struct {
-	logger   blog.Logger
-	monitors []*monitor
-	shutdown func(ctx context.Context)
+	logger     blog.Logger
+	monitors   []*monitor
+	statusAddr string
+	shutdown   func(ctx context.Context)
 }
 
-// Start spins off a goroutine for each monitor, and waits for a signal to exit
+// Start spins off a goroutine for each monitor, serves the status API if it's
+// configured, and waits for a signal to exit
 func (o *Observer) Start() {
 	defer o.shutdown(context.Background())
 
@@ -31,5 +39,23 @@ func (o *Observer) Start() {
 		go mon.start(ctx, o.logger)
 	}
 
+	if o.statusAddr != "" {
+		listener, err := net.Listen("tcp", o.statusAddr)
+		cmd.FailOnError(err, "failed to listen for status API")
+		srv := &http.Server{
+			Handler:      statusHandler{o.monitors},
+			ReadTimeout:  30 * time.Second,
+			WriteTimeout: 30 * time.Second,
+		}
+		o.logger.Infof("Serving status API on %s", listener.Addr())
+		go func() {
+			err := srv.Serve(listener)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				o.logger.Errf("serving status API: %s", err)
+			}
+		}()
+		defer srv.Shutdown(context.Background())
+	}
+
 	cmd.WaitForSignal()
 }
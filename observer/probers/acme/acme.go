@@ -0,0 +1,241 @@
+package probers
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/eggsampler/acme/v3"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/observer/obsclient"
+)
+
+// The steps of an ACMEProbe, used to label its metrics.
+const (
+	stepAccount   = "account"
+	stepOrder     = "order"
+	stepAuthorize = "authorize"
+	stepFinalize  = "finalize"
+	stepDownload  = "download"
+	stepRevoke    = "revoke"
+)
+
+// ACMEProbe is the exported 'Prober' object for monitors configured to obtain,
+// and optionally revoke, a certificate from an ACME server.
+type ACMEProbe struct {
+	directory     string
+	domain        string
+	challenge     string
+	listenAddress string
+	profile       string
+	revoke        bool
+	accountKey    crypto.Signer
+	stepLatency   *prometheus.HistogramVec
+	stepFailures  *prometheus.CounterVec
+}
+
+// Name returns a string that uniquely identifies the monitor.
+func (p ACMEProbe) Name() string {
+	return fmt.Sprintf("%s-%s-%s", p.directory, p.domain, p.challenge)
+}
+
+// Kind returns a name that uniquely identifies the `Kind` of `Prober`.
+func (p ACMEProbe) Kind() string {
+	return "ACME"
+}
+
+// ctxTransport binds every request it makes to a context, because the ACME
+// client doesn't accept one.
+type ctxTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (t ctxTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(t.ctx))
+}
+
+// step runs one step of the probe, recording its latency and any failure.
+func (p ACMEProbe) step(name string, f func() error) error {
+	start := time.Now()
+	err := f()
+	p.stepLatency.WithLabelValues(p.Name(), name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		p.stepFailures.WithLabelValues(p.Name(), name).Inc()
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// Probe registers, or finds the existing, account for the configured key,
+// orders a certificate for the configured domain, completes its challenge
+// using an embedded responder, finalizes the order, downloads the certificate,
+// and, if configured, revokes it. The order's authorizations are deactivated
+// when the probe ends, so that every probe has to validate the domain afresh.
+func (p ACMEProbe) Probe(ctx context.Context) error {
+	httpClient := &http.Client{
+		Transport: ctxTransport{ctx: ctx, base: obsclient.Client(false).Transport},
+	}
+
+	var client acme.Client
+	var account acme.Account
+	err := p.step(stepAccount, func() error {
+		var err error
+		client, err = acme.NewClient(p.directory, acme.WithHTTPClient(httpClient), acme.WithUserAgentSuffix("boulder-observer"))
+		if err != nil {
+			return err
+		}
+		// Registering with an existing account's key returns that account.
+		account, err = client.NewAccount(p.accountKey, false, true)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	deadline, ok := ctx.Deadline()
+	if ok {
+		client.PollTimeout = time.Until(deadline)
+	}
+
+	var order acme.Order
+	err = p.step(stepOrder, func() error {
+		var err error
+		order, err = client.NewOrderExtension(account, []acme.Identifier{{Type: "dns", Value: p.domain}}, acme.OrderExtension{Profile: p.profile})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	defer deactivate(client, account, order)
+
+	err = p.step(stepAuthorize, func() error {
+		return p.authorize(client, account, order)
+	})
+	if err != nil {
+		return err
+	}
+
+	certKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	err = p.step(stepFinalize, func() error {
+		csr, err := makeCSR(certKey, p.domain)
+		if err != nil {
+			return err
+		}
+		order, err = client.FinalizeOrder(account, order, csr)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var cert *x509.Certificate
+	err = p.step(stepDownload, func() error {
+		certs, err := client.FetchCertificates(account, order.Certificate)
+		if err != nil {
+			return err
+		}
+		if len(certs) == 0 {
+			return errors.New("no certificates in response")
+		}
+		cert = certs[0]
+		if !slices.Contains(cert.DNSNames, p.domain) {
+			return fmt.Errorf("certificate is for %v, not %q", cert.DNSNames, p.domain)
+		}
+		certPub, err := x509.MarshalPKIXPublicKey(cert.PublicKey)
+		if err != nil {
+			return err
+		}
+		keyPub, err := x509.MarshalPKIXPublicKey(certKey.Public())
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(certPub, keyPub) {
+			return errors.New("certificate does not match the CSR's public key")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !p.revoke {
+		return nil
+	}
+	return p.step(stepRevoke, func() error {
+		return client.RevokeCertificate(account, cert, account.PrivateKey, acme.ReasonUnspecified)
+	})
+}
+
+// authorize completes the challenge of each of the order's pending
+// authorizations, serving the responses with a responder which only listens
+// for the duration of the step.
+func (p ACMEProbe) authorize(client acme.Client, account acme.Account, order acme.Order) error {
+	var resp responder
+	var err error
+	switch p.challenge {
+	case acme.ChallengeTypeHTTP01:
+		resp, err = newHTTPResponder(p.listenAddress)
+	case acme.ChallengeTypeDNS01:
+		resp, err = newDNSResponder(p.listenAddress)
+	default:
+		err = fmt.Errorf("unsupported challenge type %q", p.challenge)
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	for _, authzURL := range order.Authorizations {
+		authz, err := client.FetchAuthorization(account, authzURL)
+		if err != nil {
+			return fmt.Errorf("fetching authorization %q: %w", authzURL, err)
+		}
+		if authz.Status == "valid" {
+			// Reused from an earlier probe which didn't deactivate it, so
+			// completing the order wouldn't exercise validation.
+			return fmt.Errorf("authorization %q for %q is already valid", authzURL, authz.Identifier.Value)
+		}
+
+		chall, ok := authz.ChallengeMap[p.challenge]
+		if !ok {
+			return fmt.Errorf("authorization %q has no %s challenge", authzURL, p.challenge)
+		}
+		resp.add(authz.Identifier.Value, chall.Token, chall.KeyAuthorization)
+		_, err = client.UpdateChallenge(account, chall)
+		if err != nil {
+			return fmt.Errorf("completing %s challenge for %q: %w", p.challenge, authz.Identifier.Value, err)
+		}
+	}
+	return nil
+}
+
+// deactivate deactivates each of the order's authorizations, so that none of
+// them can be reused by a later probe. It's best effort: an authorization which
+// can't be deactivated fails the next probe instead.
+func deactivate(client acme.Client, account acme.Account, order acme.Order) {
+	for _, authzURL := range order.Authorizations {
+		_, _ = client.DeactivateAuthorization(account, authzURL)
+	}
+}
+
+// makeCSR returns a CSR for the given domain, signed by the given key.
+func makeCSR(key *ecdsa.PrivateKey, domain string) (*x509.CertificateRequest, error) {
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: []string{domain}}, key)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificateRequest(der)
+}
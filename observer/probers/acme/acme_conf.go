@@ -0,0 +1,197 @@
+package probers
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/eggsampler/acme/v3"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/letsencrypt/boulder/privatekey"
+	"github.com/letsencrypt/boulder/strictyaml"
+)
+
+const (
+	stepLatencyName  = "obs_acme_step_latency_seconds"
+	stepFailuresName = "obs_acme_step_failures"
+)
+
+// ACMEConf is exported to receive YAML configuration.
+type ACMEConf struct {
+	Directory      string `yaml:"directory"`
+	Domain         string `yaml:"domain"`
+	Challenge      string `yaml:"challenge"`
+	ListenAddress  string `yaml:"listenAddress"`
+	AccountKeyFile string `yaml:"accountKeyFile"`
+	Profile        string `yaml:"profile"`
+	Revoke         bool   `yaml:"revoke"`
+}
+
+// Kind returns a name that uniquely identifies the `Kind` of `Configurer`.
+func (c ACMEConf) Kind() string {
+	return "ACME"
+}
+
+// UnmarshalSettings constructs an ACMEConf object from YAML as bytes.
+func (c ACMEConf) UnmarshalSettings(settings []byte) (probers.Configurer, error) {
+	var conf ACMEConf
+	err := strictyaml.Unmarshal(settings, &conf)
+	if err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func (c ACMEConf) validateDirectory() error {
+	url, err := url.Parse(c.Directory)
+	if err != nil {
+		return fmt.Errorf("invalid 'directory', got: %q, expected a valid url", c.Directory)
+	}
+	if url.Scheme != "http" && url.Scheme != "https" {
+		return fmt.Errorf("invalid 'directory', got: %q, scheme must be http or https", c.Directory)
+	}
+	return nil
+}
+
+func (c ACMEConf) validateDomain() error {
+	if c.Domain == "" {
+		return fmt.Errorf("'domain' is required")
+	}
+	if strings.Contains(c.Domain, "*") || strings.Contains(c.Domain, "/") || strings.Contains(c.Domain, ":") {
+		return fmt.Errorf("invalid 'domain', got: %q, expected a non-wildcard hostname", c.Domain)
+	}
+	return nil
+}
+
+// challengeAndAddress returns the configured challenge type and responder
+// address, applying their defaults.
+func (c ACMEConf) challengeAndAddress() (string, string, error) {
+	challenge := strings.ToLower(c.Challenge)
+	var defaultPort string
+	switch challenge {
+	case "", acme.ChallengeTypeHTTP01:
+		challenge = acme.ChallengeTypeHTTP01
+		defaultPort = "80"
+	case acme.ChallengeTypeDNS01:
+		defaultPort = "53"
+	default:
+		return "", "", fmt.Errorf("invalid 'challenge', got: %q, expected %q or %q", c.Challenge, acme.ChallengeTypeHTTP01, acme.ChallengeTypeDNS01)
+	}
+
+	addr := c.ListenAddress
+	if addr == "" {
+		addr = net.JoinHostPort("", defaultPort)
+	}
+	_, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid 'listenAddress', got: %q, expected a host:port: %s", c.ListenAddress, err)
+	}
+	return challenge, addr, nil
+}
+
+// accountKey loads the configured account key, or generates one if none is
+// configured. A generated key is kept for the life of the process, so each
+// probe reuses the same account.
+func (c ACMEConf) accountKey() (crypto.Signer, error) {
+	if c.AccountKeyFile == "" {
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	key, _, err := privatekey.Load(c.AccountKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'accountKeyFile', got: %q: %s", c.AccountKeyFile, err)
+	}
+	return key, nil
+}
+
+// MakeProber constructs an `ACMEProbe` object from the contents of the bound
+// `ACMEConf` object. If the `ACMEConf` cannot be validated, an error
+// appropriate for end-user consumption is returned instead.
+func (c ACMEConf) MakeProber(collectors map[string]prometheus.Collector) (probers.Prober, error) {
+	err := c.validateDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.validateDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, addr, err := c.challengeAndAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.accountKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// validate the prometheus collectors that were passed in
+	coll, ok := collectors[stepLatencyName]
+	if !ok {
+		return nil, fmt.Errorf("acme prober did not receive collector %q", stepLatencyName)
+	}
+	stepLatencyColl, ok := coll.(*prometheus.HistogramVec)
+	if !ok {
+		return nil, fmt.Errorf("acme prober received collector %q of wrong type, got: %T, expected *prometheus.HistogramVec", stepLatencyName, coll)
+	}
+
+	coll, ok = collectors[stepFailuresName]
+	if !ok {
+		return nil, fmt.Errorf("acme prober did not receive collector %q", stepFailuresName)
+	}
+	stepFailuresColl, ok := coll.(*prometheus.CounterVec)
+	if !ok {
+		return nil, fmt.Errorf("acme prober received collector %q of wrong type, got: %T, expected *prometheus.CounterVec", stepFailuresName, coll)
+	}
+
+	return ACMEProbe{
+		directory:     c.Directory,
+		domain:        strings.ToLower(c.Domain),
+		challenge:     challenge,
+		listenAddress: addr,
+		profile:       c.Profile,
+		revoke:        c.Revoke,
+		accountKey:    key,
+		stepLatency:   stepLatencyColl,
+		stepFailures:  stepFailuresColl,
+	}, nil
+}
+
+// Instrument constructs any `prometheus.Collector` objects the `ACMEProbe`
+// will need to report its own metrics. A map is returned containing the
+// constructed objects, indexed by the name of the prometheus metric. If no
+// objects were constructed, nil is returned.
+func (c ACMEConf) Instrument() map[string]prometheus.Collector {
+	stepLatency := prometheus.Collector(prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    stepLatencyName,
+			Help:    "latency of each step of an ACME issuance probe",
+			Buckets: []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"name", "step"},
+	))
+	stepFailures := prometheus.Collector(prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: stepFailuresName,
+			Help: "count of failures of each step of an ACME issuance probe",
+		}, []string{"name", "step"},
+	))
+	return map[string]prometheus.Collector{
+		stepLatencyName:  stepLatency,
+		stepFailuresName: stepFailures,
+	}
+}
+
+// init is called at runtime and registers `ACMEConf`, a `Prober` `Configurer`
+// type, as "ACME".
+func init() {
+	probers.Register(ACMEConf{})
+}
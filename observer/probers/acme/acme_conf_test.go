@@ -0,0 +1,97 @@
+package probers
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestACMEConf_MakeProber(t *testing.T) {
+	conf := ACMEConf{}
+	colls := conf.Instrument()
+	badColl := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "obs_acme_foo",
+			Help: "Hmmm, this shouldn't be here...",
+		},
+		[]string{},
+	))
+	const dir = "https://acme.example.com/directory"
+	tests := []struct {
+		name          string
+		conf          ACMEConf
+		colls         map[string]prometheus.Collector
+		wantChallenge string
+		wantAddress   string
+		wantErr       bool
+	}{
+		// valid
+		{"defaults", ACMEConf{Directory: dir, Domain: "example.com"}, colls, "http-01", ":80", false},
+		{"http-01 with address", ACMEConf{Directory: dir, Domain: "example.com", Challenge: "HTTP-01", ListenAddress: "10.0.0.1:80"}, colls, "http-01", "10.0.0.1:80", false},
+		{"dns-01", ACMEConf{Directory: dir, Domain: "example.com", Challenge: "dns-01"}, colls, "dns-01", ":53", false},
+		{"http directory", ACMEConf{Directory: "http://boulder:4001/directory", Domain: "example.com"}, colls, "http-01", ":80", false},
+		// invalid
+		{"missing directory", ACMEConf{Domain: "example.com"}, colls, "", "", true},
+		{"bad directory scheme", ACMEConf{Directory: "ftp://example.com", Domain: "example.com"}, colls, "", "", true},
+		{"missing domain", ACMEConf{Directory: dir}, colls, "", "", true},
+		{"wildcard domain", ACMEConf{Directory: dir, Domain: "*.example.com"}, colls, "", "", true},
+		{"bad challenge", ACMEConf{Directory: dir, Domain: "example.com", Challenge: "tls-alpn-01"}, colls, "", "", true},
+		{"bad address", ACMEConf{Directory: dir, Domain: "example.com", ListenAddress: "80"}, colls, "", "", true},
+		{"missing account key", ACMEConf{Directory: dir, Domain: "example.com", AccountKeyFile: "/does/not/exist"}, colls, "", "", true},
+		{"unexpected collector", ACMEConf{Directory: dir, Domain: "example.com"}, map[string]prometheus.Collector{"obs_acme_foo": badColl}, "", "", true},
+		{"missing collectors", ACMEConf{Directory: dir, Domain: "example.com"}, map[string]prometheus.Collector{}, "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := tt.conf.MakeProber(tt.colls)
+			if tt.wantErr {
+				test.AssertError(t, err, "ACMEConf.MakeProber()")
+			} else {
+				test.AssertNotError(t, err, "ACMEConf.MakeProber()")
+
+				test.AssertNotNil(t, p, "ACMEConf.MakeProber(): nil prober")
+				prober := p.(ACMEProbe)
+				test.AssertEquals(t, prober.challenge, tt.wantChallenge)
+				test.AssertEquals(t, prober.listenAddress, tt.wantAddress)
+				test.AssertNotNil(t, prober.accountKey, "ACMEConf.MakeProber(): nil accountKey")
+				test.AssertNotNil(t, prober.stepLatency, "ACMEConf.MakeProber(): nil stepLatency")
+				test.AssertNotNil(t, prober.stepFailures, "ACMEConf.MakeProber(): nil stepFailures")
+			}
+		})
+	}
+}
+
+func TestACMEConf_UnmarshalSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  probers.Settings
+		want    probers.Configurer
+		wantErr bool
+	}{
+		{
+			"valid",
+			probers.Settings{"directory": "https://acme.example.com/directory", "domain": "example.com", "challenge": "dns-01", "revoke": true},
+			ACMEConf{Directory: "https://acme.example.com/directory", Domain: "example.com", Challenge: "dns-01", Revoke: true},
+			false,
+		},
+		{"invalid (map)", probers.Settings{"domain": make(map[string]any)}, nil, true},
+		{"invalid (unknown field)", probers.Settings{"domains": "example.com"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settingsBytes, _ := yaml.Marshal(tt.fields)
+			c := ACMEConf{}
+			got, err := c.UnmarshalSettings(settingsBytes)
+			if tt.wantErr {
+				test.AssertError(t, err, "ACMEConf.UnmarshalSettings()")
+			} else {
+				test.AssertNotError(t, err, "ACMEConf.UnmarshalSettings()")
+			}
+			test.AssertDeepEquals(t, got, tt.want)
+		})
+	}
+}
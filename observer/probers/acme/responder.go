@@ -0,0 +1,149 @@
+package probers
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eggsampler/acme/v3"
+	"github.com/miekg/dns"
+)
+
+// responder serves the responses to an ACMEProbe's challenges.
+type responder interface {
+	// add serves the response to a challenge for the given domain.
+	add(domain, token, keyAuthorization string)
+	// Close stops serving responses.
+	Close() error
+}
+
+// httpResponder serves http-01 challenge responses.
+type httpResponder struct {
+	sync.Mutex
+	keyAuthorizations map[string]string
+	listener          net.Listener
+	server            *http.Server
+}
+
+// newHTTPResponder starts serving http-01 challenge responses on the given
+// address, which must be reachable on port 80 of the domains being validated.
+func newHTTPResponder(addr string) (*httpResponder, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	r := &httpResponder{keyAuthorizations: make(map[string]string), listener: l}
+	r.server = &http.Server{Handler: r, ReadTimeout: 5 * time.Second}
+	go r.server.Serve(l)
+	return r, nil
+}
+
+func (r *httpResponder) add(_, token, keyAuthorization string) {
+	r.Lock()
+	defer r.Unlock()
+	r.keyAuthorizations[token] = keyAuthorization
+}
+
+// ServeHTTP responds to requests for /.well-known/acme-challenge/<token>.
+func (r *httpResponder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	token, ok := strings.CutPrefix(req.URL.Path, "/.well-known/acme-challenge/")
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	r.Lock()
+	keyAuthorization, ok := r.keyAuthorizations[token]
+	r.Unlock()
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	w.Write([]byte(keyAuthorization))
+}
+
+func (r *httpResponder) Close() error {
+	return r.server.Close()
+}
+
+// dnsResponder is an authoritative DNS server for dns-01 challenge responses.
+type dnsResponder struct {
+	sync.Mutex
+	records map[string][]string
+	servers []*dns.Server
+}
+
+// newDNSResponder starts serving dns-01 challenge responses over UDP and TCP
+// on the given address. The _acme-challenge subdomain of the domains being
+// validated must be delegated to it.
+func newDNSResponder(addr string) (*dnsResponder, error) {
+	r := &dnsResponder{records: make(map[string][]string)}
+
+	pc, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	// Listen on the same port over TCP, even if addr chose any free port.
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		pc.Close()
+		return nil, err
+	}
+	// Wait for both servers to start, so that they can be shut down.
+	var started sync.WaitGroup
+	started.Add(2)
+	r.servers = []*dns.Server{
+		{PacketConn: pc, Handler: r, NotifyStartedFunc: started.Done},
+		{Listener: l, Handler: r, NotifyStartedFunc: started.Done},
+	}
+	for _, s := range r.servers {
+		go s.ActivateAndServe()
+	}
+	started.Wait()
+	return r, nil
+}
+
+func (r *dnsResponder) add(domain, _, keyAuthorization string) {
+	r.Lock()
+	defer r.Unlock()
+	name := dns.Fqdn("_acme-challenge." + strings.ToLower(domain))
+	r.records[name] = append(r.records[name], acme.EncodeDNS01KeyAuthorization(keyAuthorization))
+}
+
+// ServeDNS answers TXT queries for the _acme-challenge names of domains which
+// have challenge responses, and refuses all other queries.
+func (r *dnsResponder) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Authoritative = true
+
+	r.Lock()
+	var values []string
+	if len(req.Question) == 1 {
+		values = r.records[strings.ToLower(req.Question[0].Name)]
+	}
+	r.Unlock()
+
+	switch {
+	case len(values) == 0:
+		m.Rcode = dns.RcodeRefused
+	case req.Question[0].Qtype == dns.TypeTXT:
+		for _, value := range values {
+			m.Answer = append(m.Answer, &dns.TXT{
+				Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET},
+				Txt: []string{value},
+			})
+		}
+	}
+	w.WriteMsg(m)
+}
+
+func (r *dnsResponder) Close() error {
+	var errs []error
+	for _, s := range r.servers {
+		errs = append(errs, s.Shutdown())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,76 @@
+package probers
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/eggsampler/acme/v3"
+	"github.com/miekg/dns"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestHTTPResponder(t *testing.T) {
+	t.Parallel()
+	r, err := newHTTPResponder("127.0.0.1:0")
+	test.AssertNotError(t, err, "starting responder")
+	defer r.Close()
+	r.add("example.com", "token", "token.thumbprint")
+
+	addr := "http://" + r.listener.Addr().String()
+	for _, tc := range []struct {
+		path     string
+		wantCode int
+		wantBody string
+	}{
+		{"/.well-known/acme-challenge/token", http.StatusOK, "token.thumbprint"},
+		{"/.well-known/acme-challenge/other", http.StatusNotFound, ""},
+		{"/token", http.StatusNotFound, ""},
+	} {
+		resp, err := http.Get(addr + tc.path)
+		test.AssertNotError(t, err, "fetching challenge response")
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		test.AssertNotError(t, err, "reading challenge response")
+		test.AssertEquals(t, resp.StatusCode, tc.wantCode)
+		if tc.wantBody != "" {
+			test.AssertEquals(t, string(body), tc.wantBody)
+		}
+	}
+}
+
+func TestDNSResponder(t *testing.T) {
+	t.Parallel()
+	r, err := newDNSResponder("127.0.0.1:0")
+	test.AssertNotError(t, err, "starting responder")
+	defer r.Close()
+	r.add("Example.com", "token", "token.thumbprint")
+
+	addr := r.servers[0].PacketConn.LocalAddr().String()
+	for _, net := range []string{"udp", "tcp"} {
+		for _, tc := range []struct {
+			name      string
+			qtype     uint16
+			wantRcode int
+			wantTXT   []string
+		}{
+			{"_acme-challenge.example.com.", dns.TypeTXT, dns.RcodeSuccess, []string{acme.EncodeDNS01KeyAuthorization("token.thumbprint")}},
+			{"_ACME-challenge.example.com.", dns.TypeTXT, dns.RcodeSuccess, []string{acme.EncodeDNS01KeyAuthorization("token.thumbprint")}},
+			{"_acme-challenge.example.com.", dns.TypeA, dns.RcodeSuccess, nil},
+			{"_acme-challenge.example.net.", dns.TypeTXT, dns.RcodeRefused, nil},
+		} {
+			m := new(dns.Msg)
+			m.SetQuestion(tc.name, tc.qtype)
+			resp, _, err := (&dns.Client{Net: net}).Exchange(m, addr)
+			test.AssertNotError(t, err, "querying responder")
+			test.AssertEquals(t, resp.Rcode, tc.wantRcode)
+			test.Assert(t, resp.Authoritative, "response should be authoritative")
+			var txts []string
+			for _, rr := range resp.Answer {
+				txts = append(txts, rr.(*dns.TXT).Txt...)
+			}
+			test.AssertDeepEquals(t, txts, tc.wantTXT)
+		}
+	}
+}
@@ -274,7 +274,7 @@ func checkCRL(ctx context.Context, url string, issuer *x509.Certificate, ageLimi
 		return nil, fmt.Errorf("CRL fetched from %s had mismatched IDP %s", url, idp)
 	}
 
-	return crl, checker.Validate(crl, issuer, ageLimit)
+	return crl, checker.Validate(crl, issuer, ageLimit, nil)
 }
 
 // getCSV fetches CSV from a URL and starts a *csv.Reader on it,
@@ -0,0 +1,119 @@
+package probers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	ctClient "github.com/google/certificate-transparency-go/client"
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/ctmonitor"
+)
+
+// maxClockSkew is how far in the future a tree head's timestamp may be before
+// it is considered invalid.
+const maxClockSkew = 5 * time.Minute
+
+// lastHead holds the largest tree head a CTProbe has verified, so that each
+// probe can check that the log's tree has only grown since.
+type lastHead struct {
+	sync.Mutex
+	head *ctmonitor.TreeHead
+}
+
+// CTProbe is the exported 'Prober' object for monitors configured to check the
+// health of a CT log.
+type CTProbe struct {
+	logName            string
+	client             ctmonitor.LogClient
+	submitter          *ctClient.LogClient
+	chain              []ct.ASN1Cert
+	maxAge             time.Duration
+	clk                clock.Clock
+	last               *lastHead
+	treeSize           *prometheus.GaugeVec
+	sthAge             *prometheus.GaugeVec
+	consistencyFailure *prometheus.CounterVec
+}
+
+// Name returns a string that uniquely identifies the monitor.
+func (p CTProbe) Name() string {
+	return p.logName
+}
+
+// Kind returns a name that uniquely identifies the `Kind` of `Prober`.
+func (p CTProbe) Kind() string {
+	return "CT"
+}
+
+// Probe fetches the log's signed tree head (RFC 6962) or checkpoint
+// (static-ct-api), verifies its signature, that it is fresh, and that it is
+// consistent with the largest tree head seen by earlier probes. If configured,
+// it then submits a precertificate chain and verifies the returned SCT.
+func (p CTProbe) Probe(ctx context.Context) error {
+	head, err := p.client.TreeHead(ctx)
+	if err != nil {
+		return err
+	}
+	age := p.clk.Since(head.Timestamp)
+	p.treeSize.WithLabelValues(p.logName).Set(float64(head.Size))
+	p.sthAge.WithLabelValues(p.logName).Set(age.Seconds())
+	if age > p.maxAge {
+		return fmt.Errorf("tree head of size %d is %s old, exceeding %s", head.Size, age.Round(time.Second), p.maxAge)
+	}
+	if age < -maxClockSkew {
+		return fmt.Errorf("tree head of size %d has timestamp %s in the future", head.Size, head.Timestamp)
+	}
+
+	err = p.checkConsistency(ctx, head)
+	if err != nil {
+		return err
+	}
+
+	if p.submitter == nil {
+		return nil
+	}
+	// The CT client verifies the SCT's signature, since it was constructed with
+	// the log's public key.
+	_, err = p.submitter.AddPreChain(ctx, p.chain)
+	if err != nil {
+		return fmt.Errorf("submitting precertificate: %w", err)
+	}
+	return nil
+}
+
+// checkConsistency checks that head is consistent with the largest tree head
+// seen by earlier probes, and keeps whichever of the two is larger. Logs may
+// serve older tree heads from some of their frontends, so a smaller tree head
+// is checked against the larger one, rather than reported as an error.
+func (p CTProbe) checkConsistency(ctx context.Context, head *ctmonitor.TreeHead) error {
+	p.last.Lock()
+	last := p.last.head
+	p.last.Unlock()
+
+	if last != nil {
+		older, newer := last, head
+		if older.Size > newer.Size {
+			older, newer = newer, older
+		}
+		err := p.client.CheckConsistency(ctx, older, newer)
+		if errors.Is(err, ctmonitor.ErrInconsistent) {
+			p.consistencyFailure.WithLabelValues(p.logName).Inc()
+		}
+		if err != nil {
+			return fmt.Errorf("checking tree of size %d against tree of size %d: %w", older.Size, newer.Size, err)
+		}
+	}
+
+	p.last.Lock()
+	defer p.last.Unlock()
+	if p.last.head == nil || head.Size > p.last.head.Size {
+		p.last.head = head
+	}
+	return nil
+}
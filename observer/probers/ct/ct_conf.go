@@ -0,0 +1,242 @@
+package probers
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	ctClient "github.com/google/certificate-transparency-go/client"
+	"github.com/google/certificate-transparency-go/jsonclient"
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/ctmonitor"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/observer/obsclient"
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/letsencrypt/boulder/strictyaml"
+)
+
+const (
+	treeSizeName           = "obs_ct_tree_size"
+	sthAgeName             = "obs_ct_sth_age_seconds"
+	consistencyFailureName = "obs_ct_consistency_failures"
+
+	// defaultMaxAge is used for logs which don't specify an MMD. RFC 6962 logs
+	// must produce a new STH at least once per MMD.
+	defaultMaxAge = 24 * time.Hour
+)
+
+// poisonOID identifies the critical extension which marks a precertificate.
+var poisonOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3}
+
+// CTConf is exported to receive YAML configuration.
+type CTConf struct {
+	LogListFile     string          `yaml:"logListFile"`
+	LogName         string          `yaml:"logName"`
+	MaxAge          config.Duration `yaml:"maxAge"`
+	SubmitChainFile string          `yaml:"submitChainFile"`
+	UserAgent       string          `yaml:"userAgent"`
+}
+
+// Kind returns a name that uniquely identifies the `Kind` of `Configurer`.
+func (c CTConf) Kind() string {
+	return "CT"
+}
+
+// UnmarshalSettings constructs a CTConf object from YAML as bytes.
+func (c CTConf) UnmarshalSettings(settings []byte) (probers.Configurer, error) {
+	var conf CTConf
+	err := strictyaml.Unmarshal(settings, &conf)
+	if err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+// findLog returns the log named by 'logName' from the log list in
+// 'logListFile'.
+func (c CTConf) findLog() (loglist.Log, error) {
+	if c.LogListFile == "" {
+		return loglist.Log{}, fmt.Errorf("'logListFile' is required")
+	}
+	if c.LogName == "" {
+		return loglist.Log{}, fmt.Errorf("'logName' is required")
+	}
+	logs, err := loglist.New(c.LogListFile)
+	if err != nil {
+		return loglist.Log{}, fmt.Errorf("invalid 'logListFile', got: %q: %s", c.LogListFile, err)
+	}
+	for _, log := range logs {
+		if log.Name == c.LogName {
+			return log, nil
+		}
+	}
+	return loglist.Log{}, fmt.Errorf("invalid 'logName', got: %q, not found in %q", c.LogName, c.LogListFile)
+}
+
+// loadChain loads the PEM precertificate chain from 'submitChainFile'. The
+// first certificate must be a precertificate, followed by its issuer and any
+// further intermediates.
+func (c CTConf) loadChain() ([]ct.ASN1Cert, error) {
+	pemBytes, err := os.ReadFile(c.SubmitChainFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'submitChainFile', got: %q: %s", c.SubmitChainFile, err)
+	}
+	var chain []ct.ASN1Cert
+	for {
+		var block *pem.Block
+		block, pemBytes = pem.Decode(pemBytes)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		chain = append(chain, ct.ASN1Cert{Data: block.Bytes})
+	}
+	if len(chain) < 2 {
+		return nil, fmt.Errorf("invalid 'submitChainFile', got: %q, expected a precertificate and its issuer", c.SubmitChainFile)
+	}
+	precert, err := x509.ParseCertificate(chain[0].Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'submitChainFile', got: %q: %s", c.SubmitChainFile, err)
+	}
+	for _, ext := range precert.Extensions {
+		if ext.Id.Equal(poisonOID) {
+			return chain, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid 'submitChainFile', got: %q, first certificate is not a precertificate", c.SubmitChainFile)
+}
+
+// MakeProber constructs a `CTProbe` object from the contents of the bound
+// `CTConf` object. If the `CTConf` cannot be validated, an error appropriate
+// for end-user consumption is returned instead.
+func (c CTConf) MakeProber(collectors map[string]prometheus.Collector) (probers.Prober, error) {
+	log, err := c.findLog()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.MaxAge.Duration < 0 {
+		return nil, fmt.Errorf("invalid 'maxAge', got: %s, expected a positive duration", c.MaxAge.Duration)
+	}
+	maxAge := c.MaxAge.Duration
+	if maxAge == 0 {
+		maxAge = log.MMD
+	}
+	if maxAge == 0 {
+		maxAge = defaultMaxAge
+	}
+
+	userAgent := c.UserAgent
+	if userAgent == "" {
+		userAgent = "boulder-observer"
+	}
+	httpClient := obsclient.Client(false)
+
+	client, err := ctmonitor.NewLogClient(log, userAgent, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var submitter *ctClient.LogClient
+	var chain []ct.ASN1Cert
+	if c.SubmitChainFile != "" {
+		chain, err = c.loadChain()
+		if err != nil {
+			return nil, err
+		}
+		submitter, err = ctClient.New(strings.TrimSuffix(log.Url, "/"), httpClient, jsonclient.Options{
+			PublicKeyDER: log.Key,
+			UserAgent:    userAgent,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("making CT client for %q: %s", log.Name, err)
+		}
+	}
+
+	// validate the prometheus collectors that were passed in
+	coll, ok := collectors[treeSizeName]
+	if !ok {
+		return nil, fmt.Errorf("ct prober did not receive collector %q", treeSizeName)
+	}
+	treeSizeColl, ok := coll.(*prometheus.GaugeVec)
+	if !ok {
+		return nil, fmt.Errorf("ct prober received collector %q of wrong type, got: %T, expected *prometheus.GaugeVec", treeSizeName, coll)
+	}
+
+	coll, ok = collectors[sthAgeName]
+	if !ok {
+		return nil, fmt.Errorf("ct prober did not receive collector %q", sthAgeName)
+	}
+	sthAgeColl, ok := coll.(*prometheus.GaugeVec)
+	if !ok {
+		return nil, fmt.Errorf("ct prober received collector %q of wrong type, got: %T, expected *prometheus.GaugeVec", sthAgeName, coll)
+	}
+
+	coll, ok = collectors[consistencyFailureName]
+	if !ok {
+		return nil, fmt.Errorf("ct prober did not receive collector %q", consistencyFailureName)
+	}
+	consistencyFailureColl, ok := coll.(*prometheus.CounterVec)
+	if !ok {
+		return nil, fmt.Errorf("ct prober received collector %q of wrong type, got: %T, expected *prometheus.CounterVec", consistencyFailureName, coll)
+	}
+
+	return CTProbe{
+		logName:            log.Name,
+		client:             client,
+		submitter:          submitter,
+		chain:              chain,
+		maxAge:             maxAge,
+		clk:                clock.New(),
+		last:               &lastHead{},
+		treeSize:           treeSizeColl,
+		sthAge:             sthAgeColl,
+		consistencyFailure: consistencyFailureColl,
+	}, nil
+}
+
+// Instrument constructs any `prometheus.Collector` objects the `CTProbe` will
+// need to report its own metrics. A map is returned containing the constructed
+// objects, indexed by the name of the prometheus metric. If no objects were
+// constructed, nil is returned.
+func (c CTConf) Instrument() map[string]prometheus.Collector {
+	treeSize := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: treeSizeName,
+			Help: "size of the CT log's most recently fetched tree head",
+		}, []string{"log"},
+	))
+	sthAge := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: sthAgeName,
+			Help: "age in seconds of the CT log's most recently fetched tree head",
+		}, []string{"log"},
+	))
+	consistencyFailure := prometheus.Collector(prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: consistencyFailureName,
+			Help: "count of CT log tree heads found to be inconsistent with an earlier tree head",
+		}, []string{"log"},
+	))
+	return map[string]prometheus.Collector{
+		treeSizeName:           treeSize,
+		sthAgeName:             sthAge,
+		consistencyFailureName: consistencyFailure,
+	}
+}
+
+// init is called at runtime and registers `CTConf`, a `Prober` `Configurer`
+// type, as "CT".
+func init() {
+	probers.Register(CTConf{})
+}
@@ -0,0 +1,154 @@
+package probers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/letsencrypt/boulder/test"
+)
+
+const logListFile = "../../../test/ct-test-srv/log_list.json"
+
+// writeChain writes a PEM file containing a certificate, which is a
+// precertificate if precert is true, followed by its self-signed issuer.
+func writeChain(t *testing.T, precert bool) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	issuerTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test issuer"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	issuerDER, err := x509.CreateCertificate(rand.Reader, issuerTmpl, issuerTmpl, key.Public(), key)
+	test.AssertNotError(t, err, "creating issuer")
+	issuer, err := x509.ParseCertificate(issuerDER)
+	test.AssertNotError(t, err, "parsing issuer")
+
+	leafTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	if precert {
+		leafTmpl.ExtraExtensions = []pkix.Extension{{Id: poisonOID, Critical: true, Value: []byte{0x05, 0x00}}}
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTmpl, issuer, key.Public(), key)
+	test.AssertNotError(t, err, "creating leaf")
+
+	path := filepath.Join(t.TempDir(), "chain.pem")
+	var pemBytes []byte
+	for _, der := range [][]byte{leafDER, issuerDER} {
+		pemBytes = append(pemBytes, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	test.AssertNotError(t, os.WriteFile(path, pemBytes, 0600), "writing chain")
+	return path
+}
+
+func TestCTConf_MakeProber(t *testing.T) {
+	colls := CTConf{}.Instrument()
+	badColl := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "obs_ct_foo",
+			Help: "Hmmm, this shouldn't be here...",
+		},
+		[]string{},
+	))
+	precertChain := writeChain(t, true)
+	certChain := writeChain(t, false)
+	tests := []struct {
+		name       string
+		conf       CTConf
+		colls      map[string]prometheus.Collector
+		wantMaxAge time.Duration
+		wantSubmit bool
+		wantErr    bool
+	}{
+		// valid
+		{"default maxAge", CTConf{LogListFile: logListFile, LogName: "A1 Current"}, colls, 24 * time.Hour, false, false},
+		{"maxAge", CTConf{LogListFile: logListFile, LogName: "A1 Current", MaxAge: config.Duration{Duration: time.Minute}}, colls, time.Minute, false, false},
+		{"submit", CTConf{LogListFile: logListFile, LogName: "B1", SubmitChainFile: precertChain}, colls, 24 * time.Hour, true, false},
+		// invalid
+		{"missing logListFile", CTConf{LogName: "A1 Current"}, colls, 0, false, true},
+		{"bad logListFile", CTConf{LogListFile: "/does/not/exist", LogName: "A1 Current"}, colls, 0, false, true},
+		{"missing logName", CTConf{LogListFile: logListFile}, colls, 0, false, true},
+		{"unknown logName", CTConf{LogListFile: logListFile, LogName: "Z9"}, colls, 0, false, true},
+		{"negative maxAge", CTConf{LogListFile: logListFile, LogName: "A1 Current", MaxAge: config.Duration{Duration: -time.Minute}}, colls, 0, false, true},
+		{"missing submitChainFile", CTConf{LogListFile: logListFile, LogName: "A1 Current", SubmitChainFile: "/does/not/exist"}, colls, 0, false, true},
+		{"submitChainFile without precert", CTConf{LogListFile: logListFile, LogName: "A1 Current", SubmitChainFile: certChain}, colls, 0, false, true},
+		{"unexpected collector", CTConf{LogListFile: logListFile, LogName: "A1 Current"}, map[string]prometheus.Collector{"obs_ct_foo": badColl}, 0, false, true},
+		{"missing collectors", CTConf{LogListFile: logListFile, LogName: "A1 Current"}, map[string]prometheus.Collector{}, 0, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := tt.conf.MakeProber(tt.colls)
+			if tt.wantErr {
+				test.AssertError(t, err, "CTConf.MakeProber()")
+			} else {
+				test.AssertNotError(t, err, "CTConf.MakeProber()")
+
+				test.AssertNotNil(t, p, "CTConf.MakeProber(): nil prober")
+				prober := p.(CTProbe)
+				test.AssertEquals(t, prober.Name(), tt.conf.LogName)
+				test.AssertEquals(t, prober.maxAge, tt.wantMaxAge)
+				test.AssertEquals(t, prober.submitter != nil, tt.wantSubmit)
+				test.AssertNotNil(t, prober.client, "CTConf.MakeProber(): nil client")
+				test.AssertNotNil(t, prober.last, "CTConf.MakeProber(): nil last")
+				test.AssertNotNil(t, prober.treeSize, "CTConf.MakeProber(): nil treeSize")
+				test.AssertNotNil(t, prober.sthAge, "CTConf.MakeProber(): nil sthAge")
+				test.AssertNotNil(t, prober.consistencyFailure, "CTConf.MakeProber(): nil consistencyFailure")
+			}
+		})
+	}
+}
+
+func TestCTConf_UnmarshalSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  probers.Settings
+		want    probers.Configurer
+		wantErr bool
+	}{
+		{
+			"valid",
+			probers.Settings{"logListFile": logListFile, "logName": "A1 Current", "maxAge": "1h", "userAgent": "test"},
+			CTConf{LogListFile: logListFile, LogName: "A1 Current", MaxAge: config.Duration{Duration: time.Hour}, UserAgent: "test"},
+			false,
+		},
+		{"invalid (map)", probers.Settings{"logName": make(map[string]any)}, nil, true},
+		{"invalid (duration)", probers.Settings{"maxAge": "forever"}, nil, true},
+		{"invalid (unknown field)", probers.Settings{"log": "A1 Current"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settingsBytes, _ := yaml.Marshal(tt.fields)
+			c := CTConf{}
+			got, err := c.UnmarshalSettings(settingsBytes)
+			if tt.wantErr {
+				test.AssertError(t, err, "CTConf.UnmarshalSettings()")
+			} else {
+				test.AssertNotError(t, err, "CTConf.UnmarshalSettings()")
+			}
+			test.AssertDeepEquals(t, got, tt.want)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package probers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/ctmonitor"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeLogClient serves a configurable tree head, and considers tree heads
+// consistent unless told otherwise.
+type fakeLogClient struct {
+	head         *ctmonitor.TreeHead
+	headErr      error
+	checkedPairs [][2]uint64
+	inconsistent bool
+}
+
+func (c *fakeLogClient) TreeHead(context.Context) (*ctmonitor.TreeHead, error) {
+	return c.head, c.headErr
+}
+
+func (c *fakeLogClient) CheckInclusion(context.Context, *ctmonitor.TreeHead, ctmonitor.Entry) error {
+	return errors.New("not implemented")
+}
+
+func (c *fakeLogClient) CheckConsistency(_ context.Context, older, newer *ctmonitor.TreeHead) error {
+	c.checkedPairs = append(c.checkedPairs, [2]uint64{older.Size, newer.Size})
+	if c.inconsistent {
+		return ctmonitor.ErrInconsistent
+	}
+	return nil
+}
+
+func TestCTProbe(t *testing.T) {
+	clk := clock.NewFake()
+	clk.Set(time.Now())
+	client := &fakeLogClient{}
+	colls := CTConf{}.Instrument()
+	p := CTProbe{
+		logName:            "A1 Current",
+		client:             client,
+		maxAge:             time.Hour,
+		clk:                clk,
+		last:               &lastHead{},
+		treeSize:           colls[treeSizeName].(*prometheus.GaugeVec),
+		sthAge:             colls[sthAgeName].(*prometheus.GaugeVec),
+		consistencyFailure: colls[consistencyFailureName].(*prometheus.CounterVec),
+	}
+	ctx := context.Background()
+	labels := prometheus.Labels{"log": "A1 Current"}
+
+	// The first tree head has nothing to be checked against.
+	client.head = &ctmonitor.TreeHead{Size: 10, Timestamp: clk.Now().Add(-time.Minute)}
+	test.AssertNotError(t, p.Probe(ctx), "probing fresh tree head")
+	test.AssertEquals(t, len(client.checkedPairs), 0)
+	test.AssertMetricWithLabelsEquals(t, p.treeSize, labels, 10)
+	test.AssertMetricWithLabelsEquals(t, p.sthAge, labels, 60)
+
+	// A larger tree head is checked against it, and replaces it.
+	client.head = &ctmonitor.TreeHead{Size: 20, Timestamp: clk.Now()}
+	test.AssertNotError(t, p.Probe(ctx), "probing larger tree head")
+	test.AssertDeepEquals(t, client.checkedPairs, [][2]uint64{{10, 20}})
+
+	// A smaller tree head, as served by a lagging frontend, is checked against
+	// the larger one, which is kept.
+	client.head = &ctmonitor.TreeHead{Size: 15, Timestamp: clk.Now()}
+	test.AssertNotError(t, p.Probe(ctx), "probing smaller tree head")
+	test.AssertDeepEquals(t, client.checkedPairs[1:], [][2]uint64{{15, 20}})
+	test.AssertEquals(t, p.last.head.Size, uint64(20))
+
+	// Stale tree heads, and those from the future, are errors.
+	client.head = &ctmonitor.TreeHead{Size: 20, Timestamp: clk.Now().Add(-2 * time.Hour)}
+	test.AssertError(t, p.Probe(ctx), "probing stale tree head")
+	test.AssertMetricWithLabelsEquals(t, p.sthAge, labels, 7200)
+	client.head = &ctmonitor.TreeHead{Size: 20, Timestamp: clk.Now().Add(time.Hour)}
+	test.AssertError(t, p.Probe(ctx), "probing tree head from the future")
+
+	// Errors fetching the tree head are reported.
+	client.headErr = errors.New("oops")
+	test.AssertError(t, p.Probe(ctx), "probing with failing log")
+	client.headErr = nil
+
+	// Inconsistent tree heads are counted, and not kept.
+	client.inconsistent = true
+	client.head = &ctmonitor.TreeHead{Size: 30, Timestamp: clk.Now()}
+	err := p.Probe(ctx)
+	test.AssertErrorIs(t, err, ctmonitor.ErrInconsistent)
+	test.AssertMetricWithLabelsEquals(t, p.consistencyFailure, labels, 1)
+	test.AssertEquals(t, p.last.head.Size, uint64(20))
+}
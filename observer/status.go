@@ -0,0 +1,140 @@
+package observer
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultHistorySize is the number of results kept for each monitor when
+// 'historysize' isn't configured.
+const defaultHistorySize = 10
+
+// result is the outcome of a single probe attempt.
+type result struct {
+	Time           time.Time `json:"time"`
+	LatencySeconds float64   `json:"latencySeconds"`
+	Success        bool      `json:"success"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// history holds the most recent results of a monitor's probes.
+type history struct {
+	sync.Mutex
+	results []result
+	// next is the index in results at which the next result will be stored,
+	// once results has reached its capacity.
+	next int
+}
+
+func newHistory(size int) *history {
+	return &history{results: make([]result, 0, size)}
+}
+
+// add stores r, replacing the oldest result if the history is full.
+func (h *history) add(r result) {
+	h.Lock()
+	defer h.Unlock()
+	if len(h.results) < cap(h.results) {
+		h.results = append(h.results, r)
+		return
+	}
+	h.results[h.next] = r
+	h.next = (h.next + 1) % len(h.results)
+}
+
+// list returns the stored results, most recent first.
+func (h *history) list() []result {
+	h.Lock()
+	defer h.Unlock()
+	res := make([]result, 0, len(h.results))
+	for i := range h.results {
+		// Walk backwards from the most recently stored result.
+		j := (h.next - 1 - i + 2*len(h.results)) % len(h.results)
+		res = append(res, h.results[j])
+	}
+	return res
+}
+
+// The health of a monitor, as determined by its most recent result.
+const (
+	statusHealthy   = "healthy"
+	statusUnhealthy = "unhealthy"
+	statusUnknown   = "unknown"
+)
+
+// monitorStatus describes the health of a single monitor.
+type monitorStatus struct {
+	Name          string   `json:"name"`
+	Kind          string   `json:"kind"`
+	PeriodSeconds float64  `json:"periodSeconds"`
+	Status        string   `json:"status"`
+	Last          *result  `json:"last,omitempty"`
+	History       []result `json:"history,omitempty"`
+}
+
+// summary describes the health of every monitor. Healthy is false if any
+// monitor's most recent probe failed.
+type summary struct {
+	Healthy  bool            `json:"healthy"`
+	Monitors []monitorStatus `json:"monitors"`
+}
+
+// statusHandler serves the health and recent results of each monitor as JSON.
+// GET /status returns a summary of each monitor's most recent result, and
+// GET /history additionally returns every stored result. Both accept a 'name'
+// query parameter to return only the monitor with that name.
+type statusHandler struct {
+	monitors []*monitor
+}
+
+func (h statusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var withHistory bool
+	switch r.URL.Path {
+	case "/status":
+	case "/history":
+		withHistory = true
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	s := summary{Healthy: true, Monitors: []monitorStatus{}}
+	for _, m := range h.monitors {
+		if name != "" && m.prober.Name() != name {
+			continue
+		}
+		ms := monitorStatus{
+			Name:          m.prober.Name(),
+			Kind:          m.prober.Kind(),
+			PeriodSeconds: m.period.Seconds(),
+			Status:        statusUnknown,
+		}
+		results := m.history.list()
+		if len(results) > 0 {
+			ms.Last = &results[0]
+			ms.Status = statusHealthy
+			if !results[0].Success {
+				ms.Status = statusUnhealthy
+				s.Healthy = false
+			}
+		}
+		if withHistory {
+			ms.History = results
+		}
+		s.Monitors = append(s.Monitors, ms)
+	}
+	if name != "" && len(s.Monitors) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(s)
+}
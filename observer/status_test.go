@@ -0,0 +1,99 @@
+package observer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/observer/probers/mock"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+	h := newHistory(3)
+	test.AssertEquals(t, len(h.list()), 0)
+
+	for i := range 5 {
+		h.add(result{Error: strconv.Itoa(i)})
+		var want []result
+		for j := i; j >= 0 && j > i-3; j-- {
+			want = append(want, result{Error: strconv.Itoa(j)})
+		}
+		test.AssertDeepEquals(t, h.list(), want)
+	}
+}
+
+func TestStatusHandler(t *testing.T) {
+	t.Parallel()
+	now := time.Now().UTC()
+	newMonitor := func(name string, results ...result) *monitor {
+		prober, err := probers.MockConfigurer{Valid: true, PName: name, PKind: "Mock"}.MakeProber(nil)
+		test.AssertNotError(t, err, "making mock prober")
+		m := &monitor{time.Minute, prober, newHistory(10)}
+		for _, r := range results {
+			m.history.add(r)
+		}
+		return m
+	}
+	ok := result{Time: now, LatencySeconds: 0.5, Success: true}
+	failed := result{Time: now.Add(time.Minute), LatencySeconds: 1, Error: "oops"}
+	h := statusHandler{[]*monitor{
+		newMonitor("healthy", failed, ok),
+		newMonitor("unhealthy", ok, failed),
+		newMonitor("unknown"),
+	}}
+
+	get := func(target string) (int, summary) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		var s summary
+		if rec.Code == http.StatusOK {
+			test.AssertEquals(t, rec.Header().Get("Content-Type"), "application/json")
+			test.AssertNotError(t, json.Unmarshal(rec.Body.Bytes(), &s), "decoding response")
+		}
+		return rec.Code, s
+	}
+
+	code, s := get("/status")
+	test.AssertEquals(t, code, http.StatusOK)
+	test.Assert(t, !s.Healthy, "summary with an unhealthy monitor should be unhealthy")
+	test.AssertEquals(t, len(s.Monitors), 3)
+	for i, want := range []struct {
+		status string
+		last   *result
+	}{
+		{statusHealthy, &ok},
+		{statusUnhealthy, &failed},
+		{statusUnknown, nil},
+	} {
+		test.AssertEquals(t, s.Monitors[i].Status, want.status)
+		test.AssertEquals(t, s.Monitors[i].PeriodSeconds, 60.0)
+		test.AssertDeepEquals(t, s.Monitors[i].Last, want.last)
+		test.AssertEquals(t, len(s.Monitors[i].History), 0)
+	}
+
+	code, s = get("/status?name=healthy")
+	test.AssertEquals(t, code, http.StatusOK)
+	test.Assert(t, s.Healthy, "summary of a healthy monitor should be healthy")
+	test.AssertEquals(t, len(s.Monitors), 1)
+	test.AssertEquals(t, s.Monitors[0].Name, "healthy")
+
+	code, s = get("/history?name=unhealthy")
+	test.AssertEquals(t, code, http.StatusOK)
+	test.AssertEquals(t, len(s.Monitors), 1)
+	test.AssertDeepEquals(t, s.Monitors[0].History, []result{failed, ok})
+
+	code, _ = get("/status?name=missing")
+	test.AssertEquals(t, code, http.StatusNotFound)
+	code, _ = get("/metrics")
+	test.AssertEquals(t, code, http.StatusNotFound)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/status", nil))
+	test.AssertEquals(t, rec.Code, http.StatusMethodNotAllowed)
+}
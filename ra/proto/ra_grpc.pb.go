@@ -34,6 +34,8 @@ const (
 	RegistrationAuthority_FinalizeOrder_FullMethodName                     = "/ra.RegistrationAuthority/FinalizeOrder"
 	RegistrationAuthority_UnpauseAccount_FullMethodName                    = "/ra.RegistrationAuthority/UnpauseAccount"
 	RegistrationAuthority_AddRateLimitOverride_FullMethodName              = "/ra.RegistrationAuthority/AddRateLimitOverride"
+	RegistrationAuthority_DisableExpiredRateLimitOverrides_FullMethodName  = "/ra.RegistrationAuthority/DisableExpiredRateLimitOverrides"
+	RegistrationAuthority_CheckOrder_FullMethodName                        = "/ra.RegistrationAuthority/CheckOrder"
 )
 
 // RegistrationAuthorityClient is the client API for RegistrationAuthority service.
@@ -53,6 +55,8 @@ type RegistrationAuthorityClient interface {
 	FinalizeOrder(ctx context.Context, in *FinalizeOrderRequest, opts ...grpc.CallOption) (*proto.Order, error)
 	UnpauseAccount(ctx context.Context, in *UnpauseAccountRequest, opts ...grpc.CallOption) (*UnpauseAccountResponse, error)
 	AddRateLimitOverride(ctx context.Context, in *AddRateLimitOverrideRequest, opts ...grpc.CallOption) (*AddRateLimitOverrideResponse, error)
+	DisableExpiredRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DisableExpiredRateLimitOverridesResponse, error)
+	CheckOrder(ctx context.Context, in *CheckOrderRequest, opts ...grpc.CallOption) (*CheckOrderResponse, error)
 }
 
 type registrationAuthorityClient struct {
@@ -193,6 +197,26 @@ func (c *registrationAuthorityClient) AddRateLimitOverride(ctx context.Context,
 	return out, nil
 }
 
+func (c *registrationAuthorityClient) DisableExpiredRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DisableExpiredRateLimitOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableExpiredRateLimitOverridesResponse)
+	err := c.cc.Invoke(ctx, RegistrationAuthority_DisableExpiredRateLimitOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationAuthorityClient) CheckOrder(ctx context.Context, in *CheckOrderRequest, opts ...grpc.CallOption) (*CheckOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckOrderResponse)
+	err := c.cc.Invoke(ctx, RegistrationAuthority_CheckOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RegistrationAuthorityServer is the server API for RegistrationAuthority service.
 // All implementations must embed UnimplementedRegistrationAuthorityServer
 // for forward compatibility.
@@ -210,6 +234,8 @@ type RegistrationAuthorityServer interface {
 	FinalizeOrder(context.Context, *FinalizeOrderRequest) (*proto.Order, error)
 	UnpauseAccount(context.Context, *UnpauseAccountRequest) (*UnpauseAccountResponse, error)
 	AddRateLimitOverride(context.Context, *AddRateLimitOverrideRequest) (*AddRateLimitOverrideResponse, error)
+	DisableExpiredRateLimitOverrides(context.Context, *emptypb.Empty) (*DisableExpiredRateLimitOverridesResponse, error)
+	CheckOrder(context.Context, *CheckOrderRequest) (*CheckOrderResponse, error)
 	mustEmbedUnimplementedRegistrationAuthorityServer()
 }
 
@@ -259,6 +285,12 @@ func (UnimplementedRegistrationAuthorityServer) UnpauseAccount(context.Context,
 func (UnimplementedRegistrationAuthorityServer) AddRateLimitOverride(context.Context, *AddRateLimitOverrideRequest) (*AddRateLimitOverrideResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddRateLimitOverride not implemented")
 }
+func (UnimplementedRegistrationAuthorityServer) DisableExpiredRateLimitOverrides(context.Context, *emptypb.Empty) (*DisableExpiredRateLimitOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExpiredRateLimitOverrides not implemented")
+}
+func (UnimplementedRegistrationAuthorityServer) CheckOrder(context.Context, *CheckOrderRequest) (*CheckOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOrder not implemented")
+}
 func (UnimplementedRegistrationAuthorityServer) mustEmbedUnimplementedRegistrationAuthorityServer() {}
 func (UnimplementedRegistrationAuthorityServer) testEmbeddedByValue()                               {}
 
@@ -514,6 +546,42 @@ func _RegistrationAuthority_AddRateLimitOverride_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RegistrationAuthority_DisableExpiredRateLimitOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).DisableExpiredRateLimitOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationAuthority_DisableExpiredRateLimitOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).DisableExpiredRateLimitOverrides(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationAuthority_CheckOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).CheckOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationAuthority_CheckOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).CheckOrder(ctx, req.(*CheckOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RegistrationAuthority_ServiceDesc is the grpc.ServiceDesc for RegistrationAuthority service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -573,6 +641,14 @@ var RegistrationAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddRateLimitOverride",
 			Handler:    _RegistrationAuthority_AddRateLimitOverride_Handler,
 		},
+		{
+			MethodName: "DisableExpiredRateLimitOverrides",
+			Handler:    _RegistrationAuthority_DisableExpiredRateLimitOverrides_Handler,
+		},
+		{
+			MethodName: "CheckOrder",
+			Handler:    _RegistrationAuthority_CheckOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ra.proto",
@@ -30,6 +30,8 @@ import (
 	"github.com/letsencrypt/boulder/config"
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/crl"
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
 	csrlib "github.com/letsencrypt/boulder/csr"
 	"github.com/letsencrypt/boulder/ctpolicy"
 	berrors "github.com/letsencrypt/boulder/errors"
@@ -41,6 +43,7 @@ import (
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
 	mtcapb "github.com/letsencrypt/boulder/mtca/proto"
+	"github.com/letsencrypt/boulder/policy"
 	"github.com/letsencrypt/boulder/probs"
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
@@ -71,11 +74,14 @@ var (
 type RegistrationAuthorityImpl struct {
 	rapb.UnsafeRegistrationAuthorityServer
 	rapb.UnsafeSCTProviderServer
-	CA            capb.CertificateAuthorityClient
-	VA            va.RemoteClients
-	SA            sapb.StorageAuthorityClient
-	PA            core.PolicyAuthority
-	publisher     pubpb.PublisherClient
+	CA        capb.CertificateAuthorityClient
+	VA        va.RemoteClients
+	SA        sapb.StorageAuthorityClient
+	PA        core.PolicyAuthority
+	publisher pubpb.PublisherClient
+	// CRLUpdater is optional. If set, the RA asks it to regenerate the
+	// affected CRL shard after every revocation.
+	CRLUpdater    cupb.CRLUpdaterClient
 	profileToMTCA map[string]mtcapb.MTCAClient
 
 	clk               clock.Clock
@@ -88,6 +94,14 @@ type RegistrationAuthorityImpl struct {
 	finalizeTimeout   time.Duration
 	drainWG           sync.WaitGroup
 
+	// crlRegenPending holds the CRL shards waiting to be regenerated, and
+	// crlRegenFlush is closed by Drain to send them without further delay.
+	// See regenerateCRLShard.
+	crlRegenMu        sync.Mutex
+	crlRegenPending   map[crlShard]struct{}
+	crlRegenFlush     chan struct{}
+	crlRegenFlushOnce sync.Once
+
 	issuersByNameID map[issuance.NameID]*issuance.Certificate
 
 	ctpolicy *ctpolicy.CTPolicy
@@ -233,6 +247,8 @@ func NewRegistrationAuthorityImpl(
 		inflightFinalizes:       inflightFinalizes,
 		certCSRMismatch:         certCSRMismatch,
 		pauseCounter:            pauseCounter,
+		crlRegenPending:         make(map[crlShard]struct{}),
+		crlRegenFlush:           make(chan struct{}),
 	}
 	return ra
 }
@@ -272,6 +288,21 @@ type ValidationProfileConfig struct {
 	// IdentifierTypes is a list of identifier types that may be issued under
 	// this profile.
 	IdentifierTypes []identifier.IdentifierType `validate:"required,dive,oneof=dns ip"`
+	// ChallengeTypes is a list of challenge types which may be offered and
+	// used to validate identifiers under this profile. This further restricts
+	// the challenge types which the PA considers suitable for each
+	// identifier; it cannot enable a challenge type which the PA has
+	// disabled. If empty, all challenge types are permitted.
+	ChallengeTypes []core.AcmeChallenge `validate:"omitempty,dive,oneof=http-01 dns-01 tls-alpn-01 dns-account-01 dns-persist-01"`
+	// AuthzReuse optionally restricts which valid authorizations may be
+	// reused by new orders under this profile, based on the challenge type
+	// which solved them. Each entry gives how long after validation an
+	// authorization solved by that challenge type may be reused, and must be
+	// no longer than ValidAuthzLifetime. Authorizations solved by a challenge
+	// type with no entry are never reused. If empty, any valid authorization
+	// may be reused until it expires. Authorizations solved by dns-persist-01
+	// are never reused, so it may not be given an entry.
+	AuthzReuse map[core.AcmeChallenge]config.Duration `validate:"omitempty,dive,keys,oneof=http-01 dns-01 tls-alpn-01 dns-account-01,endkeys"`
 }
 
 // validationProfile holds the attributes of a given validation profile.
@@ -299,6 +330,14 @@ type validationProfile struct {
 	// identifierTypes is a list of identifier types that may be issued under
 	// this profile.
 	identifierTypes []identifier.IdentifierType
+	// challengeTypes is a list of challenge types which may be used to
+	// validate identifiers under this profile. If empty, all challenge types
+	// are permitted.
+	challengeTypes []core.AcmeChallenge
+	// authzReuse maps challenge types to how long after validation an
+	// authorization solved by that challenge type may be reused. If empty,
+	// any valid authorization may be reused until it expires.
+	authzReuse map[core.AcmeChallenge]time.Duration
 	// MTC indicates that orders with this profile should be sent to an
 	// MTCA instance for issuance.
 	mtc bool
@@ -350,6 +389,30 @@ func NewValidationProfiles(defaultName string, configs map[string]*ValidationPro
 			return nil, fmt.Errorf("MaxNames must be greater than 0 and at most 100")
 		}
 
+		for _, challType := range config.ChallengeTypes {
+			if !challType.IsValid() {
+				return nil, fmt.Errorf("unrecognized challenge type %q in profile %q", challType, name)
+			}
+		}
+
+		var authzReuse map[core.AcmeChallenge]time.Duration
+		for challType, maxAge := range config.AuthzReuse {
+			if !challType.IsValid() {
+				return nil, fmt.Errorf("unrecognized challenge type %q in AuthzReuse of profile %q", challType, name)
+			}
+			if challType == core.ChallengeTypeDNSPersist01 {
+				return nil, fmt.Errorf("AuthzReuse of profile %q may not include %q, which is never reused", name, challType)
+			}
+			if maxAge.Duration <= 0 || maxAge.Duration > config.ValidAuthzLifetime.Duration {
+				return nil, fmt.Errorf("AuthzReuse value for %q in profile %q must be greater than 0 and at most ValidAuthzLifetime, but got %q",
+					challType, name, maxAge.Duration)
+			}
+			if authzReuse == nil {
+				authzReuse = make(map[core.AcmeChallenge]time.Duration, len(config.AuthzReuse))
+			}
+			authzReuse[challType] = maxAge.Duration
+		}
+
 		var allowList *allowlist.List[int64]
 		if config.AllowList != "" {
 			data, err := os.ReadFile(config.AllowList)
@@ -369,6 +432,8 @@ func NewValidationProfiles(defaultName string, configs map[string]*ValidationPro
 			maxNames:             config.MaxNames,
 			allowList:            allowList,
 			identifierTypes:      config.IdentifierTypes,
+			challengeTypes:       config.ChallengeTypes,
+			authzReuse:           authzReuse,
 			mtc:                  config.MTC,
 		}
 	}
@@ -395,6 +460,34 @@ func (vp *validationProfiles) get(name string) (*validationProfile, error) {
 	return profile, nil
 }
 
+// challengeTypeAllowed returns true if the given challenge type may be used to
+// validate identifiers under this profile.
+func (p *validationProfile) challengeTypeAllowed(challType core.AcmeChallenge) bool {
+	return len(p.challengeTypes) == 0 || slices.Contains(p.challengeTypes, challType)
+}
+
+// authzReuseDeadline returns the time after which this profile no longer
+// permits the given valid authorization to be reused by new orders. It returns
+// the zero time if the profile places no restriction on reuse beyond the
+// authorization's own expiry, and false if the profile does not permit the
+// authorization to be reused at all.
+func (p *validationProfile) authzReuseDeadline(authz *core.Authorization) (time.Time, bool) {
+	if len(p.authzReuse) == 0 {
+		return time.Time{}, true
+	}
+	for _, chall := range authz.Challenges {
+		if chall.Status != core.StatusValid || chall.Validated == nil {
+			continue
+		}
+		maxAge, ok := p.authzReuse[chall.Type]
+		if !ok {
+			return time.Time{}, false
+		}
+		return chall.Validated.Add(maxAge), true
+	}
+	return time.Time{}, false
+}
+
 // certificateRequestAuthz is a struct for logging information about when and
 // how an identifier was validated. We include the challenge type that solved
 // the authorization and when the challenge was completed to make some common
@@ -404,6 +497,47 @@ type identifierLog struct {
 	Authz     int64
 	Challenge core.AcmeChallenge
 	Validated time.Time
+	// Reused is true if the authorization was validated before the order was
+	// created, i.e. it was reused from an earlier order.
+	Reused bool `json:",omitempty"`
+	// ReuseDeadline is the time after which the profile's reuse policy no
+	// longer permits this authorization to be reused, which also capped the
+	// expiry of the order reusing it.
+	ReuseDeadline *time.Time `json:",omitempty"`
+}
+
+// Reasons for which a profile's reuse policy rejects an existing
+// authorization, logged in authzReuseEvent.
+const (
+	reuseRejectedChallengeType = "challenge type not permitted by profile"
+	reuseRejectedPolicy        = "reuse not permitted by profile"
+	reuseRejectedDeadline      = "profile's reuse period ends too soon"
+)
+
+// rejectedAuthzLog describes an existing authorization which a profile's reuse
+// policy prevented a new order from reusing.
+type rejectedAuthzLog struct {
+	Ident     identifier.ACMEIdentifier
+	Authz     int64
+	Challenge core.AcmeChallenge
+	Reason    string
+}
+
+// authzReuseEvent is logged as JSON to the audit log when a profile's reuse
+// policy affects a new order, by rejecting existing authorizations or by
+// capping the order's expiry.
+type authzReuseEvent struct {
+	Requester int64
+	OrderID   int64
+	Profile   string `json:",omitempty"`
+	// Rejected are the authorizations which the order did not reuse, and for
+	// which new pending authorizations were created instead.
+	Rejected []rejectedAuthzLog `json:",omitempty"`
+	// ReuseDeadline is the earliest reuse deadline of the order's reused
+	// authorizations, if it was earlier than the order's lifetime allowed.
+	ReuseDeadline *time.Time `json:",omitempty"`
+	// Expires is the order's expiry, after any cap from ReuseDeadline.
+	Expires time.Time
 }
 
 // certificateRequestEvent is a struct for holding information that is logged as
@@ -594,6 +728,7 @@ func (ra *RegistrationAuthorityImpl) checkOrderAuthorizations(
 	ctx context.Context,
 	orderID orderID,
 	acctID accountID,
+	profile *validationProfile,
 	idents identifier.ACMEIdentifiers,
 	now time.Time) (map[identifier.ACMEIdentifier]*core.Authorization, error) {
 	// Get all of the valid authorizations for this account/order
@@ -664,7 +799,7 @@ func (ra *RegistrationAuthorityImpl) checkOrderAuthorizations(
 	if !features.Get().CAARechecksFailOrder {
 		// Check that the authzs either don't need CAA rechecking, or do the
 		// necessary CAA rechecks right now.
-		err = ra.checkAuthorizationsCAA(ctx, int64(acctID), authzs, now)
+		err = ra.checkAuthorizationsCAA(ctx, int64(acctID), profile, authzs, now)
 		if err != nil {
 			return nil, err
 		}
@@ -688,11 +823,13 @@ func validatedBefore(authz *core.Authorization, caaRecheckTime time.Time) (bool,
 
 // checkAuthorizationsCAA ensures that we have sufficiently-recent CAA checks
 // for every input identifier/authz. If any authz was validated too long ago, it
-// kicks off a CAA recheck for that identifier If it returns an error, it will
-// be of type BoulderError.
+// kicks off a CAA recheck for that identifier. The profile's authorization
+// reuse policy may shorten how long ago that is for some validation methods. If
+// it returns an error, it will be of type BoulderError.
 func (ra *RegistrationAuthorityImpl) checkAuthorizationsCAA(
 	ctx context.Context,
 	acctID int64,
+	profile *validationProfile,
 	authzs map[identifier.ACMEIdentifier]*core.Authorization,
 	now time.Time) error {
 	if len(authzs) == 0 {
@@ -711,7 +848,19 @@ func (ra *RegistrationAuthorityImpl) checkAuthorizationsCAA(
 	caaRecheckAfter := now.Add(caaRecheckDuration)
 
 	for _, authz := range authzs {
-		if staleCAA, err := validatedBefore(authz, caaRecheckAfter); err != nil {
+		// If the profile permits authorizations solved by this validation
+		// method to be reused for less time than that, don't reuse the CAA
+		// check for any longer either.
+		authzCAARecheckAfter := caaRecheckAfter
+		solvedBy, err := authz.SolvedBy()
+		if err == nil {
+			maxAge, ok := profile.authzReuse[solvedBy]
+			if ok && now.Add(-maxAge).After(authzCAARecheckAfter) {
+				authzCAARecheckAfter = now.Add(-maxAge)
+			}
+		}
+
+		if staleCAA, err := validatedBefore(authz, authzCAARecheckAfter); err != nil {
 			return err
 		} else if staleCAA {
 			switch authz.Identifier.Type {
@@ -1101,7 +1250,7 @@ func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 	// Double-check that all authorizations on this order are valid, are also
 	// associated with the same account as the order itself, and have recent CAA.
 	authzs, err := ra.checkOrderAuthorizations(
-		ctx, orderID(req.Order.Id), accountID(req.Order.RegistrationID), csrIdents, ra.clk.Now())
+		ctx, orderID(req.Order.Id), accountID(req.Order.RegistrationID), profile, csrIdents, ra.clk.Now())
 	if err != nil {
 		// Pass through the error without wrapping it because the called functions
 		// return BoulderError and we don't want to lose the type.
@@ -1120,12 +1269,20 @@ func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 				break
 			}
 		}
-		logIdents = append(logIdents, identifierLog{
+		logIdent := identifierLog{
 			Ident:     ident,
 			Authz:     authz.ID,
 			Challenge: solvedChall.Type,
 			Validated: *solvedChall.Validated,
-		})
+			Reused:    req.Order.Created != nil && solvedChall.Validated.Before(req.Order.Created.AsTime()),
+		}
+		if logIdent.Reused {
+			reuseDeadline, _ := profile.authzReuseDeadline(authz)
+			if !reuseDeadline.IsZero() {
+				logIdent.ReuseDeadline = &reuseDeadline
+			}
+		}
+		logIdents = append(logIdents, logIdent)
 		authzAge := (profile.validAuthzLifetime - authz.Expires.Sub(ra.clk.Now())).Seconds()
 		ra.authzAges.WithLabelValues("FinalizeOrder", string(authz.Status)).Observe(authzAge)
 	}
@@ -1286,9 +1443,14 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	}
 
 	if features.Get().CAARechecksFailOrder {
+		profile, err := ra.profiles.get(profileName)
+		if err != nil {
+			return nil, err
+		}
+
 		// Check that the authzs either don't need CAA rechecking, or do the
 		// necessary CAA rechecks right now.
-		err := ra.checkAuthorizationsCAA(ctx, int64(acctID), authzs, ra.clk.Now())
+		err = ra.checkAuthorizationsCAA(ctx, int64(acctID), profile, authzs, ra.clk.Now())
 		if err != nil {
 			return nil, err
 		}
@@ -1500,6 +1662,12 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 		return nil, berrors.MalformedError("challenge type %q no longer allowed", ch.Type)
 	}
 
+	// The profile's permitted challenge types may have been restricted since
+	// the challenge was created.
+	if !profile.challengeTypeAllowed(ch.Type) {
+		return nil, berrors.MalformedError("challenge type %q not allowed by profile", ch.Type)
+	}
+
 	// We expect some clients to try and update a challenge for an authorization
 	// that is already valid. In this case we don't need to process the
 	// challenge update. It wouldn't be helpful, the overall authorization is
@@ -1621,7 +1789,7 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 func (ra *RegistrationAuthorityImpl) revokeCertificate(ctx context.Context, cert *x509.Certificate, reason revocation.Reason) error {
 	serialString := core.SerialToString(cert.SerialNumber)
 	issuerID := issuance.IssuerNameID(cert)
-	shardIdx, err := crlShard(cert)
+	shardIdx, err := crl.Shard(cert)
 	if err != nil {
 		return err
 	}
@@ -1638,6 +1806,7 @@ func (ra *RegistrationAuthorityImpl) revokeCertificate(ctx context.Context, cert
 	}
 
 	ra.revocationReasonCounter.WithLabelValues(reason.String()).Inc()
+	ra.regenerateCRLShard(issuerID, shardIdx)
 	return nil
 }
 
@@ -1669,7 +1838,7 @@ func (ra *RegistrationAuthorityImpl) updateRevocationForKeyCompromise(ctx contex
 		return err
 	}
 
-	shardIdx, err := crlShard(x509Cert)
+	shardIdx, err := crl.Shard(x509Cert)
 	if err != nil {
 		return err
 	}
@@ -1687,9 +1856,88 @@ func (ra *RegistrationAuthorityImpl) updateRevocationForKeyCompromise(ctx contex
 	}
 
 	ra.revocationReasonCounter.WithLabelValues(revocation.KeyCompromise.String()).Inc()
+	ra.regenerateCRLShard(issuerID, shardIdx)
 	return nil
 }
 
+const (
+	// crlRegenDebounce is how long the RA collects CRL shards to regenerate
+	// before asking the crl-updater for all of them at once.
+	crlRegenDebounce = 5 * time.Second
+	// crlRegenMaxPending bounds the number of distinct shards waiting to be
+	// regenerated. Shards beyond this are left to their next scheduled update.
+	crlRegenMaxPending = 1000
+	// crlRegenTimeout bounds each RegenerateShards call. The crl-updater
+	// finishes the shards it has already started when a call times out, but
+	// starts no more of them.
+	crlRegenTimeout = time.Minute
+)
+
+// crlShard identifies a single CRL shard.
+type crlShard struct {
+	issuerID issuance.NameID
+	shardIdx int64
+}
+
+// regenerateCRLShard asks the crl-updater, if one is configured, to publish a
+// new version of the given CRL shard, so that a revocation becomes visible
+// without waiting for the shard's next scheduled update. Requests are coalesced:
+// shards requested within crlRegenDebounce of each other are deduplicated and
+// sent in a single background call. Failures are only logged: the crl-updater
+// refuses to regenerate a shard which is already being updated, and in any case
+// the next scheduled update will include the revocation.
+func (ra *RegistrationAuthorityImpl) regenerateCRLShard(issuerID issuance.NameID, shardIdx int64) {
+	if ra.CRLUpdater == nil {
+		return
+	}
+
+	ra.crlRegenMu.Lock()
+	defer ra.crlRegenMu.Unlock()
+
+	key := crlShard{issuerID: issuerID, shardIdx: shardIdx}
+	_, ok := ra.crlRegenPending[key]
+	if ok {
+		return
+	}
+	if len(ra.crlRegenPending) >= crlRegenMaxPending {
+		ra.log.Warningf("not regenerating CRL shard %d for issuer %d: %d shards already pending", shardIdx, issuerID, len(ra.crlRegenPending))
+		return
+	}
+	ra.crlRegenPending[key] = struct{}{}
+	if len(ra.crlRegenPending) > 1 {
+		// A background call is already scheduled and will include this shard.
+		return
+	}
+
+	wait := ra.clk.After(crlRegenDebounce)
+	ra.drainWG.Go(func() {
+		select {
+		case <-wait:
+		case <-ra.crlRegenFlush:
+		}
+		ra.sendPendingCRLShards()
+	})
+}
+
+// sendPendingCRLShards asks the crl-updater to regenerate every pending CRL
+// shard, and clears the pending set.
+func (ra *RegistrationAuthorityImpl) sendPendingCRLShards() {
+	ra.crlRegenMu.Lock()
+	shards := make([]*cupb.Shard, 0, len(ra.crlRegenPending))
+	for key := range ra.crlRegenPending {
+		shards = append(shards, &cupb.Shard{IssuerNameID: int64(key.issuerID), ShardIdx: key.shardIdx})
+	}
+	clear(ra.crlRegenPending)
+	ra.crlRegenMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), crlRegenTimeout)
+	defer cancel()
+	_, err := ra.CRLUpdater.RegenerateShards(ctx, &cupb.RegenerateShardsRequest{Shards: shards})
+	if err != nil {
+		ra.log.Warningf("regenerating %d CRL shards: %s", len(shards), err)
+	}
+}
+
 // revokeAuthorizations must be called as a background goroutine as it uses a
 // custom context timeout and is not cancelled by its parent. It sends off an
 // asynchronous request to the SA to revoke authorizations for all Identifiers
@@ -1838,44 +2086,6 @@ func (ra *RegistrationAuthorityImpl) RevokeCertByApplicant(ctx context.Context,
 	return &emptypb.Empty{}, nil
 }
 
-// crlShard extracts the CRL shard from a certificate's CRLDistributionPoint.
-//
-// If there is no CRLDistributionPoint, returns 0.
-//
-// If there is more than one CRLDistributionPoint, returns an error.
-//
-// Assumes the shard number is represented in the URL as an integer that
-// occurs in the last path component, optionally followed by ".crl".
-//
-// Note: This assumes (a) the CA is generating well-formed, correct
-// CRLDistributionPoints and (b) an earlier component has verified the signature
-// on this certificate comes from one of our issuers.
-func crlShard(cert *x509.Certificate) (int64, error) {
-	if len(cert.CRLDistributionPoints) == 0 {
-		return 0, errors.New("no crlDistributionPoints in certificate")
-	}
-	if len(cert.CRLDistributionPoints) > 1 {
-		return 0, errors.New("too many crlDistributionPoints in certificate")
-	}
-
-	url := strings.TrimSuffix(cert.CRLDistributionPoints[0], ".crl")
-	lastIndex := strings.LastIndex(url, "/")
-	if lastIndex == -1 {
-		return 0, fmt.Errorf("malformed CRLDistributionPoint %q", url)
-	}
-	shardStr := url[lastIndex+1:]
-	shardIdx, err := strconv.Atoi(shardStr)
-	if err != nil {
-		return 0, fmt.Errorf("parsing CRLDistributionPoint: %s", err)
-	}
-
-	if shardIdx <= 0 {
-		return 0, fmt.Errorf("invalid shard in CRLDistributionPoint: %d", shardIdx)
-	}
-
-	return int64(shardIdx), nil
-}
-
 // addToBlockedKeys initiates a GRPC call to have the Base64-encoded SHA256
 // digest of a provided public key added to the blockedKeys table.
 func (ra *RegistrationAuthorityImpl) addToBlockedKeys(ctx context.Context, key crypto.PublicKey, src string, comment string) error {
@@ -2031,7 +2241,7 @@ func (ra *RegistrationAuthorityImpl) AdministrativelyRevokeCertificate(ctx conte
 			return nil, err
 		}
 		issuerID = issuance.IssuerNameID(cert)
-		shard, err = crlShard(cert)
+		shard, err = crl.Shard(cert)
 		if err != nil {
 			return nil, err
 		}
@@ -2051,7 +2261,7 @@ func (ra *RegistrationAuthorityImpl) AdministrativelyRevokeCertificate(ctx conte
 			return nil, err
 		}
 		issuerID = issuance.IssuerNameID(cert)
-		shard, err = crlShard(cert)
+		shard, err = crl.Shard(cert)
 		if err != nil {
 			return nil, err
 		}
@@ -2103,6 +2313,7 @@ func (ra *RegistrationAuthorityImpl) AdministrativelyRevokeCertificate(ctx conte
 		return nil, err
 	}
 
+	ra.regenerateCRLShard(issuerID, shard)
 	return &emptypb.Empty{}, nil
 }
 
@@ -2169,12 +2380,13 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 			"Order cannot contain more than %d identifiers", profile.maxNames)
 	}
 
+	profileDisplayName := "Default profile"
+	if req.CertificateProfileName != "" {
+		profileDisplayName = fmt.Sprintf("Profile %q", req.CertificateProfileName)
+	}
+
 	for _, ident := range idents {
 		if !slices.Contains(profile.identifierTypes, ident.Type) {
-			name := "Default profile"
-			if req.CertificateProfileName != "" {
-				name = fmt.Sprintf("Profile %q", req.CertificateProfileName)
-			}
 			identType := "unknown"
 			switch ident.Type {
 			case identifier.TypeIP:
@@ -2183,7 +2395,7 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 				identType = "DNS"
 			}
 			return nil, berrors.RejectedIdentifierError("%s does not permit %s identifiers. "+
-				"See available profiles at https://letsencrypt.org/docs/profiles/.", name, identType)
+				"See available profiles at https://letsencrypt.org/docs/profiles/.", profileDisplayName, identType)
 		}
 	}
 
@@ -2258,6 +2470,18 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 		return nil, err
 	}
 
+	// Start with the order's own expiry as the minExpiry. We only care
+	// about authz expiries and reuse deadlines that are sooner than the
+	// order's expiry.
+	minExpiry := ra.clk.Now().Add(profile.orderLifetime)
+
+	// Record the effects of the profile's reuse policy, to be logged once the
+	// order has been created.
+	reuseEvent := authzReuseEvent{
+		Requester: req.RegistrationID,
+		Profile:   req.CertificateProfileName,
+	}
+
 	// For each of the identifiers in the order, if there is an acceptable
 	// existing authz, append it to the order to reuse it. Otherwise track that
 	// there is a missing authz for that identifier.
@@ -2323,6 +2547,39 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 			continue
 		}
 
+		// Don't reuse authorizations which were validated using a challenge
+		// type that this profile does not permit, which the profile's reuse
+		// policy forbids reusing, or which it permits reusing only until
+		// shortly before the cutoff used above for authorization expiry.
+		reuseDeadline, reusable := profile.authzReuseDeadline(authz)
+		var rejectReason string
+		switch {
+		case !profile.challengeTypeAllowed(solvedBy):
+			rejectReason = reuseRejectedChallengeType
+		case !reusable:
+			rejectReason = reuseRejectedPolicy
+		case !reuseDeadline.IsZero() && reuseDeadline.Before(authzExpiryCutoff):
+			rejectReason = reuseRejectedDeadline
+		}
+		if rejectReason != "" {
+			reuseEvent.Rejected = append(reuseEvent.Rejected, rejectedAuthzLog{
+				Ident:     ident,
+				Authz:     authz.ID,
+				Challenge: solvedBy,
+				Reason:    rejectReason,
+			})
+			missingAuthzIdents = append(missingAuthzIdents, ident)
+			// Delete the authz from the identToExistingAuthz map since we are not reusing it.
+			delete(identToExistingAuthz, ident)
+			continue
+		}
+		// The order must not outlive the period for which the profile permits
+		// this authorization to be reused.
+		if !reuseDeadline.IsZero() && reuseDeadline.Before(minExpiry) {
+			minExpiry = reuseDeadline
+			reuseEvent.ReuseDeadline = &reuseDeadline
+		}
+
 		// If we reached this point then the existing authz was acceptable for
 		// reuse.
 		newOrderAuthzs = append(newOrderAuthzs, authz.ID)
@@ -2340,8 +2597,15 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 
 		var challStrs []string
 		for _, t := range challTypes {
+			if !profile.challengeTypeAllowed(t) {
+				continue
+			}
 			challStrs = append(challStrs, string(t))
 		}
+		if len(challStrs) == 0 {
+			return nil, berrors.RejectedIdentifierError(
+				"%s does not permit any challenge types suitable for identifier %q", profileDisplayName, ident.Value)
+		}
 
 		newAuthzs = append(newAuthzs, &sapb.NewAuthzRequest{
 			Identifier:     ident.ToProto(),
@@ -2354,10 +2618,6 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 		ra.authzAges.WithLabelValues("NewOrder", string(core.StatusPending)).Observe(0)
 	}
 
-	// Start with the order's own expiry as the minExpiry. We only care
-	// about authz expiries that are sooner than the order's expiry
-	minExpiry := ra.clk.Now().Add(profile.orderLifetime)
-
 	// Check the reused authorizations to see if any have an expiry before the
 	// minExpiry (the order's lifetime)
 	for _, authz := range identToExistingAuthz {
@@ -2407,6 +2667,12 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	}
 	ra.orderAges.WithLabelValues("NewOrder").Observe(0)
 
+	if len(reuseEvent.Rejected) > 0 || reuseEvent.ReuseDeadline != nil {
+		reuseEvent.OrderID = storedOrder.Id
+		reuseEvent.Expires = storedOrder.Expires.AsTime()
+		ra.log.AuditInfo("Authorization reuse", reuseEvent)
+	}
+
 	// Note how many identifiers are being requested in this certificate order.
 	ra.namesPerCert.With(prometheus.Labels{"type": "requested"}).Observe(float64(len(storedOrder.Identifiers)))
 
@@ -2436,6 +2702,212 @@ func wildcardOverlap(idents identifier.ACMEIdentifiers) error {
 	return nil
 }
 
+// CheckOrder evaluates a hypothetical new-order request for the given account
+// and reports every reason that the order would be refused, either at NewOrder
+// or at issuance time, without creating any objects or spending against any
+// rate limits. Problems which apply to a single identifier are reported against
+// that identifier; the rest apply to the order as a whole. An error is returned
+// only if the request itself is malformed or the report could not be produced.
+func (ra *RegistrationAuthorityImpl) CheckOrder(ctx context.Context, req *rapb.CheckOrderRequest) (*rapb.CheckOrderResponse, error) {
+	if req == nil || core.IsAnyNilOrZero(req.RegistrationID, req.Identifiers) {
+		return nil, errIncompleteGRPCRequest
+	}
+
+	idents := identifier.Normalize(identifier.FromProtoSlice(req.Identifiers))
+	err := policy.WellFormedIdentifiers(idents)
+	if err != nil {
+		return nil, err
+	}
+
+	caaMethod := core.AcmeChallenge(req.CaaValidationMethod)
+	if caaMethod != "" && !caaMethod.IsValid() {
+		return nil, berrors.MalformedError("unrecognized validation method %q", caaMethod)
+	}
+
+	resp := &rapb.CheckOrderResponse{}
+	addOrderProblem := func(prob *probs.ProblemDetails) {
+		for _, existing := range resp.Problems {
+			if existing.Detail == prob.Detail {
+				return
+			}
+		}
+		resp.Problems = append(resp.Problems, bgrpc.ProblemDetailsToPB(prob))
+	}
+	reports := make(map[identifier.ACMEIdentifier]*rapb.IdentifierCheck, len(idents))
+	for _, ident := range idents {
+		report := &rapb.IdentifierCheck{Identifier: ident.ToProto()}
+		reports[ident] = report
+		resp.Identifiers = append(resp.Identifiers, report)
+	}
+	addIdentProblem := func(ident identifier.ACMEIdentifier, prob *probs.ProblemDetails) {
+		reports[ident].Problems = append(reports[ident].Problems, bgrpc.ProblemDetailsToPB(prob))
+	}
+
+	profile, err := ra.profiles.get(req.CertificateProfileName)
+	if err != nil {
+		// Without a profile, none of the profile-specific checks below can be
+		// performed, but the remaining checks are still meaningful.
+		addOrderProblem(web.ProblemDetailsForError(err, "Error creating new order"))
+	} else {
+		if profile.allowList != nil && !profile.allowList.Contains(req.RegistrationID) {
+			addOrderProblem(probs.Unauthorized(fmt.Sprintf(
+				"account ID %d is not permitted to use certificate profile %q",
+				req.RegistrationID, req.CertificateProfileName)))
+		}
+		if len(idents) > profile.maxNames {
+			addOrderProblem(probs.Malformed(fmt.Sprintf(
+				"Order cannot contain more than %d identifiers", profile.maxNames)))
+		}
+	}
+
+	err = wildcardOverlap(idents)
+	if err != nil {
+		addOrderProblem(web.ProblemDetailsForError(err, "Error creating new order"))
+	}
+
+	paused, err := ra.SA.CheckIdentifiersPaused(ctx, &sapb.PauseRequest{
+		RegistrationID: req.RegistrationID,
+		Identifiers:    idents.ToProtoSlice(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	pausedIdents := identifier.FromProtoSlice(paused.Identifiers)
+
+	// caaIdents are the DNS identifiers which would otherwise be issuable, and
+	// so are worth the cost of a CAA lookup.
+	var caaIdents identifier.ACMEIdentifiers
+	for _, ident := range idents {
+		identOK := true
+
+		if slices.Contains(pausedIdents, ident) {
+			addIdentProblem(ident, probs.Paused(fmt.Sprintf(
+				"Your account is temporarily prevented from requesting certificates for %s", ident.Value)))
+			identOK = false
+		}
+
+		if profile != nil && !slices.Contains(profile.identifierTypes, ident.Type) {
+			addIdentProblem(ident, probs.RejectedIdentifier(fmt.Sprintf(
+				"Profile does not permit %s identifiers", ident.Type)))
+			identOK = false
+		}
+
+		err := ra.PA.WillingToIssue(identifier.ACMEIdentifiers{ident})
+		if err != nil {
+			addIdentProblem(ident, web.ProblemDetailsForError(err, "Error creating new order"))
+			// The PA can't offer challenges for an identifier it's unwilling
+			// to issue for.
+			continue
+		}
+
+		if profile != nil {
+			challTypes, err := ra.PA.ChallengeTypesFor(ident)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.ContainsFunc(challTypes, profile.challengeTypeAllowed) {
+				addIdentProblem(ident, probs.RejectedIdentifier(
+					"Profile does not permit any challenge types suitable for this identifier"))
+				identOK = false
+			}
+		}
+
+		if identOK && caaMethod != "" && ident.Type == identifier.TypeDNS {
+			if profile != nil && !profile.challengeTypeAllowed(caaMethod) {
+				// The identifier could never be validated using this method,
+				// so there's no point in checking CAA for it.
+				addIdentProblem(ident, probs.RejectedIdentifier(fmt.Sprintf(
+					"Profile does not permit validation method %q", caaMethod)))
+				continue
+			}
+			caaIdents = append(caaIdents, ident)
+		}
+	}
+
+	if !req.IsARIRenewal {
+		ra.checkOrderLimits(ctx, req.RegistrationID, idents, req.IsRenewal, addOrderProblem)
+	}
+
+	for ident, prob := range ra.checkOrderCAA(ctx, req.RegistrationID, caaIdents, caaMethod) {
+		addIdentProblem(ident, prob)
+	}
+
+	return resp, nil
+}
+
+// checkOrderLimits checks, without spending, each of the rate limits which
+// would be checked at NewOrder time for an order with the given identifiers,
+// and calls addProblem for each limit which would be exceeded. As with
+// NewOrder, failures of the rate limit system itself are logged but not
+// reported.
+func (ra *RegistrationAuthorityImpl) checkOrderLimits(ctx context.Context, regID int64, idents identifier.ACMEIdentifiers, isRenewal bool, addProblem func(*probs.ProblemDetails)) {
+	txns, err := ra.txnBuilder.NewOrderLimitTransactions(regID, idents, isRenewal)
+	if err != nil {
+		ra.log.Warningf("building rate limit transactions for order check: %s", err)
+	}
+
+	for _, txn := range txns {
+		d, err := ra.limiter.Check(ctx, txn)
+		if err != nil {
+			ra.log.Warningf("checking rate limits for order check: %s", err)
+			continue
+		}
+		err = d.Result(ra.clk.Now())
+		if err != nil {
+			addProblem(probs.RateLimited(err.Error()))
+		}
+	}
+}
+
+// checkOrderCAA checks CAA for each of the given identifiers, as though each
+// had been validated by the given account using the given method. It returns a
+// problem for each identifier whose CAA records would forbid issuance, or which
+// could not be checked.
+func (ra *RegistrationAuthorityImpl) checkOrderCAA(ctx context.Context, regID int64, idents identifier.ACMEIdentifiers, method core.AcmeChallenge) map[identifier.ACMEIdentifier]*probs.ProblemDetails {
+	type caaResult struct {
+		ident identifier.ACMEIdentifier
+		prob  *probs.ProblemDetails
+	}
+	ch := make(chan caaResult, len(idents))
+	for _, ident := range idents {
+		go func() {
+			resp, err := ra.VA.DoCAA(ctx, &vapb.IsCAAValidRequest{
+				Identifier:       ident.ToProto(),
+				ValidationMethod: string(method),
+				AccountURIID:     regID,
+				Preflight:        true,
+			})
+			if err != nil {
+				ra.log.AuditErr("Checking CAA for order check", err, map[string]any{
+					"requester":  regID,
+					"identifier": ident.Value,
+					"method":     method,
+				})
+				ch <- caaResult{ident, probs.ServerInternal("Internal error checking CAA")}
+				return
+			}
+			if resp.Problem != nil {
+				prob, err := bgrpc.PBToProblemDetails(resp.Problem)
+				if err != nil {
+					prob = probs.ServerInternal("Internal error checking CAA")
+				}
+				ch <- caaResult{ident, prob}
+				return
+			}
+			ch <- caaResult{ident, nil}
+		}()
+	}
+
+	probsByIdent := make(map[identifier.ACMEIdentifier]*probs.ProblemDetails)
+	for range len(idents) {
+		result := <-ch
+		if result.prob != nil {
+			probsByIdent[result.ident] = result.prob
+		}
+	}
+	return probsByIdent
+}
+
 // UnpauseAccount receives a validated account unpause request from the SFE and
 // instructs the SA to unpause that account. If the account cannot be unpaused,
 // an error is returned.
@@ -2464,11 +2936,21 @@ func (ra *RegistrationAuthorityImpl) GetAuthorization(ctx context.Context, req *
 		return nil, fmt.Errorf("getting authz from SA: %w", err)
 	}
 
-	// Filter out any challenges which are currently disabled, so that the client
-	// doesn't attempt them.
+	// An authorization may outlive the profile it was created under, if that
+	// profile has since been renamed or removed. Such authorizations must
+	// remain fetchable, so their challenges are filtered only by the PA.
+	profile, err := ra.profiles.get(authz.CertificateProfileName)
+	if err != nil {
+		profile = nil
+	}
+
+	// Filter out any challenges which are currently disabled, or which are not
+	// permitted by the authorization's profile, so that the client doesn't
+	// attempt them.
 	challs := []*corepb.Challenge{}
 	for _, chall := range authz.Challenges {
-		if ra.PA.ChallengeTypeEnabled(core.AcmeChallenge(chall.Type)) {
+		challType := core.AcmeChallenge(chall.Type)
+		if ra.PA.ChallengeTypeEnabled(challType) && (profile == nil || profile.challengeTypeAllowed(challType)) {
 			challs = append(challs, chall)
 		}
 	}
@@ -2491,7 +2973,9 @@ func (ra *RegistrationAuthorityImpl) AddRateLimitOverride(ctx context.Context, r
 			Period:    req.Override.Period,
 			Count:     req.Override.Count,
 			Burst:     req.Override.Burst,
+			ExpiresAt: req.Override.ExpiresAt,
 		},
+		ClearExpiry: req.ClearExpiry,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("adding rate limit override: %w", err)
@@ -2499,14 +2983,7 @@ func (ra *RegistrationAuthorityImpl) AddRateLimitOverride(ctx context.Context, r
 
 	var existing *rapb.RateLimitOverride
 	if resp.Existing != nil {
-		existing = &rapb.RateLimitOverride{
-			LimitEnum: resp.Existing.LimitEnum,
-			BucketKey: resp.Existing.BucketKey,
-			Comment:   resp.Existing.Comment,
-			Period:    resp.Existing.Period,
-			Count:     resp.Existing.Count,
-			Burst:     resp.Existing.Burst,
-		}
+		existing = overrideSAToRA(resp.Existing)
 	}
 
 	return &rapb.AddRateLimitOverrideResponse{
@@ -2516,6 +2993,51 @@ func (ra *RegistrationAuthorityImpl) AddRateLimitOverride(ctx context.Context, r
 	}, nil
 }
 
+// overrideSAToRA converts a rate limit override from its SA representation to
+// its RA representation.
+func overrideSAToRA(o *sapb.RateLimitOverride) *rapb.RateLimitOverride {
+	return &rapb.RateLimitOverride{
+		LimitEnum: o.LimitEnum,
+		BucketKey: o.BucketKey,
+		Comment:   o.Comment,
+		Period:    o.Period,
+		Count:     o.Count,
+		Burst:     o.Burst,
+		ExpiresAt: o.ExpiresAt,
+	}
+}
+
+// expiredOverrideEvent is the audit log event emitted for each rate limit
+// override disabled by DisableExpiredRateLimitOverrides.
+type expiredOverrideEvent struct {
+	Limit     string
+	BucketKey string
+	Comment   string
+	ExpiresAt time.Time
+}
+
+// DisableExpiredRateLimitOverrides disables all rate limit overrides whose
+// expiry time has passed, audit logging each one, and returns the overrides
+// which were disabled.
+func (ra *RegistrationAuthorityImpl) DisableExpiredRateLimitOverrides(ctx context.Context, _ *emptypb.Empty) (*rapb.DisableExpiredRateLimitOverridesResponse, error) {
+	resp, err := ra.SA.DisableExpiredRateLimitOverrides(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("disabling expired rate limit overrides: %w", err)
+	}
+
+	disabled := make([]*rapb.RateLimitOverride, 0, len(resp.Disabled))
+	for _, o := range resp.Disabled {
+		ra.log.AuditInfo("Disabled expired rate limit override", expiredOverrideEvent{
+			Limit:     ratelimits.Name(o.LimitEnum).String(),
+			BucketKey: o.BucketKey,
+			Comment:   o.Comment,
+			ExpiresAt: o.ExpiresAt.AsTime(),
+		})
+		disabled = append(disabled, overrideSAToRA(o))
+	}
+	return &rapb.DisableExpiredRateLimitOverridesResponse{Disabled: disabled}, nil
+}
+
 // Drain blocks until all detached goroutines are done.
 //
 // The RA runs detached goroutines for challenge validation and finalization,
@@ -2523,6 +3045,10 @@ func (ra *RegistrationAuthorityImpl) AddRateLimitOverride(ctx context.Context, r
 //
 // The main goroutine should call this before exiting to avoid canceling the work
 // being done in detached goroutines.
+//
+// Pending CRL shard regenerations are sent immediately rather than after their
+// usual delay.
 func (ra *RegistrationAuthorityImpl) Drain() {
+	ra.crlRegenFlushOnce.Do(func() { close(ra.crlRegenFlush) })
 	ra.drainWG.Wait()
 }
@@ -20,6 +20,7 @@ import (
 	mrand "math/rand/v2"
 	"net/netip"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -40,6 +41,8 @@ import (
 	"github.com/letsencrypt/boulder/config"
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/crl"
+	cupb "github.com/letsencrypt/boulder/crl/updater/proto"
 	"github.com/letsencrypt/boulder/ctpolicy"
 	"github.com/letsencrypt/boulder/ctpolicy/loglist"
 	berrors "github.com/letsencrypt/boulder/errors"
@@ -53,6 +56,7 @@ import (
 	"github.com/letsencrypt/boulder/mocks"
 	mtcapb "github.com/letsencrypt/boulder/mtca/proto"
 	"github.com/letsencrypt/boulder/policy"
+	"github.com/letsencrypt/boulder/probs"
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/ratelimits"
@@ -356,7 +360,7 @@ func initAuthorities(t *testing.T) (*DummyValidationAuthority, sapb.StorageAutho
 	}, nil, nil, 0, log, metrics.NoopRegisterer)
 
 	rlSource := ratelimits.NewInmemSource()
-	limiter, err := ratelimits.NewLimiter(fc, rlSource, stats)
+	limiter, err := ratelimits.NewLimiter(fc, rlSource, stats, log)
 	test.AssertNotError(t, err, "making limiter")
 	txnBuilder, err := ratelimits.NewTransactionBuilderFromFiles("../test/config-next/ratelimit-defaults.yml", "", metrics.NoopRegisterer, log)
 	test.AssertNotError(t, err, "making transaction composer")
@@ -707,7 +711,7 @@ func TestPerformValidation_FailedThenSuccessfulValidationResetsPauseIdentifiersR
 	limiter, err := ratelimits.NewLimiter(fc, mockRLSourceWithSyncDelete{
 		Source: rl,
 		out:    keyChan,
-	}, metrics.NoopRegisterer)
+	}, metrics.NoopRegisterer, blog.NewMock())
 	test.AssertNotError(t, err, "creating mock limiter")
 	ra.limiter = limiter
 
@@ -1120,22 +1124,22 @@ func TestRecheckCAADates(t *testing.T) {
 
 	// NOTE: The names provided here correspond to authorizations in the
 	// `mockSAWithRecentAndOlder`
-	err := ra.checkAuthorizationsCAA(context.Background(), registration.Id, authzs, fc.Now())
+	err := ra.checkAuthorizationsCAA(context.Background(), registration.Id, ra.profiles.def(), authzs, fc.Now())
 	// We expect that there is no error rechecking authorizations for these names
 	if err != nil {
 		t.Errorf("expected nil err, got %s", err)
 	}
 
 	// Should error if a authorization has `!= 1` challenge
-	err = ra.checkAuthorizationsCAA(context.Background(), registration.Id, twoChallenges, fc.Now())
+	err = ra.checkAuthorizationsCAA(context.Background(), registration.Id, ra.profiles.def(), twoChallenges, fc.Now())
 	test.AssertEquals(t, err.Error(), "authorization has incorrect number of challenges. 1 expected, 2 found for: id 13372")
 
 	// Should error if a authorization has `!= 1` challenge
-	err = ra.checkAuthorizationsCAA(context.Background(), registration.Id, noChallenges, fc.Now())
+	err = ra.checkAuthorizationsCAA(context.Background(), registration.Id, ra.profiles.def(), noChallenges, fc.Now())
 	test.AssertEquals(t, err.Error(), "authorization has incorrect number of challenges. 1 expected, 0 found for: id 13370")
 
 	// Should error if authorization's challenge has no validated timestamp
-	err = ra.checkAuthorizationsCAA(context.Background(), registration.Id, noValidationTime, fc.Now())
+	err = ra.checkAuthorizationsCAA(context.Background(), registration.Id, ra.profiles.def(), noValidationTime, fc.Now())
 	test.AssertEquals(t, err.Error(), "authorization's challenge has no validated timestamp for: id 13371")
 
 	// We expect that "recent.com" is not checked because its mock authorization
@@ -1296,6 +1300,58 @@ func TestRecheckCAAFail(t *testing.T) {
 	test.AssertEquals(t, len(berr.SubErrors), 0)
 }
 
+func TestRecheckCAAProfileAuthzReuse(t *testing.T) {
+	_, _, ra, _, fc, registration, cleanUp := initAuthorities(t)
+	defer cleanUp()
+	recorder := &caaRecorder{names: make(map[string]bool)}
+	ra.VA = va.RemoteClients{CAAClient: recorder}
+	ra.profiles.def().authzReuse = map[core.AcmeChallenge]time.Duration{
+		core.ChallengeTypeHTTP01: 2 * time.Hour,
+		core.ChallengeTypeDNS01:  30 * 24 * time.Hour,
+	}
+
+	validated := fc.Now().Add(-3 * time.Hour)
+	expires := fc.Now().Add(5 * time.Hour)
+	authzs := map[identifier.ACMEIdentifier]*core.Authorization{
+		identifier.NewDNS("http.com"): {
+			Identifier: identifier.NewDNS("http.com"),
+			Expires:    &expires,
+			Challenges: []core.Challenge{
+				{
+					Status:    core.StatusValid,
+					Type:      core.ChallengeTypeHTTP01,
+					Token:     "exampleToken",
+					Validated: &validated,
+				},
+			},
+		},
+		identifier.NewDNS("dns.com"): {
+			Identifier: identifier.NewDNS("dns.com"),
+			Expires:    &expires,
+			Challenges: []core.Challenge{
+				{
+					Status:    core.StatusValid,
+					Type:      core.ChallengeTypeDNS01,
+					Token:     "exampleToken",
+					Validated: &validated,
+				},
+			},
+		},
+	}
+
+	err := ra.checkAuthorizationsCAA(context.Background(), registration.Id, ra.profiles.def(), authzs, fc.Now())
+	test.AssertNotError(t, err, "checking CAA")
+
+	// The http-01 authorization is older than the profile permits reusing
+	// http-01 validations for, so its CAA must be rechecked. The dns-01
+	// authorization is within both that profile's limit and the usual CAA
+	// recheck duration, so it must not be.
+	_, present := recorder.names["http.com"]
+	test.Assert(t, present, "Failed to recheck CAA for http.com")
+	_, present = recorder.names["dns.com"]
+	test.Assert(t, !present, "Rechecked CAA unnecessarily for dns.com")
+}
+
 func TestRecheckCAAInternalServerError(t *testing.T) {
 	_, _, ra, _, _, _, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -1331,7 +1387,7 @@ func TestRecheckSkipIPAddress(t *testing.T) {
 			},
 		},
 	}
-	err := ra.checkAuthorizationsCAA(context.Background(), registration.Id, authzs, fc.Now())
+	err := ra.checkAuthorizationsCAA(context.Background(), registration.Id, ra.profiles.def(), authzs, fc.Now())
 	test.AssertNotError(t, err, "rechecking CAA for IP address, should have skipped")
 }
 
@@ -1358,7 +1414,7 @@ func TestRecheckInvalidIdentifierType(t *testing.T) {
 			},
 		},
 	}
-	err := ra.checkAuthorizationsCAA(context.Background(), registration.Id, authzs, fc.Now())
+	err := ra.checkAuthorizationsCAA(context.Background(), registration.Id, ra.profiles.def(), authzs, fc.Now())
 	test.AssertError(t, err, "expected err, got nil")
 	test.AssertErrorIs(t, err, berrors.Malformed)
 	test.AssertContains(t, err.Error(), "invalid identifier type")
@@ -1827,6 +1883,332 @@ func TestNewOrder_ProfileSelectionAllowList(t *testing.T) {
 	}
 }
 
+func TestNewValidationProfiles_ChallengeTypes(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(challTypes ...core.AcmeChallenge) map[string]*ValidationProfileConfig {
+		return map[string]*ValidationProfileConfig{
+			"test": {
+				PendingAuthzLifetime: config.Duration{Duration: 7 * 24 * time.Hour},
+				ValidAuthzLifetime:   config.Duration{Duration: 30 * 24 * time.Hour},
+				OrderLifetime:        config.Duration{Duration: 7 * 24 * time.Hour},
+				MaxNames:             100,
+				IdentifierTypes:      []identifier.IdentifierType{identifier.TypeDNS},
+				ChallengeTypes:       challTypes,
+			},
+		}
+	}
+
+	profiles, err := NewValidationProfiles("test", newConfig())
+	test.AssertNotError(t, err, "making validation profiles without challenge types")
+	test.Assert(t, profiles.def().challengeTypeAllowed(core.ChallengeTypeHTTP01), "unrestricted profile should allow http-01")
+	test.Assert(t, profiles.def().challengeTypeAllowed(core.ChallengeTypeDNSPersist01), "unrestricted profile should allow dns-persist-01")
+
+	profiles, err = NewValidationProfiles("test", newConfig(core.ChallengeTypeDNSPersist01))
+	test.AssertNotError(t, err, "making validation profiles with challenge types")
+	test.Assert(t, !profiles.def().challengeTypeAllowed(core.ChallengeTypeHTTP01), "restricted profile should not allow http-01")
+	test.Assert(t, profiles.def().challengeTypeAllowed(core.ChallengeTypeDNSPersist01), "restricted profile should allow dns-persist-01")
+
+	_, err = NewValidationProfiles("test", newConfig("http-02"))
+	test.AssertError(t, err, "making validation profiles with unknown challenge type")
+	test.AssertContains(t, err.Error(), "unrecognized challenge type \"http-02\"")
+}
+
+func TestNewValidationProfiles_AuthzReuse(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(reuse map[core.AcmeChallenge]config.Duration) map[string]*ValidationProfileConfig {
+		return map[string]*ValidationProfileConfig{
+			"test": {
+				PendingAuthzLifetime: config.Duration{Duration: 7 * 24 * time.Hour},
+				ValidAuthzLifetime:   config.Duration{Duration: 30 * 24 * time.Hour},
+				OrderLifetime:        config.Duration{Duration: 7 * 24 * time.Hour},
+				MaxNames:             100,
+				IdentifierTypes:      []identifier.IdentifierType{identifier.TypeDNS},
+				AuthzReuse:           reuse,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		reuse     map[core.AcmeChallenge]config.Duration
+		expectErr string
+	}{
+		{
+			name:  "No reuse policy",
+			reuse: nil,
+		},
+		{
+			name: "Valid reuse policy",
+			reuse: map[core.AcmeChallenge]config.Duration{
+				core.ChallengeTypeDNS01:  {Duration: 30 * 24 * time.Hour},
+				core.ChallengeTypeHTTP01: {Duration: 7 * 24 * time.Hour},
+			},
+		},
+		{
+			name:      "Unknown challenge type",
+			reuse:     map[core.AcmeChallenge]config.Duration{"http-02": {Duration: time.Hour}},
+			expectErr: "unrecognized challenge type \"http-02\"",
+		},
+		{
+			name:      "dns-persist-01",
+			reuse:     map[core.AcmeChallenge]config.Duration{core.ChallengeTypeDNSPersist01: {Duration: time.Hour}},
+			expectErr: "which is never reused",
+		},
+		{
+			name:      "Zero duration",
+			reuse:     map[core.AcmeChallenge]config.Duration{core.ChallengeTypeDNS01: {Duration: 0}},
+			expectErr: "must be greater than 0 and at most ValidAuthzLifetime",
+		},
+		{
+			name:      "Longer than ValidAuthzLifetime",
+			reuse:     map[core.AcmeChallenge]config.Duration{core.ChallengeTypeDNS01: {Duration: 31 * 24 * time.Hour}},
+			expectErr: "must be greater than 0 and at most ValidAuthzLifetime",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewValidationProfiles("test", newConfig(tc.reuse))
+			if tc.expectErr != "" {
+				test.AssertError(t, err, "making validation profiles")
+				test.AssertContains(t, err.Error(), tc.expectErr)
+			} else {
+				test.AssertNotError(t, err, "making validation profiles")
+			}
+		})
+	}
+}
+
+func TestAuthzReuseDeadline(t *testing.T) {
+	t.Parallel()
+
+	validated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	makeAuthz := func(challType core.AcmeChallenge) *core.Authorization {
+		return &core.Authorization{
+			Status: core.StatusValid,
+			Challenges: []core.Challenge{
+				{Type: challType, Status: core.StatusValid, Validated: &validated},
+			},
+		}
+	}
+
+	unrestricted := &validationProfile{}
+	deadline, reusable := unrestricted.authzReuseDeadline(makeAuthz(core.ChallengeTypeHTTP01))
+	test.Assert(t, reusable, "unrestricted profile should permit reuse")
+	test.Assert(t, deadline.IsZero(), "unrestricted profile should not impose a reuse deadline")
+
+	restricted := &validationProfile{
+		authzReuse: map[core.AcmeChallenge]time.Duration{
+			core.ChallengeTypeDNS01:  30 * 24 * time.Hour,
+			core.ChallengeTypeHTTP01: 7 * 24 * time.Hour,
+		},
+	}
+	deadline, reusable = restricted.authzReuseDeadline(makeAuthz(core.ChallengeTypeDNS01))
+	test.Assert(t, reusable, "restricted profile should permit reusing dns-01")
+	test.AssertEquals(t, deadline, validated.Add(30*24*time.Hour))
+
+	deadline, reusable = restricted.authzReuseDeadline(makeAuthz(core.ChallengeTypeHTTP01))
+	test.Assert(t, reusable, "restricted profile should permit reusing http-01")
+	test.AssertEquals(t, deadline, validated.Add(7*24*time.Hour))
+
+	_, reusable = restricted.authzReuseDeadline(makeAuthz(core.ChallengeTypeTLSALPN01))
+	test.Assert(t, !reusable, "restricted profile should not permit reusing tls-alpn-01")
+
+	noValidated := makeAuthz(core.ChallengeTypeDNS01)
+	noValidated.Challenges[0].Validated = nil
+	_, reusable = restricted.authzReuseDeadline(noValidated)
+	test.Assert(t, !reusable, "restricted profile should not permit reusing an authz without a validated time")
+}
+
+func TestNewOrder_ProfileAuthzReuse(t *testing.T) {
+	_, sa, ra, _, fc, registration, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	ra.profiles.def().authzReuse = map[core.AcmeChallenge]time.Duration{
+		core.ChallengeTypeDNS01:  20 * 24 * time.Hour,
+		core.ChallengeTypeHTTP01: 7 * 24 * time.Hour,
+	}
+
+	authzExpires := fc.Now().Add(20 * 24 * time.Hour)
+	tenDaysAgo := fc.Now().Add(-10 * 24 * time.Hour)
+	twoDaysAgo := fc.Now().Add(-2 * 24 * time.Hour)
+
+	// A dns-01 authorization validated ten days ago is within the profile's
+	// reuse policy.
+	dnsIdent := identifier.NewDNS(randomDomain())
+	dnsAuthzID := createFinalizedAuthorization(t, sa, registration.Id, dnsIdent, authzExpires, core.ChallengeTypeDNS01, tenDaysAgo)
+
+	// An http-01 authorization validated ten days ago is not.
+	oldHTTPIdent := identifier.NewDNS(randomDomain())
+	oldHTTPAuthzID := createFinalizedAuthorization(t, sa, registration.Id, oldHTTPIdent, authzExpires, core.ChallengeTypeHTTP01, tenDaysAgo)
+
+	// An http-01 authorization validated two days ago is, but only for another
+	// five days.
+	newHTTPIdent := identifier.NewDNS(randomDomain())
+	newHTTPAuthzID := createFinalizedAuthorization(t, sa, registration.Id, newHTTPIdent, authzExpires, core.ChallengeTypeHTTP01, twoDaysAgo)
+
+	// A tls-alpn-01 authorization is never reused under this profile.
+	tlsALPNIdent := identifier.NewDNS(randomDomain())
+	tlsALPNAuthzID := createFinalizedAuthorization(t, sa, registration.Id, tlsALPNIdent, authzExpires, core.ChallengeTypeTLSALPN01, fc.Now())
+
+	mockLog := ra.log.(*blog.Mock)
+	mockLog.Clear()
+	order, err := ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID: registration.Id,
+		Identifiers: []*corepb.Identifier{
+			dnsIdent.ToProto(),
+			oldHTTPIdent.ToProto(),
+			tlsALPNIdent.ToProto(),
+		},
+	})
+	test.AssertNotError(t, err, "NewOrder failed")
+	test.AssertEquals(t, numAuthorizations(order), 3)
+	test.Assert(t, slices.Contains(order.V2Authorizations, dnsAuthzID), "dns-01 authz should have been reused")
+	test.Assert(t, !slices.Contains(order.V2Authorizations, oldHTTPAuthzID), "old http-01 authz should not have been reused")
+	test.Assert(t, !slices.Contains(order.V2Authorizations, tlsALPNAuthzID), "tls-alpn-01 authz should not have been reused")
+
+	// The rejections, and their reasons, should have been logged.
+	loglines := mockLog.GetAllMatching("Authorization reuse JSON=")
+	test.AssertEquals(t, len(loglines), 1)
+	var event authzReuseEvent
+	err = json.Unmarshal([]byte(loglines[0][strings.Index(loglines[0], "{"):]), &event)
+	test.AssertNotError(t, err, "unmarshalling authorization reuse event")
+	test.AssertEquals(t, event.OrderID, order.Id)
+	test.AssertEquals(t, len(event.Rejected), 2)
+	for _, rejected := range event.Rejected {
+		switch rejected.Authz {
+		case oldHTTPAuthzID:
+			test.AssertEquals(t, rejected.Reason, reuseRejectedDeadline)
+		case tlsALPNAuthzID:
+			test.AssertEquals(t, rejected.Reason, reuseRejectedPolicy)
+		default:
+			t.Errorf("unexpected rejected authz %d", rejected.Authz)
+		}
+	}
+
+	mockLog.Clear()
+	order, err = ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID: registration.Id,
+		Identifiers:    []*corepb.Identifier{newHTTPIdent.ToProto()},
+	})
+	test.AssertNotError(t, err, "NewOrder failed")
+	test.AssertDeepEquals(t, order.V2Authorizations, []int64{newHTTPAuthzID})
+	// The order must expire when the profile stops permitting reuse of its
+	// http-01 authorization, which is sooner than the order lifetime.
+	test.AssertEquals(t, order.Expires.AsTime(), twoDaysAgo.Add(7*24*time.Hour))
+
+	// The cap should have been logged.
+	loglines = mockLog.GetAllMatching("Authorization reuse JSON=")
+	test.AssertEquals(t, len(loglines), 1)
+	event = authzReuseEvent{}
+	err = json.Unmarshal([]byte(loglines[0][strings.Index(loglines[0], "{"):]), &event)
+	test.AssertNotError(t, err, "unmarshalling authorization reuse event")
+	test.AssertEquals(t, len(event.Rejected), 0)
+	test.AssertNotNil(t, event.ReuseDeadline, "reuse deadline should have been logged")
+	test.Assert(t, event.ReuseDeadline.Equal(twoDaysAgo.Add(7*24*time.Hour)), "logged reuse deadline should be the cap")
+	test.Assert(t, event.Expires.Equal(order.Expires.AsTime()), "logged expiry should be the order's")
+}
+
+func TestNewOrder_ProfileChallengeTypes(t *testing.T) {
+	_, sa, ra, _, fc, registration, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	testCases := []struct {
+		name           string
+		challTypes     []core.AcmeChallenge
+		ident          identifier.ACMEIdentifier
+		wantChallTypes []string
+		expectErr      string
+	}{
+		{
+			name:           "No restriction",
+			challTypes:     nil,
+			ident:          identifier.NewDNS(randomDomain()),
+			wantChallTypes: []string{"http-01", "dns-01", "tls-alpn-01"},
+		},
+		{
+			name:           "Only dns-01",
+			challTypes:     []core.AcmeChallenge{core.ChallengeTypeDNS01},
+			ident:          identifier.NewDNS(randomDomain()),
+			wantChallTypes: []string{"dns-01"},
+		},
+		{
+			name:           "Forbid http-01",
+			challTypes:     []core.AcmeChallenge{core.ChallengeTypeDNS01, core.ChallengeTypeTLSALPN01},
+			ident:          identifier.NewDNS(randomDomain()),
+			wantChallTypes: []string{"dns-01", "tls-alpn-01"},
+		},
+		{
+			name:       "Only http-01, wildcard",
+			challTypes: []core.AcmeChallenge{core.ChallengeTypeHTTP01},
+			ident:      identifier.NewDNS("*." + randomDomain()),
+			expectErr:  "Profile \"test\" does not permit any challenge types suitable for identifier",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ra.profiles.byName = map[string]*validationProfile{
+				"test": {
+					pendingAuthzLifetime: 7 * 24 * time.Hour,
+					validAuthzLifetime:   30 * 24 * time.Hour,
+					orderLifetime:        7 * 24 * time.Hour,
+					maxNames:             1,
+					identifierTypes:      []identifier.IdentifierType{identifier.TypeDNS},
+					challengeTypes:       tc.challTypes,
+				},
+			}
+
+			order, err := ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+				RegistrationID:         registration.Id,
+				Identifiers:            []*corepb.Identifier{tc.ident.ToProto()},
+				CertificateProfileName: "test",
+			})
+			if tc.expectErr != "" {
+				test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+				test.AssertContains(t, err.Error(), tc.expectErr)
+				return
+			}
+			test.AssertNotError(t, err, "NewOrder failed")
+
+			authz := getAuthorization(t, order.V2Authorizations[0], sa)
+			var gotChallTypes []string
+			for _, chall := range authz.Challenges {
+				gotChallTypes = append(gotChallTypes, chall.Type)
+			}
+			slices.Sort(gotChallTypes)
+			slices.Sort(tc.wantChallTypes)
+			test.AssertDeepEquals(t, gotChallTypes, tc.wantChallTypes)
+		})
+	}
+
+	// An authorization solved by a challenge type which the profile doesn't
+	// permit must not be reused.
+	ra.profiles.byName = map[string]*validationProfile{
+		"test": {
+			pendingAuthzLifetime: 7 * 24 * time.Hour,
+			validAuthzLifetime:   30 * 24 * time.Hour,
+			orderLifetime:        7 * 24 * time.Hour,
+			maxNames:             1,
+			identifierTypes:      []identifier.IdentifierType{identifier.TypeDNS},
+			challengeTypes:       []core.AcmeChallenge{core.ChallengeTypeDNS01},
+		},
+	}
+	ident := identifier.NewDNS(randomDomain())
+	httpAuthzID := createFinalizedAuthorization(t, sa, registration.Id, ident, fc.Now().Add(24*time.Hour), core.ChallengeTypeHTTP01, fc.Now())
+	order, err := ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID:         registration.Id,
+		Identifiers:            []*corepb.Identifier{ident.ToProto()},
+		CertificateProfileName: "test",
+	})
+	test.AssertNotError(t, err, "NewOrder failed")
+	test.AssertEquals(t, numAuthorizations(order), 1)
+	test.AssertNotEquals(t, order.V2Authorizations[0], httpAuthzID)
+}
+
 func TestNewOrder_ProfileIdentifierTypes(t *testing.T) {
 	_, _, ra, _, _, registration, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -3197,6 +3579,29 @@ func TestPerformValidationBadChallengeType(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "challenge type \"http-01\" no longer allowed")
 }
 
+func TestPerformValidationProfileChallengeType(t *testing.T) {
+	_, sa, ra, _, fc, registration, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	ra.profiles.def().challengeTypes = []core.AcmeChallenge{core.ChallengeTypeDNS01}
+
+	authzPB := createPendingAuthorization(t, sa, registration.Id, identifier.NewDNS("example.com"), fc.Now().Add(12*time.Hour))
+	var httpChallIdx int64
+	for i, ch := range authzPB.Challenges {
+		if core.AcmeChallenge(ch.Type) == core.ChallengeTypeHTTP01 {
+			httpChallIdx = int64(i)
+		}
+	}
+
+	_, err := ra.PerformValidation(context.Background(), &rapb.PerformValidationRequest{
+		Authz:          authzPB,
+		ChallengeIndex: httpChallIdx,
+	})
+	test.AssertError(t, err, "ra.PerformValidation allowed a challenge type forbidden by the profile")
+	test.AssertErrorIs(t, err, berrors.Malformed)
+	test.AssertEquals(t, err.Error(), "challenge type \"http-01\" not allowed by profile")
+}
+
 type timeoutPub struct {
 }
 
@@ -3799,6 +4204,84 @@ func TestRevokeCertByKey(t *testing.T) {
 	test.AssertNotError(t, err, "should have succeeded")
 }
 
+// mockCRLUpdater records the shards it is asked to regenerate.
+type mockCRLUpdater struct {
+	sync.Mutex
+	requests int
+	shards   []*cupb.Shard
+}
+
+func (m *mockCRLUpdater) RegenerateShards(_ context.Context, req *cupb.RegenerateShardsRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.requests++
+	m.shards = append(m.shards, req.Shards...)
+	return &emptypb.Empty{}, nil
+}
+
+func TestRevokeRegeneratesCRLShard(t *testing.T) {
+	_, _, ra, _, clk, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	_, cert := test.ThrowAwayCert(t, clk)
+	cert.IsCA = true
+	ic, err := issuance.NewCertificate(cert)
+	test.AssertNotError(t, err, "failed to create issuer cert")
+	ra.issuersByNameID = map[issuance.NameID]*issuance.Certificate{
+		ic.NameID(): ic,
+	}
+	ra.SA = newMockSARevocation(cert)
+	cu := &mockCRLUpdater{}
+	ra.CRLUpdater = cu
+
+	_, err = ra.RevokeCertByKey(context.Background(), &rapb.RevokeCertByKeyRequest{
+		Cert: cert.Raw,
+	})
+	test.AssertNotError(t, err, "should have succeeded")
+	ra.Drain()
+
+	shardIdx, err := crl.Shard(cert)
+	test.AssertNotError(t, err, "getting cert's CRL shard")
+	test.AssertEquals(t, len(cu.shards), 1)
+	test.AssertEquals(t, cu.shards[0].IssuerNameID, int64(ic.NameID()))
+	test.AssertEquals(t, cu.shards[0].ShardIdx, shardIdx)
+}
+
+func TestRegenerateCRLShardCoalesces(t *testing.T) {
+	_, _, ra, _, clk, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	cu := &mockCRLUpdater{}
+	ra.CRLUpdater = cu
+
+	// Repeated requests for the same shard within the debounce period are
+	// sent as a single request naming each shard once.
+	ra.regenerateCRLShard(1, 3)
+	ra.regenerateCRLShard(1, 3)
+	ra.regenerateCRLShard(1, 4)
+	ra.regenerateCRLShard(2, 3)
+	clk.Add(crlRegenDebounce)
+	ra.drainWG.Wait()
+
+	test.AssertEquals(t, cu.requests, 1)
+	var got []string
+	for _, shard := range cu.shards {
+		got = append(got, fmt.Sprintf("%d/%d", shard.IssuerNameID, shard.ShardIdx))
+	}
+	slices.Sort(got)
+	test.AssertDeepEquals(t, got, []string{"1/3", "1/4", "2/3"})
+
+	// Once sent, the same shard can be requested again. Shards beyond the
+	// pending limit are dropped.
+	for i := range crlRegenMaxPending + 10 {
+		ra.regenerateCRLShard(1, int64(i))
+	}
+	ra.Drain()
+
+	test.AssertEquals(t, cu.requests, 2)
+	test.AssertEquals(t, len(cu.shards), 3+crlRegenMaxPending)
+}
+
 func TestAdministrativelyRevokeCertificate(t *testing.T) {
 	_, _, ra, _, clk, _, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -4082,6 +4565,32 @@ func TestGetAuthorization(t *testing.T) {
 	authz, err = ra.GetAuthorization(context.Background(), &rapb.GetAuthorizationRequest{Id: 1})
 	test.AssertNotError(t, err, "should not fail")
 	test.AssertEquals(t, len(authz.Challenges), 0)
+
+	// With HTTP01 enabled but not permitted by the authorization's profile,
+	// GetAuthorization should filter out the mock challenge.
+	pa, err = policy.New(
+		map[identifier.IdentifierType]bool{
+			identifier.TypeDNS: true,
+			identifier.TypeIP:  true,
+		},
+		map[core.AcmeChallenge]bool{
+			core.ChallengeTypeHTTP01: true,
+			core.ChallengeTypeDNS01:  true,
+		},
+		blog.NewMock())
+	test.AssertNotError(t, err, "Couldn't create PA")
+	ra.PA = pa
+	ra.profiles.def().challengeTypes = []core.AcmeChallenge{core.ChallengeTypeDNS01}
+	authz, err = ra.GetAuthorization(context.Background(), &rapb.GetAuthorizationRequest{Id: 1})
+	test.AssertNotError(t, err, "should not fail")
+	test.AssertEquals(t, len(authz.Challenges), 0)
+
+	// An authorization whose profile no longer exists should still be
+	// fetchable, with its challenges filtered only by the PA.
+	ra.SA.(*mockSAWithAuthzs).authzs[0].CertificateProfileName = "removed"
+	authz, err = ra.GetAuthorization(context.Background(), &rapb.GetAuthorizationRequest{Id: 1})
+	test.AssertNotError(t, err, "should not fail for an authz with an unknown profile")
+	test.AssertEquals(t, len(authz.Challenges), 1)
 }
 
 type NoUpdateSA struct {
@@ -4156,63 +4665,6 @@ func TestUpdateRegistrationKey(t *testing.T) {
 	test.AssertContains(t, err.Error(), "mocked to always error")
 }
 
-func TestCRLShard(t *testing.T) {
-	var cdp []string
-	n, err := crlShard(&x509.Certificate{CRLDistributionPoints: cdp})
-	if err == nil {
-		t.Errorf("crlShard(%+v) = %d, %s, want 0, some error", cdp, n, err)
-	}
-
-	cdp = []string{
-		"https://example.com/123.crl",
-		"https://example.net/123.crl",
-	}
-	n, err = crlShard(&x509.Certificate{CRLDistributionPoints: cdp})
-	if err == nil {
-		t.Errorf("crlShard(%+v) = %d, %s, want 0, some error", cdp, n, err)
-	}
-
-	cdp = []string{
-		"https://example.com/abc",
-	}
-	n, err = crlShard(&x509.Certificate{CRLDistributionPoints: cdp})
-	if err == nil {
-		t.Errorf("crlShard(%+v) = %d, %s, want 0, some error", cdp, n, err)
-	}
-
-	cdp = []string{
-		"example",
-	}
-	n, err = crlShard(&x509.Certificate{CRLDistributionPoints: cdp})
-	if err == nil {
-		t.Errorf("crlShard(%+v) = %d, %s, want 0, some error", cdp, n, err)
-	}
-
-	cdp = []string{
-		"https://example.com/abc/-77.crl",
-	}
-	n, err = crlShard(&x509.Certificate{CRLDistributionPoints: cdp})
-	if err == nil {
-		t.Errorf("crlShard(%+v) = %d, %s, want 0, some error", cdp, n, err)
-	}
-
-	cdp = []string{
-		"https://example.com/abc/123",
-	}
-	n, err = crlShard(&x509.Certificate{CRLDistributionPoints: cdp})
-	if err != nil || n != 123 {
-		t.Errorf("crlShard(%+v) = %d, %s, want 123, nil", cdp, n, err)
-	}
-
-	cdp = []string{
-		"https://example.com/abc/123.crl",
-	}
-	n, err = crlShard(&x509.Certificate{CRLDistributionPoints: cdp})
-	if err != nil || n != 123 {
-		t.Errorf("crlShard(%+v) = %d, %s, want 123, nil", cdp, n, err)
-	}
-}
-
 type mockSAWithOverrides struct {
 	sapb.StorageAuthorityClient
 	inserted *sapb.AddRateLimitOverrideRequest
@@ -4223,6 +4675,22 @@ func (sa *mockSAWithOverrides) AddRateLimitOverride(ctx context.Context, req *sa
 	return &sapb.AddRateLimitOverrideResponse{}, nil
 }
 
+func (sa *mockSAWithOverrides) DisableExpiredRateLimitOverrides(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*sapb.DisableExpiredRateLimitOverridesResponse, error) {
+	return &sapb.DisableExpiredRateLimitOverridesResponse{
+		Disabled: []*sapb.RateLimitOverride{
+			{
+				LimitEnum: 1,
+				BucketKey: "1:127.0.0.1",
+				Comment:   "expired",
+				Period:    durationpb.New(time.Hour),
+				Count:     100,
+				Burst:     100,
+				ExpiresAt: timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+	}, nil
+}
+
 func TestAddRateLimitOverride(t *testing.T) {
 	_, _, ra, _, _, _, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -4251,3 +4719,128 @@ func TestAddRateLimitOverride(t *testing.T) {
 	test.AssertEquals(t, mockSA.inserted.Override.Count, ov.Override.Count)
 	test.AssertEquals(t, mockSA.inserted.Override.Burst, ov.Override.Burst)
 }
+
+func TestDisableExpiredRateLimitOverrides(t *testing.T) {
+	_, _, ra, _, _, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	mockSA := mockSAWithOverrides{}
+	ra.SA = &mockSA
+	mockLog := ra.log.(*blog.Mock)
+	mockLog.Clear()
+
+	resp, err := ra.DisableExpiredRateLimitOverrides(ctx, &emptypb.Empty{})
+	test.AssertNotError(t, err, "expected successful sweep, got error")
+	test.AssertEquals(t, len(resp.Disabled), 1)
+	test.AssertEquals(t, resp.Disabled[0].BucketKey, "1:127.0.0.1")
+	test.AssertEquals(t, resp.Disabled[0].ExpiresAt.AsTime(), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	test.AssertEquals(t, len(mockLog.GetAllMatching(`Disabled expired rate limit override.*"BucketKey":"1:127.0.0.1"`)), 1)
+}
+
+func TestCheckOrder(t *testing.T) {
+	_, sa, ra, rl, fc, registration, cleanUp := initAuthorities(t)
+	defer cleanUp()
+	ctx := context.Background()
+	ra.VA = va.RemoteClients{CAAClient: &caaFailer{}}
+
+	txnBuilder, err := ratelimits.NewTransactionBuilder(ratelimits.LimitConfigs{
+		ratelimits.NewOrdersPerAccount.String(): &ratelimits.LimitConfig{
+			Burst:  1,
+			Count:  1,
+			Period: config.Duration{Duration: time.Hour * 24}},
+	}, nil, metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "making transaction composer")
+	ra.txnBuilder = txnBuilder
+
+	pausedDomain := randomDomain()
+	_, err = sa.PauseIdentifiers(ctx, &sapb.PauseRequest{
+		RegistrationID: registration.Id,
+		Identifiers:    []*corepb.Identifier{identifier.NewDNS(pausedDomain).ToProto()},
+	})
+	test.AssertNotError(t, err, "pausing identifier")
+
+	req := &rapb.CheckOrderRequest{
+		RegistrationID: registration.Id,
+		Identifiers: identifier.ACMEIdentifiers{
+			identifier.NewDNS("a.com"),
+			identifier.NewDNS("b.com"),
+			identifier.NewDNS(pausedDomain),
+			identifier.NewDNS("exactblacklist.letsencrypt.org"),
+		}.ToProtoSlice(),
+		CaaValidationMethod: string(core.ChallengeTypeDNS01),
+	}
+
+	problemsByValue := func(resp *rapb.CheckOrderResponse) map[string][]*corepb.ProblemDetails {
+		t.Helper()
+		test.AssertEquals(t, len(resp.Identifiers), len(req.Identifiers))
+		res := make(map[string][]*corepb.ProblemDetails)
+		for _, check := range resp.Identifiers {
+			res[check.Identifier.Value] = check.Problems
+		}
+		return res
+	}
+
+	resp, err := ra.CheckOrder(ctx, req)
+	test.AssertNotError(t, err, "CheckOrder failed")
+	test.AssertEquals(t, len(resp.Problems), 0)
+	identProbs := problemsByValue(resp)
+	test.AssertEquals(t, len(identProbs["a.com"]), 1)
+	test.AssertContains(t, identProbs["a.com"][0].Detail, "CAA invalid for a.com")
+	test.AssertEquals(t, len(identProbs["b.com"]), 0)
+	test.AssertEquals(t, len(identProbs[pausedDomain]), 1)
+	test.AssertEquals(t, identProbs[pausedDomain][0].ProblemType, string(probs.PausedProblem))
+	test.AssertEquals(t, len(identProbs["exactblacklist.letsencrypt.org"]), 1)
+	test.AssertEquals(t, identProbs["exactblacklist.letsencrypt.org"][0].ProblemType, string(probs.RejectedIdentifierProblem))
+
+	// Exhaust the account's NewOrdersPerAccount limit.
+	bucketKey, err := ratelimits.BuildBucketKey(ratelimits.NewOrdersPerAccount, registration.Id, identifier.ACMEIdentifier{}, nil, netip.Addr{})
+	test.AssertNotError(t, err, "building bucket key")
+	err = rl.BatchSet(ctx, map[string]time.Time{
+		bucketKey: fc.Now().Add(25 * time.Hour),
+	})
+	test.AssertNotError(t, err, "updating rate limit bucket")
+
+	resp, err = ra.CheckOrder(ctx, req)
+	test.AssertNotError(t, err, "CheckOrder failed")
+	test.AssertEquals(t, len(resp.Problems), 1)
+	test.AssertEquals(t, resp.Problems[0].ProblemType, string(probs.RateLimitedProblem))
+	test.AssertContains(t, resp.Problems[0].Detail, "too many new orders")
+
+	// Orders which replace a certificate within its ARI window are exempt.
+	req.IsARIRenewal = true
+	resp, err = ra.CheckOrder(ctx, req)
+	test.AssertNotError(t, err, "CheckOrder failed")
+	test.AssertEquals(t, len(resp.Problems), 0)
+
+	// A validation method the profile doesn't permit is reported against each
+	// DNS identifier, rather than failing the whole check.
+	ra.profiles.def().challengeTypes = []core.AcmeChallenge{core.ChallengeTypeHTTP01}
+	resp, err = ra.CheckOrder(ctx, req)
+	test.AssertNotError(t, err, "CheckOrder failed")
+	test.AssertEquals(t, len(resp.Problems), 0)
+	identProbs = problemsByValue(resp)
+	test.AssertEquals(t, len(identProbs["b.com"]), 1)
+	test.AssertEquals(t, identProbs["b.com"][0].ProblemType, string(probs.RejectedIdentifierProblem))
+	test.AssertContains(t, identProbs["b.com"][0].Detail, "validation method")
+	ra.profiles.def().challengeTypes = nil
+
+	// Checking CAA is optional.
+	req.CaaValidationMethod = ""
+	resp, err = ra.CheckOrder(ctx, req)
+	test.AssertNotError(t, err, "CheckOrder failed")
+	test.AssertEquals(t, len(problemsByValue(resp)["a.com"]), 0)
+
+	// The account isn't permitted to use a restricted profile.
+	ra.profiles.def().allowList = allowlist.NewList([]int64{1337})
+	resp, err = ra.CheckOrder(ctx, req)
+	test.AssertNotError(t, err, "CheckOrder failed")
+	test.AssertEquals(t, len(resp.Problems), 1)
+	test.AssertEquals(t, resp.Problems[0].ProblemType, string(probs.UnauthorizedProblem))
+
+	// Nothing was created along the way.
+	_, err = sa.GetOrderForNames(ctx, &sapb.GetOrderForNamesRequest{
+		AcctID:      registration.Id,
+		Identifiers: identifier.NewDNSSlice([]string{"b.com"}).ToProtoSlice(),
+	})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+}
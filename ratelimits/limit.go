@@ -1,7 +1,9 @@
 package ratelimits
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/csv"
 	"errors"
 	"fmt"
@@ -43,6 +45,12 @@ type LimitConfig struct {
 	// Period is the duration of time in which the count (of requests) is
 	// allowed. It must be greater than zero.
 	Period config.Duration
+
+	// Shadow, if true, causes the limit to be evaluated and its buckets to be
+	// maintained as usual, but requests which would exceed it are only logged
+	// and counted, never denied. It may only be set for default limits; any
+	// overrides of a shadow limit are also shadow limits.
+	Shadow bool
 }
 
 type LimitConfigs map[string]*LimitConfig
@@ -88,6 +96,11 @@ type Limit struct {
 
 	// isOverride is true if the limit is an override.
 	isOverride bool
+
+	// shadow is true if requests which exceed the limit should be reported
+	// rather than denied. Overrides inherit this from the default limit of the
+	// same name when they are loaded.
+	shadow bool
 }
 
 // precompute calculates the emissionInterval and burstOffset for the limit.
@@ -220,6 +233,10 @@ func parseOverrideLimits(newOverridesYAML overridesYAML) (Limits, error) {
 				return nil, fmt.Errorf("unrecognized name %q in override limit, must be one of %v", k, LimitNames)
 			}
 
+			if v.Shadow {
+				return nil, fmt.Errorf("override limit %q: shadow may only be set for default limits", k)
+			}
+
 			for _, entry := range v.Ids {
 				id, err := hydrateOverrideLimit(entry.Id, name)
 				if err != nil {
@@ -298,6 +315,7 @@ func parseDefaultLimits(newDefaultLimits LimitConfigs) (Limits, error) {
 			Count:  v.Count,
 			Period: v.Period,
 			Name:   name,
+			shadow: v.Shadow,
 		}
 
 		err := ValidateLimit(lim)
@@ -319,6 +337,15 @@ type limitRegistry struct {
 	// defaults stores default limits by 'name'.
 	defaults Limits
 
+	// defaultsPath is the path to the YAML file the defaults were loaded from,
+	// if any. It is used to reload the defaults.
+	defaultsPath string
+
+	// overridesPath is the path to the YAML file the overrides are loaded
+	// from, if any. It is only used to detect changes to the file; overrides
+	// are always loaded using refreshOverrides.
+	overridesPath string
+
 	// overrides stores override limits by 'name:id'.
 	overrides Limits
 
@@ -336,6 +363,7 @@ type limitRegistry struct {
 	overridesTimestamp prometheus.Gauge
 	overridesErrors    prometheus.Gauge
 	overridesPerLimit  prometheus.GaugeVec
+	reloads            *prometheus.CounterVec
 
 	logger blog.Logger
 }
@@ -350,9 +378,9 @@ func (l *limitRegistry) getLimit(name Name, bucketKey string) (*Limit, error) {
 		// Name enums defined in this package.
 		return nil, fmt.Errorf("specified name enum %q, is invalid", name)
 	}
+	l.RLock()
+	defer l.RUnlock()
 	if bucketKey != "" {
-		l.RLock()
-		defer l.RUnlock()
 		// Check for override.
 		ol, ok := l.overrides[bucketKey]
 		if ok {
@@ -396,7 +424,7 @@ func (l *limitRegistry) loadOverrides(ctx context.Context) error {
 		newOverridesPerLimit[override.Name]++
 	}
 
-	l.overrides = newOverrides
+	l.overrides = inheritShadow(l.defaults, newOverrides)
 	l.overridesTimestamp.SetToCurrentTime()
 	for rlName, rlString := range nameToString {
 		l.overridesPerLimit.WithLabelValues(rlString).Set(newOverridesPerLimit[rlName])
@@ -405,6 +433,143 @@ func (l *limitRegistry) loadOverrides(ctx context.Context) error {
 	return nil
 }
 
+// inheritShadow returns the provided overrides, with each override's shadow
+// field set to match that of the default limit of the same name. Overrides
+// which must change are copied rather than modified, because they may be in
+// use by concurrent callers.
+func inheritShadow(defaults, overrides Limits) Limits {
+	result := make(Limits, len(overrides))
+	for bucketKey, override := range overrides {
+		dl, ok := defaults[override.Name.EnumString()]
+		shadow := ok && dl.shadow
+		if override.shadow != shadow {
+			copied := *override
+			copied.shadow = shadow
+			override = &copied
+		}
+		result[bucketKey] = override
+	}
+	return result
+}
+
+// reloadDefaults replaces this registry's defaults with those in the file they
+// were originally loaded from. The new defaults are fully validated before any
+// are replaced, so an invalid file leaves the current defaults in place.
+func (l *limitRegistry) reloadDefaults() error {
+	if l.defaultsPath == "" {
+		return nil
+	}
+	defaultsData, err := loadDefaultsFromFile(l.defaultsPath)
+	if err != nil {
+		return fmt.Errorf("loading defaults: %w", err)
+	}
+	newDefaults, err := parseDefaultLimits(defaultsData)
+	if err != nil {
+		return fmt.Errorf("parsing defaults: %w", err)
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	l.defaults = newDefaults
+	l.overrides = inheritShadow(newDefaults, l.overrides)
+	return nil
+}
+
+// Reload reloads the default limits from their file, if they were loaded from
+// one, and then reloads the overrides. Each is validated before it replaces the
+// current set, and a failure to reload either leaves it unchanged. Transactions
+// built before a reload completes continue to use the limits they were built
+// with.
+func (l *limitRegistry) Reload(ctx context.Context) error {
+	err := l.reloadDefaults()
+	if err != nil {
+		l.reloads.WithLabelValues("failure").Inc()
+		return err
+	}
+	err = l.loadOverrides(ctx)
+	if err != nil {
+		l.reloads.WithLabelValues("failure").Inc()
+		return fmt.Errorf("loading overrides: %w", err)
+	}
+	l.reloads.WithLabelValues("success").Inc()
+	return nil
+}
+
+// watchedFilesHash returns a hash of the contents of the defaults and overrides
+// files, if any.
+func (l *limitRegistry) watchedFilesHash() ([]byte, error) {
+	h := sha256.New()
+	for _, path := range []string{l.defaultsPath, l.overridesPath} {
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		h.Write(data)
+		// Separate the files so that moving bytes between them is a change.
+		h.Write([]byte{0})
+	}
+	return h.Sum(nil), nil
+}
+
+// NewReloader calls Reload each time a value is received on the provided
+// trigger channel, which may be nil, and whenever the contents of the defaults
+// or overrides files are found to have changed. The files are checked for
+// changes every interval; if interval is zero, they are not checked.
+func (l *limitRegistry) NewReloader(interval time.Duration, trigger <-chan os.Signal) context.CancelFunc {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lastHash, err := l.watchedFilesHash()
+	if err != nil {
+		l.logger.Errf("reading rate limit files: %s", err)
+	}
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		context.AfterFunc(ctx, func() { ticker.Stop() })
+		tick = ticker.C
+	}
+
+	go func() {
+		for {
+			select {
+			case <-trigger:
+				l.logger.Info("reloading rate limits (signal)")
+			case <-tick:
+				hash, err := l.watchedFilesHash()
+				if err != nil {
+					l.logger.Errf("reading rate limit files: %s", err)
+					continue
+				}
+				if bytes.Equal(hash, lastHash) {
+					continue
+				}
+				l.logger.Info("reloading rate limits (files changed)")
+			case <-ctx.Done():
+				return
+			}
+
+			// Record the current contents before reloading, so that a file
+			// which fails to load isn't retried until it changes again.
+			hash, err := l.watchedFilesHash()
+			if err == nil {
+				lastHash = hash
+			}
+			err = l.Reload(ctx)
+			if err != nil {
+				l.logger.Errf("reloading rate limits: %s", err)
+				continue
+			}
+			l.logger.Info("reloaded rate limits")
+		}
+	}()
+
+	return cancel
+}
+
 // Ready reports whether at least one override load attempt has completed
 // successfully.
 func (l *limitRegistry) Ready() bool {
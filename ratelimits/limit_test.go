@@ -9,9 +9,12 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"sync"
+	"syscall"
 	"testing"
 	"time"
 
+	"github.com/jmhodges/clock"
 	"github.com/prometheus/client_golang/prometheus"
 	io_prometheus_client "github.com/prometheus/client_model/go"
 
@@ -230,6 +233,11 @@ func TestLoadAndParseOverrideLimitsFromFile(t *testing.T) {
 	test.AssertError(t, err, "single override limit with burst=0")
 	test.AssertContains(t, err.Error(), "invalid burst")
 
+	// Shadow cannot be set for an override.
+	_, err = loadAndParseOverrideLimitsFromFile("testdata/busted_override_shadow.yml")
+	test.AssertError(t, err, "single override limit with shadow=true")
+	test.AssertContains(t, err.Error(), "shadow may only be set for default limits")
+
 	// Id cannot be empty.
 	_, err = loadAndParseOverrideLimitsFromFile("testdata/busted_override_empty_id.yml")
 	test.AssertError(t, err, "single override limit with empty id")
@@ -310,6 +318,172 @@ func TestLoadOverrides(t *testing.T) {
 	test.AssertDeepEquals(t, tb.limitRegistry.overrides, testOverrides)
 }
 
+func TestLoadOverridesInheritsShadow(t *testing.T) {
+	t.Parallel()
+
+	tb, err := NewTransactionBuilder(LimitConfigs{
+		NewRegistrationsPerIPAddress.String(): &LimitConfig{Burst: 20, Count: 20, Period: config.Duration{Duration: time.Second}},
+		NewOrdersPerAccount.String():          &LimitConfig{Burst: 20, Count: 20, Period: config.Duration{Duration: time.Second}, Shadow: true},
+	}, func(context.Context, prometheus.Gauge, blog.Logger) (Limits, error) {
+		return Limits{
+			"1:64.112.117.1": &Limit{Burst: 40, Count: 40, Period: config.Duration{Duration: time.Second}, Name: NewRegistrationsPerIPAddress, isOverride: true},
+			"3:12345":        &Limit{Burst: 40, Count: 40, Period: config.Duration{Duration: time.Second}, Name: NewOrdersPerAccount, isOverride: true},
+		}, nil
+	}, metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "creating TransactionBuilder")
+	err = tb.loadOverrides(context.Background())
+	test.AssertNotError(t, err, "loading overrides")
+
+	// Overrides of a shadow limit are shadow limits too.
+	limit, err := tb.getLimit(NewOrdersPerAccount, "3:12345")
+	test.AssertNotError(t, err, "getting override")
+	test.Assert(t, limit.isOverride, "should be an override")
+	test.Assert(t, limit.shadow, "should be a shadow limit")
+
+	limit, err = tb.getLimit(NewRegistrationsPerIPAddress, "1:64.112.117.1")
+	test.AssertNotError(t, err, "getting override")
+	test.Assert(t, limit.isOverride, "should be an override")
+	test.Assert(t, !limit.shadow, "should not be a shadow limit")
+}
+
+// writeLimitsFiles writes a defaults file with the provided
+// NewRegistrationsPerIPAddress burst and count, and an overrides file with an
+// override of that limit with double the burst and count, to the provided
+// paths.
+func writeLimitsFiles(t *testing.T, defaultsPath, overridesPath string, burst int) {
+	t.Helper()
+	defaults := fmt.Sprintf("NewRegistrationsPerIPAddress:\n  burst: %d\n  count: %d\n  period: 1s\n", burst, burst)
+	err := os.WriteFile(defaultsPath, []byte(defaults), 0600)
+	test.AssertNotError(t, err, "writing defaults file")
+	overrides := fmt.Sprintf("- NewRegistrationsPerIPAddress:\n    burst: %d\n    count: %d\n    period: 1s\n    ids:\n      - id: 64.112.117.1\n", burst*2, burst*2)
+	err = os.WriteFile(overridesPath, []byte(overrides), 0600)
+	test.AssertNotError(t, err, "writing overrides file")
+}
+
+func TestReload(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	defaultsPath := filepath.Join(dir, "defaults.yml")
+	overridesPath := filepath.Join(dir, "overrides.yml")
+	writeLimitsFiles(t, defaultsPath, overridesPath, 20)
+
+	tb, err := NewTransactionBuilderFromFiles(defaultsPath, overridesPath, metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "creating TransactionBuilder")
+	err = tb.loadOverrides(context.Background())
+	test.AssertNotError(t, err, "loading overrides")
+	limiter := newInmemTestLimiter(t, clock.NewFake())
+
+	// Check and spend continuously while the limits are reloaded.
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ip := netip.MustParseAddr(fmt.Sprintf("64.112.117.%d", i%2+1))
+			for ctx.Err() == nil {
+				txns, err := tb.NewAccountLimitTransactions(ip)
+				if err != nil {
+					t.Errorf("building transactions: %s", err)
+					return
+				}
+				_, err = limiter.BatchSpend(ctx, txns)
+				if err != nil {
+					t.Errorf("spending: %s", err)
+					return
+				}
+				_, err = limiter.Check(ctx, txns[0])
+				if err != nil {
+					t.Errorf("checking: %s", err)
+					return
+				}
+			}
+		}()
+	}
+
+	for i := range 10 {
+		writeLimitsFiles(t, defaultsPath, overridesPath, 30+i)
+		err = tb.Reload(context.Background())
+		test.AssertNotError(t, err, "reloading limits")
+	}
+	cancel()
+	wg.Wait()
+
+	limit, err := tb.getLimit(NewRegistrationsPerIPAddress, "")
+	test.AssertNotError(t, err, "getting default")
+	test.AssertEquals(t, limit.Burst, int64(39))
+	limit, err = tb.getLimit(NewRegistrationsPerIPAddress, "1:64.112.117.1")
+	test.AssertNotError(t, err, "getting override")
+	test.AssertEquals(t, limit.Burst, int64(78))
+	test.Assert(t, limit.isOverride, "should be an override")
+
+	// An invalid defaults file should be rejected, leaving both the defaults
+	// and the overrides unchanged.
+	err = os.WriteFile(defaultsPath, []byte("NewRegistrationsPerIPAddress:\n  burst: 0\n  count: 10\n  period: 1s\n"), 0600)
+	test.AssertNotError(t, err, "writing defaults file")
+	writeLimitsFiles(t, filepath.Join(dir, "unused.yml"), overridesPath, 50)
+	err = tb.Reload(context.Background())
+	test.AssertError(t, err, "reloading invalid defaults")
+	limit, err = tb.getLimit(NewRegistrationsPerIPAddress, "")
+	test.AssertNotError(t, err, "getting default")
+	test.AssertEquals(t, limit.Burst, int64(39))
+	limit, err = tb.getLimit(NewRegistrationsPerIPAddress, "1:64.112.117.1")
+	test.AssertNotError(t, err, "getting override")
+	test.AssertEquals(t, limit.Burst, int64(78))
+	test.AssertMetricWithLabelsEquals(t, tb.reloads, prometheus.Labels{"result": "failure"}, 1)
+
+	// An invalid overrides file should be rejected too.
+	writeLimitsFiles(t, defaultsPath, filepath.Join(dir, "unused.yml"), 60)
+	err = os.WriteFile(overridesPath, []byte("- NewRegistrationsPerIPAddress:\n    burst: 0\n    count: 10\n    period: 1s\n    ids:\n      - id: 64.112.117.1\n"), 0600)
+	test.AssertNotError(t, err, "writing overrides file")
+	err = tb.Reload(context.Background())
+	test.AssertError(t, err, "reloading invalid overrides")
+	limit, err = tb.getLimit(NewRegistrationsPerIPAddress, "1:64.112.117.1")
+	test.AssertNotError(t, err, "getting override")
+	test.AssertEquals(t, limit.Burst, int64(78))
+}
+
+func TestNewReloader(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	defaultsPath := filepath.Join(dir, "defaults.yml")
+	overridesPath := filepath.Join(dir, "overrides.yml")
+	writeLimitsFiles(t, defaultsPath, overridesPath, 20)
+
+	tb, err := NewTransactionBuilderFromFiles(defaultsPath, overridesPath, metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "creating TransactionBuilder")
+
+	waitForBurst := func(expected int64) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			limit, err := tb.getLimit(NewRegistrationsPerIPAddress, "")
+			test.AssertNotError(t, err, "getting default")
+			if limit.Burst == expected {
+				return
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		t.Fatalf("timed out waiting for burst %d", expected)
+	}
+
+	// Without file checks, only the trigger causes a reload.
+	trigger := make(chan os.Signal, 1)
+	shutdown := tb.NewReloader(0, trigger)
+	writeLimitsFiles(t, defaultsPath, overridesPath, 30)
+	trigger <- syscall.SIGHUP
+	waitForBurst(30)
+	shutdown()
+
+	// With file checks, changing the files causes a reload.
+	shutdown = tb.NewReloader(5*time.Millisecond, nil)
+	defer shutdown()
+	writeLimitsFiles(t, defaultsPath, overridesPath, 40)
+	waitForBurst(40)
+}
+
 func TestNewRefresher(t *testing.T) {
 	mockLog := blog.NewMock()
 
@@ -442,6 +616,12 @@ func TestLoadAndParseDefaultLimits(t *testing.T) {
 	test.AssertEquals(t, l[NewRegistrationsPerIPv6Range.EnumString()].Count, int64(30))
 	test.AssertEquals(t, l[NewRegistrationsPerIPv6Range.EnumString()].Period.Duration, time.Second*2)
 
+	// Load a shadow default limit alongside an enforced one.
+	l, err = loadAndParseDefaultLimits("testdata/working_default_shadow.yml")
+	test.AssertNotError(t, err, "valid shadow default limit")
+	test.Assert(t, !l[NewRegistrationsPerIPAddress.EnumString()].shadow, "should not be a shadow limit")
+	test.Assert(t, l[NewOrdersPerAccount.EnumString()].shadow, "should be a shadow limit")
+
 	// Path is empty string.
 	_, err = loadAndParseDefaultLimits("")
 	test.AssertError(t, err, "path is empty string")
@@ -15,6 +15,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	berrors "github.com/letsencrypt/boulder/errors"
+	blog "github.com/letsencrypt/boulder/log"
 )
 
 const (
@@ -37,13 +38,15 @@ type Limiter struct {
 	// source is used to store buckets. It must be safe for concurrent use.
 	source Source
 	clk    clock.Clock
+	log    blog.Logger
 
 	spendLatency *prometheus.HistogramVec
+	shadowDenied *prometheus.CounterVec
 }
 
 // NewLimiter returns a new *Limiter. The provided source must be safe for
 // concurrent use.
-func NewLimiter(clk clock.Clock, source Source, stats prometheus.Registerer) (*Limiter, error) {
+func NewLimiter(clk clock.Clock, source Source, stats prometheus.Registerer, logger blog.Logger) (*Limiter, error) {
 	spendLatency := promauto.With(stats).NewHistogramVec(prometheus.HistogramOpts{
 		Name: "ratelimits_spend_latency",
 		Help: fmt.Sprintf("Latency of ratelimit checks labeled by limit=[name] and decision=[%s|%s], in seconds", Allowed, Denied),
@@ -51,13 +54,43 @@ func NewLimiter(clk clock.Clock, source Source, stats prometheus.Registerer) (*L
 		Buckets: prometheus.ExponentialBuckets(0.0005, 3, 8),
 	}, []string{"limit", "decision"})
 
+	shadowDenied := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Name: "ratelimits_shadow_denied",
+		Help: "Total number of requests which would have been denied by a shadow limit, labeled by limit=[name]",
+	}, []string{"limit"})
+
 	return &Limiter{
 		source:       source,
 		clk:          clk,
+		log:          logger,
 		spendLatency: spendLatency,
+		shadowDenied: shadowDenied,
 	}, nil
 }
 
+// shadowDenialEvent is logged when a request would have been denied by a
+// shadow limit.
+type shadowDenialEvent struct {
+	Limit     string
+	BucketKey string
+	Cost      int64
+	Remaining int64
+	RetryIn   time.Duration
+}
+
+// reportShadowDenial records that the request described by the provided denied
+// *Decision would have been refused, had its limit not been a shadow limit.
+func (l *Limiter) reportShadowDenial(d *Decision) {
+	l.shadowDenied.WithLabelValues(d.transaction.limit.Name.String()).Inc()
+	l.log.InfoObject("Request would have been denied by shadow rate limit", shadowDenialEvent{
+		Limit:     d.transaction.limit.Name.String(),
+		BucketKey: d.transaction.bucketKey,
+		Cost:      d.transaction.cost,
+		Remaining: d.remaining,
+		RetryIn:   d.retryIn,
+	})
+}
+
 // Decision represents the result of a rate limit check or spend operation. To
 // check the result of a *Decision, call the Result() method.
 type Decision struct {
@@ -181,6 +214,15 @@ func (d *Decision) Result(now time.Time) error {
 			retryAfterTs,
 		)
 
+	case CAAChecksPerAccount:
+		return berrors.CAAChecksPerAccountError(
+			retryAfter,
+			"too many CAA checks (%d) requested by this account in the last %s, retry after %s",
+			d.transaction.limit.Burst,
+			d.transaction.limit.Period.Duration,
+			retryAfterTs,
+		)
+
 	default:
 		return berrors.InternalServerError("cannot generate error for unknown rate limit")
 	}
@@ -190,7 +232,8 @@ func (d *Decision) Result(now time.Time) error {
 // capacity. The returned *Decision indicates whether the capacity exists to
 // satisfy the cost and represents the hypothetical state of the bucket IF the
 // cost WERE to be deducted. If no bucket exists it will NOT be created. No
-// state is persisted to the underlying datastore.
+// state is persisted to the underlying datastore. Transactions for shadow
+// limits are always allowed, but would-be denials are reported.
 func (l *Limiter) Check(ctx context.Context, txn Transaction) (*Decision, error) {
 	if txn.allowOnly() {
 		return allowedDecision, nil
@@ -206,9 +249,16 @@ func (l *Limiter) Check(ctx context.Context, txn Transaction) (*Decision, error)
 		// First request from this client. No need to initialize the bucket
 		// because this is a check, not a spend. A TAT of "now" is equivalent to
 		// a full bucket.
-		return maybeSpend(l.clk, txn, l.clk.Now()), nil
+		tat = l.clk.Now()
 	}
-	return maybeSpend(l.clk, txn, tat), nil
+	d := maybeSpend(l.clk, txn, tat)
+	if txn.limit.shadow {
+		if !d.allowed {
+			l.reportShadowDenial(d)
+		}
+		return allowedDecision, nil
+	}
+	return d, nil
 }
 
 // Spend attempts to deduct the cost from the provided bucket's capacity. The
@@ -266,6 +316,10 @@ func stricter(a, b *Decision) *Decision {
 // datastore before returning. Non-existent buckets will be initialized WITH the
 // cost factored into the initial state. The returned *Decision represents the
 // strictest of all *Decisions reached in the batch.
+//
+// Transactions for shadow limits are evaluated and their buckets are updated
+// exactly as they would be if the limits were enforced, but they never
+// contribute to the returned *Decision. Would-be denials are reported instead.
 func (l *Limiter) BatchSpend(ctx context.Context, txns []Transaction) (*Decision, error) {
 	start := l.clk.Now()
 
@@ -308,6 +362,17 @@ func (l *Limiter) BatchSpend(ctx context.Context, txns []Transaction) (*Decision
 			}
 		}
 
+		if txn.limit.shadow {
+			// Shadow Transactions never contribute to the batchDecision.
+			// Spend-only Transactions are best-effort, so there's nothing to
+			// report for them.
+			if !d.allowed && !txn.spendOnly() {
+				l.reportShadowDenial(d)
+			}
+			txnOutcomes[txn] = Allowed
+			continue
+		}
+
 		if !txn.spendOnly() {
 			// Spend-only Transactions are best-effort and do not contribute to
 			// the batchDecision.
@@ -444,6 +509,84 @@ func (l *Limiter) BatchRefund(ctx context.Context, txns []Transaction) (*Decisio
 	return batchDecision, nil
 }
 
+// BucketStatus describes the current state of a single bucket, as reported by
+// BatchStatus. It is intended for display to Subscribers.
+type BucketStatus struct {
+	// Name is the name of the limit which applies to the bucket.
+	Name Name
+
+	// BucketKey is the key of the bucket, in the 'enum:...' format.
+	BucketKey string
+
+	// Burst, Count and Period describe the limit which applies to the bucket,
+	// which may be an override.
+	Burst  int64
+	Count  int64
+	Period time.Duration
+
+	// Override is true if the limit which applies to the bucket is an override
+	// rather than the default.
+	Override bool
+
+	// Remaining is the number of requests which could be made right now before
+	// the limit is reached.
+	Remaining int64
+
+	// ResetAt is the time at which the bucket will have refilled to its
+	// maximum capacity, assuming no further requests are made. If the bucket
+	// is already full, it is the current time.
+	ResetAt time.Time
+}
+
+// BatchStatus reports the current state of the buckets referenced by the
+// provided Transactions, without spending or refunding any capacity. The cost
+// of each Transaction is ignored. Non-existent buckets are reported as full and
+// are NOT created. Allow-only Transactions, including those for disabled
+// limits, are omitted from the result. The order of the returned statuses
+// matches the order of the Transactions.
+func (l *Limiter) BatchStatus(ctx context.Context, txns []Transaction) ([]BucketStatus, error) {
+	batch, bucketKeys, err := prepareBatch(txns)
+	if err != nil {
+		return nil, err
+	}
+	if len(batch) == 0 {
+		return nil, nil
+	}
+
+	// Remove cancellation from the request context so that transactions are not
+	// interrupted by a client disconnect.
+	ctx = context.WithoutCancel(ctx)
+	tats, err := l.source.BatchGet(ctx, bucketKeys)
+	if err != nil {
+		return nil, fmt.Errorf("batch get for %d keys: %w", len(bucketKeys), err)
+	}
+
+	now := l.clk.Now()
+	statuses := make([]BucketStatus, 0, len(batch))
+	for _, txn := range batch {
+		// A zero-cost spend leaves the TAT untouched, so the resulting
+		// Decision describes the bucket exactly as it is now.
+		txn.cost = 0
+		tat, bucketExists := tats[txn.bucketKey]
+		if !bucketExists {
+			// A TAT of "now" is equivalent to a full bucket.
+			tat = now
+		}
+		d := maybeSpend(l.clk, txn, tat)
+		statuses = append(statuses, BucketStatus{
+			Name:      txn.limit.Name,
+			BucketKey: txn.bucketKey,
+			Burst:     txn.limit.Burst,
+			Count:     txn.limit.Count,
+			Period:    txn.limit.Period.Duration,
+			Override:  txn.limit.isOverride,
+			Remaining: d.remaining,
+			ResetAt:   now.Add(d.resetIn),
+		})
+	}
+	return statuses, nil
+}
+
 // BatchReset resets the specified buckets to their maximum capacity using the
 // provided reset Transactions. The new bucket state is persisted to the
 // underlying datastore before returning.
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/letsencrypt/boulder/config"
 	berrors "github.com/letsencrypt/boulder/errors"
@@ -23,7 +24,7 @@ const overriddenIP = "64.112.117.1"
 
 // newTestLimiter constructs a new limiter.
 func newTestLimiter(t *testing.T, s Source, clk clock.FakeClock) *Limiter {
-	l, err := NewLimiter(clk, s, metrics.NoopRegisterer)
+	l, err := NewLimiter(clk, s, metrics.NoopRegisterer, blog.NewMock())
 	test.AssertNotError(t, err, "should not error")
 	return l
 }
@@ -53,10 +54,14 @@ func setup(t *testing.T) (context.Context, map[string]*Limiter, *TransactionBuil
 	}
 
 	// Construct a limiter for each source.
-	return testCtx, map[string]*Limiter{
+	limiters := map[string]*Limiter{
 		"inmem": newInmemTestLimiter(t, clk),
 		"redis": newRedisTestLimiter(t, clk),
-	}, newTestTransactionBuilder(t), clk, randIP.String()
+	}
+	if dbSourceAvailable() {
+		limiters["db"] = newDBTestLimiter(t, clk)
+	}
+	return testCtx, limiters, newTestTransactionBuilder(t), clk, randIP.String()
 }
 
 func resetBucket(t *testing.T, l *Limiter, ctx context.Context, limit *Limit, bucketKey string) {
@@ -449,6 +454,116 @@ func TestLimiter_RefundAndReset(t *testing.T) {
 	}
 }
 
+func TestLimiter_BatchStatus(t *testing.T) {
+	t.Parallel()
+	testCtx, limiters, txnBuilder, clk, testIP := setup(t)
+	for name, l := range limiters {
+		t.Run(name, func(t *testing.T) {
+			bucketKey := newIPAddressBucketKey(NewRegistrationsPerIPAddress, netip.MustParseAddr(testIP))
+			limit, err := txnBuilder.getLimit(NewRegistrationsPerIPAddress, bucketKey)
+			test.AssertNotError(t, err, "should not error")
+			overriddenBucketKey := newIPAddressBucketKey(NewRegistrationsPerIPAddress, netip.MustParseAddr(overriddenIP))
+			overriddenLimit, err := txnBuilder.getLimit(NewRegistrationsPerIPAddress, overriddenBucketKey)
+			test.AssertNotError(t, err, "should not error")
+			resetBucket(t, l, testCtx, overriddenLimit, overriddenBucketKey)
+
+			txn, err := newCheckOnlyTransaction(limit, bucketKey, 1)
+			test.AssertNotError(t, err, "txn should be valid")
+			overriddenTxn, err := newCheckOnlyTransaction(overriddenLimit, overriddenBucketKey, 1)
+			test.AssertNotError(t, err, "txn should be valid")
+
+			// Neither bucket exists yet, so both should be reported as full,
+			// and neither should be created.
+			statuses, err := l.BatchStatus(testCtx, []Transaction{txn, overriddenTxn, newAllowOnlyTransaction()})
+			test.AssertNotError(t, err, "should not error")
+			test.AssertEquals(t, len(statuses), 2)
+			test.AssertEquals(t, statuses[0].BucketKey, bucketKey)
+			test.AssertEquals(t, statuses[0].Remaining, int64(20))
+			test.AssertEquals(t, statuses[0].ResetAt, clk.Now())
+			test.Assert(t, !statuses[0].Override, "should not be an override")
+			test.AssertEquals(t, statuses[1].BucketKey, overriddenBucketKey)
+			test.AssertEquals(t, statuses[1].Remaining, int64(40))
+			test.AssertEquals(t, statuses[1].Burst, int64(40))
+			test.Assert(t, statuses[1].Override, "should be an override")
+
+			// Spend 5 requests.
+			txn5, err := newTransaction(limit, bucketKey, 5)
+			test.AssertNotError(t, err, "txn should be valid")
+			d, err := l.Spend(testCtx, txn5)
+			test.AssertNotError(t, err, "should not error")
+			test.Assert(t, d.allowed, "should be allowed")
+
+			// The status should reflect the spend, and checking the status
+			// repeatedly should not spend anything.
+			for range 2 {
+				statuses, err = l.BatchStatus(testCtx, []Transaction{txn})
+				test.AssertNotError(t, err, "should not error")
+				test.AssertEquals(t, len(statuses), 1)
+				test.AssertEquals(t, statuses[0].Remaining, int64(15))
+				test.AssertEquals(t, statuses[0].ResetAt, clk.Now().Add(d.resetIn))
+			}
+
+			// Once the bucket has refilled, it should be reported as full.
+			clk.Add(d.resetIn)
+			statuses, err = l.BatchStatus(testCtx, []Transaction{txn})
+			test.AssertNotError(t, err, "should not error")
+			test.AssertEquals(t, statuses[0].Remaining, int64(20))
+			test.AssertEquals(t, statuses[0].ResetAt, clk.Now())
+		})
+	}
+}
+
+func TestLimiter_ShadowLimits(t *testing.T) {
+	t.Parallel()
+	testCtx, limiters, _, clk, testIP := setup(t)
+	for name, l := range limiters {
+		t.Run(name, func(t *testing.T) {
+			mockLog := blog.NewMock()
+			l.log = mockLog
+
+			shadowLimit := &Limit{Burst: 1, Count: 1, Period: config.Duration{Duration: time.Second}, Name: NewOrdersPerAccount, shadow: true}
+			shadowLimit.precompute()
+			shadowBucketKey := joinWithColon(NewOrdersPerAccount.EnumString(), testIP)
+			enforcedLimit := &Limit{Burst: 10, Count: 10, Period: config.Duration{Duration: time.Second}, Name: NewRegistrationsPerIPAddress}
+			enforcedLimit.precompute()
+			enforcedBucketKey := newIPAddressBucketKey(NewRegistrationsPerIPAddress, netip.MustParseAddr(testIP))
+			resetBucket(t, l, testCtx, shadowLimit, shadowBucketKey)
+			resetBucket(t, l, testCtx, enforcedLimit, enforcedBucketKey)
+
+			shadowTxn, err := newTransaction(shadowLimit, shadowBucketKey, 1)
+			test.AssertNotError(t, err, "txn should be valid")
+			enforcedTxn, err := newTransaction(enforcedLimit, enforcedBucketKey, 1)
+			test.AssertNotError(t, err, "txn should be valid")
+
+			// The first spend fits within the shadow limit, and is persisted
+			// to its bucket as usual.
+			d, err := l.BatchSpend(testCtx, []Transaction{shadowTxn, enforcedTxn})
+			test.AssertNotError(t, err, "should not error")
+			test.Assert(t, d.allowed, "should be allowed")
+			test.AssertEquals(t, d.remaining, int64(9))
+			tat, err := l.source.Get(testCtx, shadowBucketKey)
+			test.AssertNotError(t, err, "shadow bucket should exist")
+			test.AssertEquals(t, tat, clk.Now().Add(time.Second))
+			test.AssertEquals(t, len(mockLog.GetAllMatching("shadow rate limit")), 0)
+
+			// The second spend exceeds the shadow limit, but is still allowed.
+			// The would-be denial is reported, and the enforced limit is spent.
+			d, err = l.BatchSpend(testCtx, []Transaction{shadowTxn, enforcedTxn})
+			test.AssertNotError(t, err, "should not error")
+			test.Assert(t, d.allowed, "should be allowed")
+			test.AssertEquals(t, d.remaining, int64(8))
+			test.AssertMetricWithLabelsEquals(t, l.shadowDenied, prometheus.Labels{"limit": NewOrdersPerAccount.String()}, 1)
+			test.AssertEquals(t, len(mockLog.GetAllMatching(`Request would have been denied by shadow rate limit JSON=.*"BucketKey":"`+shadowBucketKey+`"`)), 1)
+
+			// A check of the shadow limit alone is allowed too, and reported.
+			d, err = l.Check(testCtx, shadowTxn)
+			test.AssertNotError(t, err, "should not error")
+			test.Assert(t, d.allowed, "should be allowed")
+			test.AssertMetricWithLabelsEquals(t, l.shadowDenied, prometheus.Labels{"limit": NewOrdersPerAccount.String()}, 2)
+		})
+	}
+}
+
 func TestRateLimitError(t *testing.T) {
 	t.Parallel()
 	now := clock.NewFake().Now()
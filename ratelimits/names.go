@@ -104,6 +104,11 @@ const (
 	// to the rate limit override request endpoint per IP address. It uses
 	// bucket key 'enum:ipAddress'.
 	LimitOverrideRequestsPerIPAddress
+
+	// CAAChecksPerAccount is used to limit the number of CAA lookups performed
+	// on behalf of an account by the order check endpoint. Each identifier
+	// checked costs 1. It uses bucket key 'enum:regId'.
+	CAAChecksPerAccount
 )
 
 // nameToString is a map of Name values to string names.
@@ -118,6 +123,7 @@ var nameToString = map[Name]string{
 	CertificatesPerFQDNSet:                            "CertificatesPerFQDNSet",
 	FailedAuthorizationsForPausingPerDomainPerAccount: "FailedAuthorizationsForPausingPerDomainPerAccount",
 	LimitOverrideRequestsPerIPAddress:                 "LimitOverrideRequestsPerIPAddress",
+	CAAChecksPerAccount:                               "CAAChecksPerAccount",
 }
 
 // isValid returns true if the Name is a valid rate limit name.
@@ -292,7 +298,7 @@ func validateIdForName(name Name, id string) error {
 		// 'enum:ipv6rangeCIDR'
 		return validIPv6RangeCIDR(id)
 
-	case NewOrdersPerAccount:
+	case NewOrdersPerAccount, CAAChecksPerAccount:
 		// 'enum:regId'
 		return validateRegId(id)
 
@@ -383,7 +389,7 @@ func BuildBucketKey(name Name, regId int64, singleIdent identifier.ACMEIdentifie
 		}
 		return newIPv6RangeCIDRBucketKey(name, prefix), nil
 
-	case NewOrdersPerAccount:
+	case NewOrdersPerAccount, CAAChecksPerAccount:
 		if regId == 0 {
 			return "", makeMissingErr("regId")
 		}
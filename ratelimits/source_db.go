@@ -0,0 +1,362 @@
+package ratelimits
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/letsencrypt/boulder/db"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// Compile-time check that DBSource implements the source interface.
+var _ Source = (*DBSource)(nil)
+
+// bucketExpiryPadding is added to the TAT of each bucket to determine when it
+// may be garbage collected. It matches the TTL padding used by RedisSource to
+// account for clock skew.
+const bucketExpiryPadding = 10 * time.Minute
+
+// DBSource is a ratelimits source backed by the rateLimitBuckets table. Each
+// row holds the TAT of a single bucket, as nanoseconds since the Unix epoch,
+// and the time after which the row may be garbage collected. Rows past their
+// expiry are treated as though they do not exist, even before they've been
+// deleted.
+type DBSource struct {
+	dbMap   db.DatabaseMap
+	clk     clock.Clock
+	latency *prometheus.HistogramVec
+	deleted prometheus.Counter
+}
+
+// NewDBSource returns a new database backed source using the provided
+// db.DatabaseMap.
+func NewDBSource(dbMap db.DatabaseMap, clk clock.Clock, stats prometheus.Registerer) *DBSource {
+	latency := promauto.With(stats).NewHistogramVec(prometheus.HistogramOpts{
+		Name: "ratelimits_db_latency",
+		Help: "Histogram of database call latencies labeled by call=[set|get|delete|gc] and result=[success|error]",
+		// Exponential buckets ranging from 0.0005s to 3s.
+		Buckets: prometheus.ExponentialBucketsRange(0.0005, 3, 8),
+	}, []string{"call", "result"})
+
+	deleted := promauto.With(stats).NewCounter(prometheus.CounterOpts{
+		Name: "ratelimits_expired_buckets_deleted",
+		Help: "Total number of expired rate limit buckets deleted by garbage collection",
+	})
+
+	return &DBSource{
+		dbMap:   dbMap,
+		clk:     clk,
+		latency: latency,
+		deleted: deleted,
+	}
+}
+
+func (d *DBSource) observeLatency(call string, latency time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "failed"
+		if errors.Is(err, ErrBucketNotFound) {
+			result = "notFound"
+		} else if errors.Is(err, context.DeadlineExceeded) {
+			result = "deadlineExceeded"
+		} else if errors.Is(err, context.Canceled) {
+			result = "canceled"
+		}
+	}
+	d.latency.With(prometheus.Labels{"call": call, "result": result}).Observe(latency.Seconds())
+}
+
+// sortedKeys returns the keys of the provided map in sorted order. Rows are
+// always written in the same order so that concurrent batches touching
+// overlapping buckets acquire their row locks in the same order, and therefore
+// cannot deadlock.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// BatchSet stores TATs at the specified bucketKeys, overwriting any existing
+// values, using a single multi-row INSERT.
+func (d *DBSource) BatchSet(ctx context.Context, buckets map[string]time.Time) error {
+	if len(buckets) == 0 {
+		return nil
+	}
+	start := d.clk.Now()
+
+	keys := sortedKeys(buckets)
+	args := make([]any, 0, len(keys)*3)
+	for _, bucketKey := range keys {
+		tat := buckets[bucketKey].UTC()
+		args = append(args, bucketKey, tat.UnixNano(), tat.Add(bucketExpiryPadding))
+	}
+	qmarks := make([]string, len(keys))
+	for i := range qmarks {
+		qmarks[i] = "(?,?,?)"
+	}
+
+	_, err := d.dbMap.ExecContext(ctx, fmt.Sprintf(
+		`INSERT INTO rateLimitBuckets (bucketKey, tat, expires) VALUES %s
+		ON DUPLICATE KEY UPDATE tat = VALUES(tat), expires = VALUES(expires)`,
+		strings.Join(qmarks, ",")), args...)
+	if err != nil {
+		d.observeLatency("batchset", d.clk.Since(start), err)
+		return err
+	}
+
+	d.observeLatency("batchset", d.clk.Since(start), nil)
+	return nil
+}
+
+// BatchSetNotExisting attempts to set TATs for the specified bucketKeys if they
+// do not already exist. Rows which exist but have expired are overwritten.
+// Returns a map indicating which keys already existed.
+func (d *DBSource) BatchSetNotExisting(ctx context.Context, buckets map[string]time.Time) (map[string]bool, error) {
+	if len(buckets) == 0 {
+		return map[string]bool{}, nil
+	}
+	start := d.clk.Now()
+	now := d.clk.Now()
+
+	result, err := db.WithTransaction(ctx, d.dbMap, func(tx db.Executor) (any, error) {
+		keys := sortedKeys(buckets)
+		args := make([]any, len(keys))
+		for i, bucketKey := range keys {
+			args[i] = bucketKey
+		}
+
+		// Existence is determined by reading the rows, rather than from the
+		// rows affected by the INSERT below, because our connections set
+		// clientFoundRows and so an untouched row is counted as affected.
+		// Locking the rows, and the gaps where absent rows would be, prevents
+		// a concurrent caller from inserting any of them until we're done.
+		var rows []bucketExpiryModel
+		_, err := tx.Select(ctx, &rows, fmt.Sprintf(
+			"SELECT bucketKey, expires FROM rateLimitBuckets WHERE bucketKey IN (%s) FOR UPDATE",
+			db.QuestionMarks(len(keys))), args...)
+		if err != nil {
+			return nil, err
+		}
+		alreadyExists := make(map[string]bool, len(buckets))
+		for _, row := range rows {
+			if row.Expires.After(now) {
+				alreadyExists[row.BucketKey] = true
+			}
+		}
+
+		for _, bucketKey := range keys {
+			if alreadyExists[bucketKey] {
+				continue
+			}
+			tat := buckets[bucketKey].UTC()
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO rateLimitBuckets (bucketKey, tat, expires) VALUES (?, ?, ?)
+				ON DUPLICATE KEY UPDATE tat = VALUES(tat), expires = VALUES(expires)`,
+				bucketKey, tat.UnixNano(), tat.Add(bucketExpiryPadding))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return alreadyExists, nil
+	})
+	if err != nil {
+		d.observeLatency("batchsetnotexisting", d.clk.Since(start), err)
+		return nil, err
+	}
+
+	d.observeLatency("batchsetnotexisting", d.clk.Since(start), nil)
+	return result.(map[string]bool), nil
+}
+
+// BatchIncrement atomically increments the TATs of the specified bucketKeys by
+// their cost and extends their expiry to now plus their TTL. Increments are
+// applied in the database, rather than by reading and re-writing the TAT, so
+// that concurrent increments of the same bucket are never lost.
+func (d *DBSource) BatchIncrement(ctx context.Context, buckets map[string]increment) error {
+	start := d.clk.Now()
+	now := d.clk.Now()
+
+	_, err := db.WithTransaction(ctx, d.dbMap, func(tx db.Executor) (any, error) {
+		for _, bucketKey := range sortedKeys(buckets) {
+			incr := buckets[bucketKey]
+			_, err := tx.ExecContext(ctx,
+				"UPDATE rateLimitBuckets SET tat = tat + ?, expires = ? WHERE bucketKey = ?",
+				incr.cost.Nanoseconds(), now.Add(incr.ttl), bucketKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		d.observeLatency("batchincrby", d.clk.Since(start), err)
+		return err
+	}
+
+	d.observeLatency("batchincrby", d.clk.Since(start), nil)
+	return nil
+}
+
+// Get retrieves the TAT at the specified bucketKey. If the bucketKey does not
+// exist, or has expired, ErrBucketNotFound is returned.
+func (d *DBSource) Get(ctx context.Context, bucketKey string) (time.Time, error) {
+	start := d.clk.Now()
+
+	var tatNano int64
+	err := d.dbMap.SelectOne(ctx, &tatNano,
+		"SELECT tat FROM rateLimitBuckets WHERE bucketKey = ? AND expires > ?",
+		bucketKey, d.clk.Now())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			d.observeLatency("get", d.clk.Since(start), ErrBucketNotFound)
+			return time.Time{}, ErrBucketNotFound
+		}
+		d.observeLatency("get", d.clk.Since(start), err)
+		return time.Time{}, err
+	}
+
+	d.observeLatency("get", d.clk.Since(start), nil)
+	return time.Unix(0, tatNano).UTC(), nil
+}
+
+// bucketModel is a single row of the rateLimitBuckets table, as read by
+// BatchGet.
+type bucketModel struct {
+	BucketKey string `db:"bucketKey"`
+	TAT       int64  `db:"tat"`
+}
+
+// bucketExpiryModel is a single row of the rateLimitBuckets table, as read by
+// BatchSetNotExisting.
+type bucketExpiryModel struct {
+	BucketKey string    `db:"bucketKey"`
+	Expires   time.Time `db:"expires"`
+}
+
+// BatchGet retrieves the TATs at the specified bucketKeys. If a bucketKey does
+// not exist, or has expired, it WILL NOT be included in the returned map.
+func (d *DBSource) BatchGet(ctx context.Context, bucketKeys []string) (map[string]time.Time, error) {
+	tats := make(map[string]time.Time, len(bucketKeys))
+	if len(bucketKeys) == 0 {
+		return tats, nil
+	}
+	start := d.clk.Now()
+
+	args := make([]any, 0, len(bucketKeys)+1)
+	for _, bucketKey := range bucketKeys {
+		args = append(args, bucketKey)
+	}
+	args = append(args, d.clk.Now())
+
+	var rows []bucketModel
+	_, err := d.dbMap.Select(ctx, &rows, fmt.Sprintf(
+		"SELECT bucketKey, tat FROM rateLimitBuckets WHERE bucketKey IN (%s) AND expires > ?",
+		db.QuestionMarks(len(bucketKeys))), args...)
+	if err != nil {
+		d.observeLatency("batchget", d.clk.Since(start), err)
+		return nil, err
+	}
+
+	for _, row := range rows {
+		tats[row.BucketKey] = time.Unix(0, row.TAT).UTC()
+	}
+
+	d.observeLatency("batchget", d.clk.Since(start), nil)
+	return tats, nil
+}
+
+// BatchDelete deletes the TATs at the specified bucketKeys ('name:id'). A nil
+// return value does not indicate that the bucketKeys existed.
+func (d *DBSource) BatchDelete(ctx context.Context, bucketKeys []string) error {
+	if len(bucketKeys) == 0 {
+		return nil
+	}
+	start := d.clk.Now()
+
+	args := make([]any, len(bucketKeys))
+	for i, bucketKey := range bucketKeys {
+		args[i] = bucketKey
+	}
+	_, err := d.dbMap.ExecContext(ctx, fmt.Sprintf(
+		"DELETE FROM rateLimitBuckets WHERE bucketKey IN (%s)",
+		db.QuestionMarks(len(bucketKeys))), args...)
+	if err != nil {
+		d.observeLatency("delete", d.clk.Since(start), err)
+		return err
+	}
+
+	d.observeLatency("delete", d.clk.Since(start), nil)
+	return nil
+}
+
+// DeleteExpired deletes expired buckets, at most batchSize at a time, until
+// none remain or the context is cancelled. It returns the number of buckets
+// deleted. Because each DELETE re-checks the expiry of every row it removes, it
+// is safe to call concurrently with itself and with the other methods of
+// DBSource.
+func (d *DBSource) DeleteExpired(ctx context.Context, batchSize int) (int64, error) {
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("batchSize must be greater than 0, got %d", batchSize)
+	}
+
+	var total int64
+	for {
+		start := d.clk.Now()
+		res, err := d.dbMap.ExecContext(ctx,
+			"DELETE FROM rateLimitBuckets WHERE expires <= ? LIMIT ?",
+			d.clk.Now(), batchSize)
+		if err != nil {
+			d.observeLatency("gc", d.clk.Since(start), err)
+			return total, err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			d.observeLatency("gc", d.clk.Since(start), err)
+			return total, err
+		}
+		d.observeLatency("gc", d.clk.Since(start), nil)
+		d.deleted.Add(float64(rows))
+		total += rows
+		if rows < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
+
+// NewGarbageCollector periodically deletes expired buckets, at most batchSize
+// at a time, at the given interval. The returned function stops it.
+func (d *DBSource) NewGarbageCollector(interval time.Duration, batchSize int, logger blog.Logger) context.CancelFunc {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				deleted, err := d.DeleteExpired(ctx, batchSize)
+				if err != nil {
+					logger.Errf("deleting expired rate limit buckets: %v", err)
+					continue
+				}
+				logger.Debugf("deleted %d expired rate limit buckets", deleted)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return cancel
+}
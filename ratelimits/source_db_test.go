@@ -0,0 +1,226 @@
+package ratelimits
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/sa"
+	"github.com/letsencrypt/boulder/test"
+	"github.com/letsencrypt/boulder/test/vars"
+)
+
+// dbSourceAvailable returns true if the rateLimitBuckets table is available in
+// the test database. The table only exists in config-next.
+func dbSourceAvailable() bool {
+	return os.Getenv("BOULDER_CONFIG_DIR") == "test/config-next"
+}
+
+func newTestDBSource(t *testing.T, clk clock.FakeClock) *DBSource {
+	t.Helper()
+	dbMap, err := sa.DBMapForTest(vars.DBConnRateLimits)
+	test.AssertNotError(t, err, "creating database connection")
+	return NewDBSource(dbMap, clk, metrics.NoopRegisterer)
+}
+
+func newDBTestLimiter(t *testing.T, clk clock.FakeClock) *Limiter {
+	return newTestLimiter(t, newTestDBSource(t, clk), clk)
+}
+
+// uniqueKeys returns n bucket keys which are unique to this test run, and
+// registers a cleanup function which deletes them.
+func uniqueKeys(t *testing.T, s *DBSource, n int) []string {
+	t.Helper()
+	keys := make([]string, n)
+	for i := range n {
+		keys[i] = fmt.Sprintf("%s:%d:%d", t.Name(), time.Now().UnixNano(), i)
+	}
+	t.Cleanup(func() {
+		_ = s.BatchDelete(context.Background(), keys)
+	})
+	return keys
+}
+
+func TestDBSource_BatchSetAndGet(t *testing.T) {
+	if !dbSourceAvailable() {
+		t.Skip("Test requires rateLimitBuckets database table")
+	}
+	clk := clock.NewFake()
+	s := newTestDBSource(t, clk)
+	keys := uniqueKeys(t, s, 4)
+
+	set := map[string]time.Time{
+		keys[0]: clk.Now().Add(time.Second),
+		keys[1]: clk.Now().Add(time.Second * 2),
+		keys[2]: clk.Now().Add(time.Second * 3),
+	}
+
+	incr := map[string]increment{
+		keys[0]: {time.Second, time.Minute},
+		keys[1]: {time.Second * 2, time.Minute},
+		keys[2]: {time.Second * 3, time.Minute},
+	}
+
+	err := s.BatchSet(context.Background(), set)
+	test.AssertNotError(t, err, "BatchSet() should not error")
+
+	got, err := s.BatchGet(context.Background(), keys[:3])
+	test.AssertNotError(t, err, "BatchGet() should not error")
+
+	for k, v := range set {
+		test.AssertEquals(t, got[k], v.UTC())
+	}
+
+	err = s.BatchIncrement(context.Background(), incr)
+	test.AssertNotError(t, err, "BatchIncrement() should not error")
+
+	got, err = s.BatchGet(context.Background(), keys[:3])
+	test.AssertNotError(t, err, "BatchGet() should not error")
+
+	for k := range set {
+		test.AssertEquals(t, got[k], set[k].Add(incr[k].cost).UTC())
+	}
+
+	// Test that BatchGet() returns a zero time for a key that does not exist.
+	got, err = s.BatchGet(context.Background(), []string{keys[0], keys[3], keys[2]})
+	test.AssertNotError(t, err, "BatchGet() should not error when a key isn't found")
+	test.Assert(t, got[keys[3]].IsZero(), "BatchGet() should return a zero time for a key that does not exist")
+
+	_, err = s.Get(context.Background(), keys[3])
+	test.AssertErrorIs(t, err, ErrBucketNotFound)
+
+	err = s.BatchDelete(context.Background(), keys[:3])
+	test.AssertNotError(t, err, "BatchDelete() should not error")
+
+	got, err = s.BatchGet(context.Background(), keys[:3])
+	test.AssertNotError(t, err, "BatchGet() should not error")
+	test.AssertEquals(t, len(got), 0)
+}
+
+func TestDBSource_BatchSetNotExisting(t *testing.T) {
+	if !dbSourceAvailable() {
+		t.Skip("Test requires rateLimitBuckets database table")
+	}
+	clk := clock.NewFake()
+	s := newTestDBSource(t, clk)
+	keys := uniqueKeys(t, s, 2)
+
+	first := clk.Now().Add(time.Second)
+	err := s.BatchSet(context.Background(), map[string]time.Time{keys[0]: first})
+	test.AssertNotError(t, err, "BatchSet() should not error")
+
+	second := clk.Now().Add(time.Hour)
+	alreadyExists, err := s.BatchSetNotExisting(context.Background(), map[string]time.Time{
+		keys[0]: second,
+		keys[1]: second,
+	})
+	test.AssertNotError(t, err, "BatchSetNotExisting() should not error")
+	test.Assert(t, alreadyExists[keys[0]], "existing bucket should be reported")
+	test.Assert(t, !alreadyExists[keys[1]], "new bucket should not be reported")
+
+	got, err := s.Get(context.Background(), keys[0])
+	test.AssertNotError(t, err, "Get() should not error")
+	test.AssertEquals(t, got, first.UTC())
+
+	got, err = s.Get(context.Background(), keys[1])
+	test.AssertNotError(t, err, "Get() should not error")
+	test.AssertEquals(t, got, second.UTC())
+
+	// Once the first bucket has expired it's treated as though it doesn't
+	// exist, and may be overwritten.
+	clk.Add(bucketExpiryPadding + 2*time.Second)
+	_, err = s.Get(context.Background(), keys[0])
+	test.AssertErrorIs(t, err, ErrBucketNotFound)
+
+	third := clk.Now().Add(time.Second)
+	alreadyExists, err = s.BatchSetNotExisting(context.Background(), map[string]time.Time{keys[0]: third})
+	test.AssertNotError(t, err, "BatchSetNotExisting() should not error")
+	test.Assert(t, !alreadyExists[keys[0]], "expired bucket should not be reported")
+
+	got, err = s.Get(context.Background(), keys[0])
+	test.AssertNotError(t, err, "Get() should not error")
+	test.AssertEquals(t, got, third.UTC())
+}
+
+func TestDBSource_ConcurrentIncrement(t *testing.T) {
+	if !dbSourceAvailable() {
+		t.Skip("Test requires rateLimitBuckets database table")
+	}
+	clk := clock.NewFake()
+	s := newTestDBSource(t, clk)
+	keys := uniqueKeys(t, s, 2)
+
+	start := clk.Now()
+	err := s.BatchSet(context.Background(), map[string]time.Time{keys[0]: start, keys[1]: start})
+	test.AssertNotError(t, err, "BatchSet() should not error")
+
+	// Increment both buckets from many goroutines at once. No increment should
+	// be lost, and no batch should deadlock with another.
+	const workers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for range workers {
+		wg.Go(func() {
+			errs <- s.BatchIncrement(context.Background(), map[string]increment{
+				keys[0]: {time.Second, time.Minute},
+				keys[1]: {time.Second, time.Minute},
+			})
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		test.AssertNotError(t, err, "BatchIncrement() should not error")
+	}
+
+	got, err := s.BatchGet(context.Background(), keys)
+	test.AssertNotError(t, err, "BatchGet() should not error")
+	for _, k := range keys {
+		test.AssertEquals(t, got[k], start.Add(workers*time.Second).UTC())
+	}
+}
+
+func TestDBSource_DeleteExpired(t *testing.T) {
+	if !dbSourceAvailable() {
+		t.Skip("Test requires rateLimitBuckets database table")
+	}
+	clk := clock.NewFake()
+	s := newTestDBSource(t, clk)
+	keys := uniqueKeys(t, s, 5)
+
+	_, err := s.DeleteExpired(context.Background(), 0)
+	test.AssertError(t, err, "DeleteExpired() should reject a batchSize of 0")
+
+	buckets := make(map[string]time.Time)
+	for _, k := range keys[:4] {
+		buckets[k] = clk.Now()
+	}
+	buckets[keys[4]] = clk.Now().Add(time.Hour)
+	err = s.BatchSet(context.Background(), buckets)
+	test.AssertNotError(t, err, "BatchSet() should not error")
+
+	// Nothing has expired yet.
+	_, err = s.DeleteExpired(context.Background(), 2)
+	test.AssertNotError(t, err, "DeleteExpired() should not error")
+	got, err := s.BatchGet(context.Background(), keys)
+	test.AssertNotError(t, err, "BatchGet() should not error")
+	test.AssertEquals(t, len(got), 5)
+
+	// The first four buckets have now expired. Deleting them takes more than
+	// one batch.
+	clk.Add(bucketExpiryPadding + time.Minute)
+	deleted, err := s.DeleteExpired(context.Background(), 2)
+	test.AssertNotError(t, err, "DeleteExpired() should not error")
+	test.Assert(t, deleted >= 4, fmt.Sprintf("expected at least 4 buckets deleted, got %d", deleted))
+
+	got, err = s.BatchGet(context.Background(), keys)
+	test.AssertNotError(t, err, "BatchGet() should not error")
+	test.AssertEquals(t, len(got), 1)
+	test.AssertEquals(t, got[keys[4]], buckets[keys[4]].UTC())
+}
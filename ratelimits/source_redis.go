@@ -15,16 +15,17 @@ import (
 // Compile-time check that RedisSource implements the source interface.
 var _ Source = (*RedisSource)(nil)
 
-// RedisSource is a ratelimits source backed by sharded Redis.
+// RedisSource is a ratelimits source backed by Redis. The client may be a
+// *redis.Ring of independent shards, a *redis.ClusterClient, or a Sentinel
+// managed failover *redis.Client.
 type RedisSource struct {
-	client  *redis.Ring
+	client  redis.UniversalClient
 	clk     clock.Clock
 	latency *prometheus.HistogramVec
 }
 
-// NewRedisSource returns a new Redis backed source using the provided
-// *redis.Ring client.
-func NewRedisSource(client *redis.Ring, clk clock.Clock, stats prometheus.Registerer) *RedisSource {
+// NewRedisSource returns a new Redis backed source using the provided client.
+func NewRedisSource(client redis.UniversalClient, clk clock.Clock, stats prometheus.Registerer) *RedisSource {
 	latency := promauto.With(stats).NewHistogramVec(prometheus.HistogramOpts{
 		Name: "ratelimits_latency",
 		Help: "Histogram of Redis call latencies labeled by call=[set|get|delete|ping] and result=[success|error]",
@@ -62,6 +63,13 @@ func resultForError(err error) string {
 		// Dialer timed out connecting to Redis.
 		return "timeout"
 	}
+	if redis.IsReadOnlyError(err) || redis.IsLoadingError(err) || redis.IsMasterDownError(err) ||
+		redis.IsClusterDownError(err) || redis.IsTryAgainError(err) {
+		// The command was sent to a primary which was demoted, or a node which
+		// was not yet ready, during a failover or resharding, and retries were
+		// exhausted.
+		return "failover"
+	}
 	var redisErr redis.Error
 	if errors.Is(err, redisErr) {
 		// An internal error was returned by the Redis server.
@@ -228,11 +236,17 @@ func (r *RedisSource) BatchGet(ctx context.Context, bucketKeys []string) (map[st
 }
 
 // BatchDelete deletes the TATs at the specified bucketKeys ('name:id'). A nil
-// return value does not indicate that the bucketKeys existed.
+// return value does not indicate that the bucketKeys existed. Each key is
+// deleted by its own command, in a single pipeline, because the keys may be
+// stored on different shards or in different cluster hash slots.
 func (r *RedisSource) BatchDelete(ctx context.Context, bucketKeys []string) error {
 	start := r.clk.Now()
 
-	err := r.client.Del(ctx, bucketKeys...).Err()
+	pipeline := r.client.Pipeline()
+	for _, bucketKey := range bucketKeys {
+		pipeline.Del(ctx, bucketKey)
+	}
+	_, err := pipeline.Exec(ctx)
 	if err != nil {
 		r.observeLatency("delete", r.clk.Since(start), err)
 		return err
@@ -242,14 +256,25 @@ func (r *RedisSource) BatchDelete(ctx context.Context, bucketKeys []string) erro
 	return nil
 }
 
-// Ping checks that each shard of the *redis.Ring is reachable using the PING
-// command.
+// Ping checks that each shard of a *redis.Ring, or each primary of a
+// *redis.ClusterClient, is reachable using the PING command. For any other
+// client, such as a Sentinel managed failover client, the current primary is
+// pinged.
 func (r *RedisSource) Ping(ctx context.Context) error {
 	start := r.clk.Now()
 
-	err := r.client.ForEachShard(ctx, func(ctx context.Context, shard *redis.Client) error {
+	ping := func(ctx context.Context, shard *redis.Client) error {
 		return shard.Ping(ctx).Err()
-	})
+	}
+	var err error
+	switch client := r.client.(type) {
+	case *redis.Ring:
+		err = client.ForEachShard(ctx, ping)
+	case *redis.ClusterClient:
+		err = client.ForEachMaster(ctx, ping)
+	default:
+		err = r.client.Ping(ctx).Err()
+	}
 	if err != nil {
 		r.observeLatency("ping", r.clk.Since(start), err)
 		return err
@@ -246,7 +246,12 @@ func NewTransactionBuilderFromDatabase(defaults string, overrides GetOverridesFu
 		return overrides, nil
 	}
 
-	return NewTransactionBuilder(defaultsData, refresher, stats, logger)
+	builder, err := NewTransactionBuilder(defaultsData, refresher, stats, logger)
+	if err != nil {
+		return nil, err
+	}
+	builder.defaultsPath = defaults
+	return builder, nil
 }
 
 // NewTransactionBuilderFromFiles returns a new *TransactionBuilder. The
@@ -259,19 +264,24 @@ func NewTransactionBuilderFromFiles(defaults string, overrides string, stats pro
 		return nil, err
 	}
 
-	if overrides == "" {
-		return NewTransactionBuilder(defaultsData, nil, stats, logger)
-	}
-
-	refresher := func(ctx context.Context, _ prometheus.Gauge, _ blog.Logger) (Limits, error) {
-		overridesData, err := loadOverridesFromFile(overrides)
-		if err != nil {
-			return nil, err
+	var refresher OverridesRefresher
+	if overrides != "" {
+		refresher = func(ctx context.Context, _ prometheus.Gauge, _ blog.Logger) (Limits, error) {
+			overridesData, err := loadOverridesFromFile(overrides)
+			if err != nil {
+				return nil, err
+			}
+			return parseOverrideLimits(overridesData)
 		}
-		return parseOverrideLimits(overridesData)
 	}
 
-	return NewTransactionBuilder(defaultsData, refresher, stats, logger)
+	builder, err := NewTransactionBuilder(defaultsData, refresher, stats, logger)
+	if err != nil {
+		return nil, err
+	}
+	builder.defaultsPath = defaults
+	builder.overridesPath = overrides
+	return builder, nil
 }
 
 // NewTransactionBuilder returns a new *TransactionBuilder. A defaults map is
@@ -309,6 +319,12 @@ func NewTransactionBuilder(defaultConfigs LimitConfigs, refresher OverridesRefre
 		Help:      "A gauge with the number of overrides, partitioned by rate limit",
 	}, []string{"limit"})
 
+	reloads := promauto.With(stats).NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ratelimits",
+		Name:      "reloads",
+		Help:      "A counter of attempts to reload default and override limits, labeled by result=[success|failure]",
+	}, []string{"result"})
+
 	registry := &limitRegistry{
 		defaults:         defaults,
 		refreshOverrides: refresher,
@@ -317,6 +333,7 @@ func NewTransactionBuilder(defaultConfigs LimitConfigs, refresher OverridesRefre
 		overridesTimestamp: overridesTimestamp,
 		overridesErrors:    overridesErrors,
 		overridesPerLimit:  *overridesPerLimit,
+		reloads:            reloads,
 	}
 
 	return &TransactionBuilder{registry}, nil
@@ -696,6 +713,55 @@ func (builder *TransactionBuilder) NewOrderLimitTransactions(regId int64, idents
 	return append(transactions, txn), nil
 }
 
+// AccountStatusTransactions returns the set of check-only Transactions which
+// describe the buckets an account's new-order requests are subject to, for use
+// with Limiter.BatchStatus. The NewOrdersPerAccount bucket is always included.
+// If any identifiers are provided, the CertificatesPerDomain (or
+// CertificatesPerDomainPerAccount, if overridden) buckets for each of them and
+// the CertificatesPerFQDNSet bucket for the set as a whole are also included.
+// Buckets for disabled and shadow limits are omitted.
+//
+// Precondition: idents must be a list of identifiers that all pass
+// policy.WellFormedIdentifiers.
+func (builder *TransactionBuilder) AccountStatusTransactions(regId int64, idents identifier.ACMEIdentifiers) ([]Transaction, error) {
+	makeTxnError := func(err error, limit Name) error {
+		return fmt.Errorf("error constructing rate limit transaction for %s rate limit: %w", limit, err)
+	}
+
+	txn, err := builder.ordersPerAccountTransaction(regId)
+	if err != nil {
+		return nil, makeTxnError(err, NewOrdersPerAccount)
+	}
+	transactions := []Transaction{txn}
+
+	if len(idents) > 0 {
+		txns, err := builder.certificatesPerDomainCheckOnlyTransactions(regId, idents)
+		if err != nil {
+			return nil, makeTxnError(err, CertificatesPerDomain)
+		}
+		transactions = append(transactions, txns...)
+
+		txn, err := builder.certificatesPerFQDNSetCheckOnlyTransaction(idents)
+		if err != nil {
+			return nil, makeTxnError(err, CertificatesPerFQDNSet)
+		}
+		transactions = append(transactions, txn)
+	}
+
+	var statusTxns []Transaction
+	for _, txn := range transactions {
+		if txn.allowOnly() || txn.limit.shadow {
+			continue
+		}
+		// Status Transactions never spend, regardless of how the
+		// corresponding new-order Transaction is processed.
+		txn.spend = false
+		txn.check = true
+		statusTxns = append(statusTxns, txn)
+	}
+	return statusTxns, nil
+}
+
 // NewAccountLimitTransactions takes in an IP address from a new-account request
 // and returns the set of rate limit transactions that should be evaluated
 // before allowing the request to proceed.
@@ -756,3 +822,18 @@ func (builder *TransactionBuilder) LimitOverrideRequestsPerIPAddressTransaction(
 	}
 	return newTransaction(limit, bucketKey, 1)
 }
+
+// CAAChecksPerAccountTransaction returns a Transaction for the
+// CAAChecksPerAccount limit for the provided ACME registration Id, costing one
+// for each of the provided identifiers whose CAA records will be checked.
+func (builder *TransactionBuilder) CAAChecksPerAccountTransaction(regId int64, idents identifier.ACMEIdentifiers) (Transaction, error) {
+	bucketKey := newRegIdBucketKey(CAAChecksPerAccount, regId)
+	limit, err := builder.getLimit(CAAChecksPerAccount, bucketKey)
+	if err != nil {
+		if errors.Is(err, errLimitDisabled) {
+			return newAllowOnlyTransaction(), nil
+		}
+		return Transaction{}, err
+	}
+	return newTransaction(limit, bucketKey, int64(len(idents)))
+}
@@ -223,6 +223,48 @@ func TestCertificatesPerFQDNSetTransactions(t *testing.T) {
 	test.Assert(t, !txn.limit.isOverride, "should not be an override")
 }
 
+func TestAccountStatusTransactions(t *testing.T) {
+	t.Parallel()
+
+	tb, err := NewTransactionBuilderFromFiles("../test/config-next/ratelimit-defaults.yml", "testdata/working_override_13371338.yml", metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "creating TransactionBuilder")
+	err = tb.loadOverrides(context.Background())
+	test.AssertNotError(t, err, "loading overrides")
+
+	// Without identifiers, only the account's NewOrdersPerAccount bucket is
+	// described.
+	txns, err := tb.AccountStatusTransactions(123456789, nil)
+	test.AssertNotError(t, err, "creating transactions")
+	test.AssertEquals(t, len(txns), 1)
+	test.AssertEquals(t, txns[0].bucketKey, "3:123456789")
+	test.Assert(t, txns[0].checkOnly(), "should be check-only")
+
+	// With identifiers, the global CertificatesPerDomain and
+	// CertificatesPerFQDNSet buckets are also described.
+	idents := identifier.NewDNSSlice([]string{"www.example.com", "example.net"})
+	txns, err = tb.AccountStatusTransactions(123456789, idents)
+	test.AssertNotError(t, err, "creating transactions")
+	txns = sortTransactions(txns)
+	namesHash := fmt.Sprintf("%x", core.HashIdentifiers(idents))
+	test.AssertEquals(t, len(txns), 4)
+	test.AssertEquals(t, txns[0].bucketKey, "3:123456789")
+	test.AssertEquals(t, txns[1].bucketKey, "5:example.com")
+	test.AssertEquals(t, txns[2].bucketKey, "5:example.net")
+	test.AssertEquals(t, txns[3].bucketKey, "7:"+namesHash)
+	for _, txn := range txns {
+		test.Assert(t, txn.checkOnly(), "should be check-only")
+	}
+
+	// An account with a CertificatesPerDomainPerAccount override is described
+	// by its per-account buckets instead.
+	txns, err = tb.AccountStatusTransactions(13371338, identifier.NewDNSSlice([]string{"www.example.com"}))
+	test.AssertNotError(t, err, "creating transactions")
+	txns = sortTransactions(txns)
+	test.AssertEquals(t, len(txns), 3)
+	test.AssertEquals(t, txns[1].bucketKey, "6:13371338:example.com")
+	test.Assert(t, txns[1].limit.isOverride, "should be an override")
+}
+
 // NewTransactionBuilder's metrics are tested in TestLoadOverrides.
 func TestNewTransactionBuilder(t *testing.T) {
 	t.Parallel()
@@ -1,7 +1,10 @@
 package redis
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/extra/redisotel/v9"
@@ -26,15 +29,40 @@ type Config struct {
 
 	// ShardAddrs is a map of shard names to IP address:port pairs. The go-redis
 	// `Ring` client will shard reads and writes across the provided Redis
-	// Servers based on a consistent hashing algorithm.
-	ShardAddrs map[string]string `validate:"omitempty,required_without=Lookups,min=1,dive,hostname_port"`
+	// Servers based on a consistent hashing algorithm. Exactly one of
+	// ShardAddrs, Lookups, ClusterAddrs, or SentinelAddrs must be set.
+	ShardAddrs map[string]string `validate:"required_without_all=Lookups ClusterAddrs SentinelAddrs,omitempty,min=1,dive,hostname_port"`
 
 	// Lookups each entry contains a service and domain name that will be used
 	// to construct a SRV DNS query to lookup Redis backends. For example: if
 	// the resource record is 'foo.service.consul', then the 'Service' is 'foo'
 	// and the 'Domain' is 'service.consul'. The expected dNSName to be
 	// authenticated in the server certificate would be 'foo.service.consul'.
-	Lookups []cmd.ServiceDomain `validate:"omitempty,required_without=ShardAddrs,min=1,dive"`
+	Lookups []cmd.ServiceDomain `validate:"required_without_all=ShardAddrs ClusterAddrs SentinelAddrs,omitempty,min=1,dive"`
+
+	// ClusterAddrs is a seed list of IP address:port pairs of Redis Cluster
+	// nodes. When set, the go-redis `ClusterClient` discovers the rest of the
+	// cluster from these nodes and routes each key to the node serving its
+	// hash slot, following MOVED and ASK redirects as slots migrate between
+	// nodes. Unlike a Ring, buckets are not lost when a node restarts, as long
+	// as one of its replicas is promoted in its place.
+	ClusterAddrs []string `validate:"omitempty,excluded_with=ShardAddrs Lookups SentinelAddrs,min=1,dive,hostname_port"`
+
+	// MaxRedirects is the maximum number of MOVED or ASK redirects, or retries
+	// after a network error, which are followed for a single command when
+	// ClusterAddrs is set. Default is 3.
+	MaxRedirects int `validate:"min=0"`
+
+	// SentinelAddrs is a list of IP address:port pairs of Redis Sentinels
+	// monitoring MasterName. When set, a go-redis failover client sends all
+	// commands to the primary which the Sentinels currently agree on, and
+	// reconnects to the new primary after a failover. The Username and
+	// password are used to authenticate to both the Sentinels and the primary.
+	SentinelAddrs []string `validate:"omitempty,excluded_with=ShardAddrs Lookups ClusterAddrs,min=1,dive,hostname_port"`
+
+	// MasterName is the name of the primary monitored by the Sentinels. It is
+	// required when SentinelAddrs is set.
+	MasterName string `validate:"required_with=SentinelAddrs,excluded_without=SentinelAddrs"`
 
 	// LookupFrequency is the frequency of periodic SRV lookups. Defaults to 30
 	// seconds.
@@ -63,7 +91,9 @@ type Config struct {
 	// Maximum number of retry attempts when dialing fails.
 	// Default is 5 attempts.
 	DialerRetries int `validate:"min=0"`
-	// Maximum number of retries before giving up.
+	// Maximum number of retries before giving up. Commands which fail because
+	// a primary is unreachable, loading, or has just been demoted to a
+	// replica (for instance, during a Sentinel failover) are retried.
 	// Default is to not retry failed commands.
 	MaxRetries int `validate:"min=0"`
 	// Minimum backoff between each retry.
@@ -190,3 +220,156 @@ func (r *Ring) StopLookups() {
 	}
 	r.lookup.stop()
 }
+
+// Client is a wrapper around a go-redis/v9 client which, depending on the
+// Config it was constructed from, is a Ring of independent shards, a Redis
+// Cluster client, or a Sentinel-managed failover client.
+type Client struct {
+	redis.UniversalClient
+
+	// ring is set only when the client is a Ring, and is used to stop its
+	// periodic SRV lookups.
+	ring *Ring
+}
+
+// NewClientFromConfig returns a new Redis client using the connection mode
+// selected by the provided Config: a Redis Cluster client if ClusterAddrs is
+// set, a Sentinel-managed failover client if SentinelAddrs is set, and
+// otherwise a Ring, exactly as returned by NewRingFromConfig. Callers should
+// defer a call to StopLookups().
+func NewClientFromConfig(c Config, stats prometheus.Registerer, log blog.Logger) (*Client, error) {
+	if len(c.ClusterAddrs) == 0 && len(c.SentinelAddrs) == 0 {
+		ring, err := NewRingFromConfig(c, stats, log)
+		if err != nil {
+			return nil, err
+		}
+		MustRegisterNodeMetricsCollector(shardPoolStats(ring.Ring), stats, c.clientName(), c.Username)
+		return &Client{UniversalClient: ring.Ring, ring: ring}, nil
+	}
+
+	password, err := c.Pass()
+	if err != nil {
+		return nil, fmt.Errorf("loading password: %w", err)
+	}
+
+	tlsConfig, err := c.TLS.Load(stats)
+	if err != nil {
+		return nil, fmt.Errorf("loading TLS config: %w", err)
+	}
+
+	var inner redis.UniversalClient
+	if len(c.ClusterAddrs) > 0 {
+		cluster := redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:     c.ClusterAddrs,
+			Username:  c.Username,
+			Password:  password,
+			TLSConfig: tlsConfig,
+
+			MaxRedirects:   c.MaxRedirects,
+			ReadOnly:       c.ReadOnly,
+			RouteByLatency: c.RouteByLatency,
+			RouteRandomly:  c.RouteRandomly,
+
+			DialerRetries:   c.DialerRetries,
+			MaxRetries:      c.MaxRetries,
+			MinRetryBackoff: c.MinRetryBackoff.Duration,
+			MaxRetryBackoff: c.MaxRetryBackoff.Duration,
+			DialTimeout:     c.DialTimeout.Duration,
+			ReadTimeout:     c.ReadTimeout.Duration,
+			WriteTimeout:    c.WriteTimeout.Duration,
+
+			PoolFIFO:        c.PoolFIFO,
+			PoolSize:        c.PoolSize,
+			MinIdleConns:    c.MinIdleConns,
+			ConnMaxLifetime: c.MaxConnAge.Duration,
+			PoolTimeout:     c.PoolTimeout.Duration,
+			ConnMaxIdleTime: c.IdleTimeout.Duration,
+		})
+		addrs := make(map[string]string, len(c.ClusterAddrs))
+		for _, addr := range c.ClusterAddrs {
+			addrs[addr] = addr
+		}
+		MustRegisterClientMetricsCollector(cluster, stats, addrs, c.Username)
+		MustRegisterNodeMetricsCollector(shardPoolStats(cluster), stats, c.clientName(), c.Username)
+		inner = cluster
+	} else {
+		// The failover client asks the Sentinels for the address of the
+		// current primary, and on a failover, closes its connections and
+		// reconnects to the newly promoted primary. Commands in flight during a
+		// failover are retried up to MaxRetries times.
+		failover := redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       c.MasterName,
+			SentinelAddrs:    c.SentinelAddrs,
+			SentinelUsername: c.Username,
+			SentinelPassword: password,
+			Username:         c.Username,
+			Password:         password,
+			TLSConfig:        tlsConfig,
+
+			DialerRetries:   c.DialerRetries,
+			MaxRetries:      c.MaxRetries,
+			MinRetryBackoff: c.MinRetryBackoff.Duration,
+			MaxRetryBackoff: c.MaxRetryBackoff.Duration,
+			DialTimeout:     c.DialTimeout.Duration,
+			ReadTimeout:     c.ReadTimeout.Duration,
+			WriteTimeout:    c.WriteTimeout.Duration,
+
+			PoolFIFO:        c.PoolFIFO,
+			PoolSize:        c.PoolSize,
+			MinIdleConns:    c.MinIdleConns,
+			ConnMaxLifetime: c.MaxConnAge.Duration,
+			PoolTimeout:     c.PoolTimeout.Duration,
+			ConnMaxIdleTime: c.IdleTimeout.Duration,
+		})
+		MustRegisterClientMetricsCollector(failover, stats, map[string]string{c.MasterName: c.MasterName}, c.Username)
+		// A failover client has a single connection pool, which follows the
+		// primary, so it's labeled with the name of the primary rather than
+		// an address.
+		MustRegisterNodeMetricsCollector(func(context.Context) map[string]*redis.PoolStats {
+			return map[string]*redis.PoolStats{c.MasterName: failover.PoolStats()}
+		}, stats, c.clientName(), c.Username)
+		inner = failover
+	}
+
+	err = redisotel.InstrumentTracing(inner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{UniversalClient: inner}, nil
+}
+
+// clientName returns a stable name for the client described by this Config,
+// used to label its per-node metrics: its shard or seed addresses, its SRV
+// lookups, or its Sentinel master name.
+func (c Config) clientName() string {
+	var names []string
+	switch {
+	case len(c.SentinelAddrs) > 0:
+		return c.MasterName
+	case len(c.ClusterAddrs) > 0:
+		names = slices.Clone(c.ClusterAddrs)
+	case len(c.ShardAddrs) > 0:
+		for _, addr := range c.ShardAddrs {
+			names = append(names, addr)
+		}
+	default:
+		for _, l := range c.Lookups {
+			names = append(names, l.Service+"."+l.Domain)
+		}
+	}
+	// Keep the list of names sorted for consistency.
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+// StopLookups stops the goroutine responsible for keeping the shards of an
+// inner Ring up-to-date. It is a no-op for Cluster and Sentinel clients, which
+// discover their topology from Redis itself.
+func (c *Client) StopLookups() {
+	if c == nil {
+		// No-op.
+		return
+	}
+	c.ring.StopLookups()
+}
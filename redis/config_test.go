@@ -0,0 +1,130 @@
+package redis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/letsencrypt/boulder/cmd"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	// Fields required regardless of the connection mode.
+	base := `{"tls": {"caCertFile": "ca.pem", "certFile": "cert.pem", "keyFile": "key.pem"}, "passwordFile": "password", "username": "boulder"`
+
+	testCases := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:   "ring of shards",
+			config: base + `, "shardAddrs": {"shard1": "10.77.77.4:4218"}}`,
+		},
+		{
+			name:   "ring from lookups",
+			config: base + `, "lookups": [{"service": "redisratelimits", "domain": "service.consul"}]}`,
+		},
+		{
+			name:   "cluster",
+			config: base + `, "clusterAddrs": ["10.77.77.4:4218", "10.77.77.5:4218"], "maxRedirects": 5}`,
+		},
+		{
+			name:   "sentinel",
+			config: base + `, "sentinelAddrs": ["10.77.77.4:26379"], "masterName": "ratelimits"}`,
+		},
+		{
+			name:    "no connection mode",
+			config:  base + `}`,
+			wantErr: "'required_without_all'",
+		},
+		{
+			name:    "cluster and shards",
+			config:  base + `, "shardAddrs": {"shard1": "10.77.77.4:4218"}, "clusterAddrs": ["10.77.77.5:4218"]}`,
+			wantErr: "'excluded_with'",
+		},
+		{
+			name:    "sentinel and cluster",
+			config:  base + `, "clusterAddrs": ["10.77.77.5:4218"], "sentinelAddrs": ["10.77.77.4:26379"], "masterName": "ratelimits"}`,
+			wantErr: "'excluded_with'",
+		},
+		{
+			name:    "sentinel without master name",
+			config:  base + `, "sentinelAddrs": ["10.77.77.4:26379"]}`,
+			wantErr: "'required_with'",
+		},
+		{
+			name:    "master name without sentinels",
+			config:  base + `, "clusterAddrs": ["10.77.77.4:4218"], "masterName": "ratelimits"}`,
+			wantErr: "'excluded_without'",
+		},
+		{
+			name:    "cluster address without port",
+			config:  base + `, "clusterAddrs": ["10.77.77.4"]}`,
+			wantErr: "'hostname_port'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := cmd.ValidateJSONConfig(&cmd.ConfigValidator{Config: &Config{}}, strings.NewReader(tc.config))
+			if tc.wantErr == "" {
+				test.AssertNotError(t, err, "config should be valid")
+			} else {
+				test.AssertError(t, err, "config should be invalid")
+				test.AssertContains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewClientFromConfig(t *testing.T) {
+	t.Parallel()
+
+	tlsConfig := cmd.TLSConfig{
+		CACertFile: "../test/certs/ipki/minica.pem",
+		CertFile:   "../test/certs/ipki/localhost/cert.pem",
+		KeyFile:    "../test/certs/ipki/localhost/key.pem",
+	}
+
+	// None of these clients connect to Redis until a command is sent.
+	ring, err := NewClientFromConfig(Config{
+		TLS:        tlsConfig,
+		Username:   "boulder",
+		ShardAddrs: map[string]string{"shard1": "10.77.77.4:4218"},
+	}, metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "creating ring client")
+	defer ring.StopLookups()
+	_, ok := ring.UniversalClient.(*redis.Ring)
+	test.Assert(t, ok, "expected a *redis.Ring")
+
+	cluster, err := NewClientFromConfig(Config{
+		TLS:          tlsConfig,
+		Username:     "boulder",
+		ClusterAddrs: []string{"10.77.77.4:4218"},
+		MaxRedirects: 5,
+	}, metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "creating cluster client")
+	defer cluster.StopLookups()
+	clusterClient, ok := cluster.UniversalClient.(*redis.ClusterClient)
+	test.Assert(t, ok, "expected a *redis.ClusterClient")
+	test.AssertEquals(t, clusterClient.Options().MaxRedirects, 5)
+
+	sentinel, err := NewClientFromConfig(Config{
+		TLS:           tlsConfig,
+		Username:      "boulder",
+		SentinelAddrs: []string{"10.77.77.4:26379"},
+		MasterName:    "ratelimits",
+	}, metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "creating sentinel client")
+	defer sentinel.StopLookups()
+	_, ok = sentinel.UniversalClient.(*redis.Client)
+	test.Assert(t, ok, "expected a *redis.Client")
+}
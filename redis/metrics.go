@@ -1,9 +1,12 @@
 package redis
 
 import (
+	"context"
 	"errors"
 	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
@@ -101,3 +104,122 @@ func MustRegisterClientMetricsCollector(client poolStatGetter, stats prometheus.
 		panic(err)
 	}
 }
+
+// nodePoolStatGetter is satisfied by *redis.Ring and *redis.ClusterClient,
+// both of which can report connection pool stats for each of their nodes, and
+// also by a mock in our tests.
+type nodePoolStatGetter interface {
+	ForEachShard(ctx context.Context, fn func(ctx context.Context, client *redis.Client) error) error
+}
+
+var (
+	_ nodePoolStatGetter = (*redis.Ring)(nil)
+	_ nodePoolStatGetter = (*redis.ClusterClient)(nil)
+)
+
+// nodeMetricsCollector reports connection pool stats for each node of a Redis
+// client, labeled by the node's address. Unlike metricsCollector, the set of
+// nodes it reports on follows topology changes, such as a cluster resharding or
+// a Sentinel failover, without needing to be re-registered.
+type nodeMetricsCollector struct {
+	// nodes returns the current pool stats for each node, keyed by address.
+	nodes func(ctx context.Context) map[string]*redis.PoolStats
+
+	lookups    *prometheus.Desc
+	totalConns *prometheus.Desc
+	idleConns  *prometheus.Desc
+	staleConns *prometheus.Desc
+}
+
+// Describe sends the descriptors of each metric reported by Collect. Collect
+// reports nothing until at least one node is known, so DescribeByCollect can't
+// be used here.
+func (nc nodeMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- nc.lookups
+	ch <- nc.totalConns
+	ch <- nc.idleConns
+	ch <- nc.staleConns
+}
+
+// Collect creates constant metrics for the pool stats of each node on the fly.
+func (nc nodeMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	writeGauge := func(stat *prometheus.Desc, val uint32, labelValues ...string) {
+		ch <- prometheus.MustNewConstMetric(stat, prometheus.GaugeValue, float64(val), labelValues...)
+	}
+
+	// Listing the nodes of a cluster may require fetching its current
+	// topology, which must not hold up a scrape indefinitely.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for node, stats := range nc.nodes(ctx) {
+		writeGauge(nc.lookups, stats.Hits, node, "hit")
+		writeGauge(nc.lookups, stats.Misses, node, "miss")
+		writeGauge(nc.lookups, stats.Timeouts, node, "timeout")
+		writeGauge(nc.totalConns, stats.TotalConns, node)
+		writeGauge(nc.idleConns, stats.IdleConns, node)
+		writeGauge(nc.staleConns, stats.StaleConns, node)
+	}
+}
+
+// newNodeMetricsCollector is broken out for testing purposes.
+func newNodeMetricsCollector(nodes func(ctx context.Context) map[string]*redis.PoolStats, labels prometheus.Labels) nodeMetricsCollector {
+	return nodeMetricsCollector{
+		nodes: nodes,
+		lookups: prometheus.NewDesc(
+			"redis_node_connection_pool_lookups",
+			"Number of lookups for a connection in the pool of each node, labeled by hit/miss",
+			[]string{"node", "result"}, labels),
+		totalConns: prometheus.NewDesc(
+			"redis_node_connection_pool_total_conns",
+			"Number of total connections in the pool of each node.",
+			[]string{"node"}, labels),
+		idleConns: prometheus.NewDesc(
+			"redis_node_connection_pool_idle_conns",
+			"Number of idle connections in the pool of each node.",
+			[]string{"node"}, labels),
+		staleConns: prometheus.NewDesc(
+			"redis_node_connection_pool_stale_conns",
+			"Number of stale connections removed from the pool of each node.",
+			[]string{"node"}, labels),
+	}
+}
+
+// shardPoolStats returns a function which reports the pool stats of each shard
+// of the given client, keyed by address. Shards which can't be listed are
+// omitted.
+func shardPoolStats(client nodePoolStatGetter) func(ctx context.Context) map[string]*redis.PoolStats {
+	return func(ctx context.Context) map[string]*redis.PoolStats {
+		var mu sync.Mutex
+		nodes := make(map[string]*redis.PoolStats)
+		// ForEachShard may call fn concurrently.
+		_ = client.ForEachShard(ctx, func(ctx context.Context, shard *redis.Client) error {
+			mu.Lock()
+			defer mu.Unlock()
+			nodes[shard.Options().Addr] = shard.PoolStats()
+			return nil
+		})
+		return nodes
+	}
+}
+
+// MustRegisterNodeMetricsCollector registers a collector reporting per-node
+// connection pool stats for the given client with the provided
+// prometheus.Registerer. The name identifies the client, for instance by its
+// seed addresses or Sentinel master name. If the collector is already
+// registered, this function is a no-op.
+func MustRegisterNodeMetricsCollector(nodes func(ctx context.Context) map[string]*redis.PoolStats, stats prometheus.Registerer, name string, user string) {
+	labels := prometheus.Labels{
+		"client": name,
+		"user":   user,
+	}
+	err := stats.Register(newNodeMetricsCollector(nodes, labels))
+	if err != nil {
+		_, ok := errors.AsType[prometheus.AlreadyRegisteredError](err)
+		if ok {
+			// The collector is already registered using the same labels.
+			return
+		}
+		panic(err)
+	}
+}
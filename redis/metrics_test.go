@@ -1,6 +1,7 @@
 package redis
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/redis/go-redis/v9"
 
 	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
 )
 
 type mockPoolStatGetter struct{}
@@ -75,3 +77,58 @@ func TestMustRegisterClientMetricsCollector(t *testing.T) {
 	// Registration with different label values should succeed.
 	MustRegisterClientMetricsCollector(client, stats, map[string]string{"f00": "b4r"}, "b4z")
 }
+
+func TestNodeMetrics(t *testing.T) {
+	nodes := map[string]*redis.PoolStats{
+		"10.77.77.4:4218": {TotalConns: 10, IdleConns: 5},
+		"10.77.77.5:4218": {TotalConns: 20, IdleConns: 15},
+	}
+	mets := newNodeMetricsCollector(func(context.Context) map[string]*redis.PoolStats {
+		return nodes
+	}, prometheus.Labels{"client": "cluster", "user": "boulder"})
+
+	stats := prometheus.NewRegistry()
+	stats.MustRegister(mets)
+
+	families, err := stats.Gather()
+	test.AssertNotError(t, err, "gathering metrics")
+
+	totalConns := make(map[string]float64)
+	for _, family := range families {
+		if family.GetName() != "redis_node_connection_pool_total_conns" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "node" {
+					totalConns[l.GetValue()] = m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	test.AssertEquals(t, len(totalConns), 2)
+	test.AssertEquals(t, totalConns["10.77.77.4:4218"], float64(10))
+	test.AssertEquals(t, totalConns["10.77.77.5:4218"], float64(20))
+
+	// A node which disappears, for instance after a failover, is no longer
+	// reported.
+	delete(nodes, "10.77.77.5:4218")
+	families, err = stats.Gather()
+	test.AssertNotError(t, err, "gathering metrics")
+	for _, family := range families {
+		if family.GetName() == "redis_node_connection_pool_total_conns" {
+			test.AssertEquals(t, len(family.GetMetric()), 1)
+		}
+	}
+}
+
+func TestMustRegisterNodeMetricsCollector(t *testing.T) {
+	nodes := func(context.Context) map[string]*redis.PoolStats { return nil }
+	stats := prometheus.NewRegistry()
+	// First registration should succeed.
+	MustRegisterNodeMetricsCollector(nodes, stats, "foo", "baz")
+	// Duplicate registration should succeed.
+	MustRegisterNodeMetricsCollector(nodes, stats, "foo", "baz")
+	// Registration with different label values should succeed.
+	MustRegisterNodeMetricsCollector(nodes, stats, "f00", "b4z")
+}
@@ -262,8 +262,10 @@ func initTables(dbMap *borp.DbMap) {
 	dbMap.AddTableWithName(replacementOrderModel{}, "replacementOrders").SetKeys(true, "ID")
 	dbMap.AddTableWithName(pausedModel{}, "paused")
 	dbMap.AddTableWithName(overrideModel{}, "overrides").SetKeys(false, "limitEnum", "bucketKey")
+	dbMap.AddTableWithName(issuanceEventModel{}, "issuanceEvents").SetKeys(true, "ID")
 
 	// Read-only maps used for selecting subsets of columns.
 	dbMap.AddTableWithName(CertStatusMetadata{}, "certificateStatus")
 	dbMap.AddTableWithName(crlEntryModel{}, "certificateStatus")
+	dbMap.AddTableWithName(overrideWithExpiryModel{}, "overrides")
 }
@@ -253,3 +253,25 @@ ALTER TABLE `orders`
   ADD COLUMN `mtcSerialNumber` bigint(20) unsigned DEFAULT NULL;
 
 ALTER TABLE `authz2` ADD COLUMN `beganProcessing` tinyint(1) NOT NULL DEFAULT 0;
+
+ALTER TABLE `orders` ADD KEY `beganProcessing_created_idx` (`beganProcessing`,`created`);
+
+CREATE TABLE `issuanceEvents` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `created` datetime NOT NULL,
+  `event` mediumblob NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `created_idx` (`created`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+ALTER TABLE `overrides` ADD COLUMN `expiresAt` datetime DEFAULT NULL;
+
+ALTER TABLE `paused` ADD KEY `unpausedAt_idx` (`unpausedAt`);
+
+CREATE TABLE `rateLimitBuckets` (
+  `bucketKey` varchar(255) NOT NULL,
+  `tat` bigint(20) NOT NULL,
+  `expires` datetime NOT NULL,
+  PRIMARY KEY (`bucketKey`),
+  KEY `expires_idx` (`expires`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
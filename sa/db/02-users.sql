@@ -9,6 +9,8 @@ CREATE USER IF NOT EXISTS 'mailer'@'%';
 CREATE USER IF NOT EXISTS 'cert_checker'@'%';
 CREATE USER IF NOT EXISTS 'test_setup'@'%';
 CREATE USER IF NOT EXISTS 'badkeyrevoker'@'%';
+CREATE USER IF NOT EXISTS 'orderrecoverer'@'%';
+CREATE USER IF NOT EXISTS 'janitor'@'%';
 CREATE USER IF NOT EXISTS 'proxysql'@'%';
 
 -- Storage Authority
@@ -76,6 +78,25 @@ GRANT SELECT ON certificateStatus TO 'badkeyrevoker'@'%';
 GRANT SELECT ON precertificates TO 'badkeyrevoker'@'%';
 GRANT SELECT ON registrations TO 'badkeyrevoker'@'%';
 
+-- Order Recoverer
+GRANT SELECT ON orders TO 'orderrecoverer'@'%';
+GRANT SELECT ON issuedNames TO 'orderrecoverer'@'%';
+
+-- Janitor
+GRANT SELECT,DELETE ON authz2 TO 'janitor'@'%';
+GRANT SELECT,DELETE ON orders TO 'janitor'@'%';
+GRANT SELECT,DELETE ON orderFqdnSets TO 'janitor'@'%';
+GRANT SELECT,DELETE ON fqdnSets TO 'janitor'@'%';
+GRANT SELECT,DELETE ON keyHashToSerial TO 'janitor'@'%';
+GRANT SELECT,DELETE ON paused TO 'janitor'@'%';
+GRANT SELECT,DELETE ON replacementOrders TO 'janitor'@'%';
+GRANT SELECT,DELETE ON issuedNames TO 'janitor'@'%';
+GRANT SELECT,DELETE ON certificates TO 'janitor'@'%';
+GRANT SELECT,DELETE ON certificateStatus TO 'janitor'@'%';
+GRANT SELECT,DELETE ON precertificates TO 'janitor'@'%';
+GRANT SELECT,DELETE ON serials TO 'janitor'@'%';
+GRANT SELECT,DELETE ON revokedCertificates TO 'janitor'@'%';
+
 -- ProxySQL --
 GRANT ALL PRIVILEGES ON monitor TO 'proxysql'@'%';
 
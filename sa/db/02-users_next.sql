@@ -9,7 +9,10 @@ CREATE USER IF NOT EXISTS 'mailer'@'%';
 CREATE USER IF NOT EXISTS 'cert_checker'@'%';
 CREATE USER IF NOT EXISTS 'test_setup'@'%';
 CREATE USER IF NOT EXISTS 'badkeyrevoker'@'%';
+CREATE USER IF NOT EXISTS 'orderrecoverer'@'%';
+CREATE USER IF NOT EXISTS 'janitor'@'%';
 CREATE USER IF NOT EXISTS 'proxysql'@'%';
+CREATE USER IF NOT EXISTS 'ratelimits'@'%';
 
 -- Storage Authority
 GRANT SELECT,INSERT ON certificates TO 'sa'@'%';
@@ -29,6 +32,7 @@ GRANT SELECT,INSERT,UPDATE ON crlShards TO 'sa'@'%';
 GRANT SELECT,INSERT,UPDATE ON revokedCertificates TO 'sa'@'%';
 GRANT SELECT,INSERT,UPDATE ON replacementOrders TO 'sa'@'%';
 GRANT SELECT,INSERT,UPDATE ON overrides TO 'sa'@'%';
+GRANT SELECT,INSERT ON issuanceEvents TO 'sa'@'%';
 -- Tests need to be able to remove rows from this table, so DELETE,DROP is necessary.
 GRANT SELECT,INSERT,UPDATE,DELETE,DROP ON paused TO 'sa'@'%';
 
@@ -50,6 +54,7 @@ GRANT SELECT ON revokedCertificates TO 'sa_ro'@'%';
 GRANT SELECT ON replacementOrders TO 'sa_ro'@'%';
 GRANT SELECT ON paused TO 'sa_ro'@'%';
 GRANT SELECT ON overrides TO 'sa_ro'@'%';
+GRANT SELECT ON issuanceEvents TO 'sa_ro'@'%';
 
 -- Revoker Tool
 GRANT SELECT,UPDATE ON registrations TO 'revoker'@'%';
@@ -76,6 +81,29 @@ GRANT SELECT ON keyHashToSerial TO 'badkeyrevoker'@'%';
 GRANT SELECT ON certificateStatus TO 'badkeyrevoker'@'%';
 GRANT SELECT ON precertificates TO 'badkeyrevoker'@'%';
 
+-- Order Recoverer
+GRANT SELECT ON orders TO 'orderrecoverer'@'%';
+GRANT SELECT ON issuedNames TO 'orderrecoverer'@'%';
+
+-- Janitor
+GRANT SELECT,DELETE ON authz2 TO 'janitor'@'%';
+GRANT SELECT,DELETE ON orders TO 'janitor'@'%';
+GRANT SELECT,DELETE ON orderFqdnSets TO 'janitor'@'%';
+GRANT SELECT,DELETE ON fqdnSets TO 'janitor'@'%';
+GRANT SELECT,DELETE ON keyHashToSerial TO 'janitor'@'%';
+GRANT SELECT,DELETE ON paused TO 'janitor'@'%';
+GRANT SELECT,DELETE ON replacementOrders TO 'janitor'@'%';
+GRANT SELECT,DELETE ON issuedNames TO 'janitor'@'%';
+GRANT SELECT,DELETE ON certificates TO 'janitor'@'%';
+GRANT SELECT,DELETE ON certificateStatus TO 'janitor'@'%';
+GRANT SELECT,DELETE ON precertificates TO 'janitor'@'%';
+GRANT SELECT,DELETE ON serials TO 'janitor'@'%';
+GRANT SELECT,DELETE ON revokedCertificates TO 'janitor'@'%';
+GRANT SELECT,DELETE ON issuanceEvents TO 'janitor'@'%';
+
+-- Rate Limits (WFE and RA, when using the database-backed source)
+GRANT SELECT,INSERT,UPDATE,DELETE ON rateLimitBuckets TO 'ratelimits'@'%';
+
 -- ProxySQL --
 GRANT ALL PRIVILEGES ON monitor TO 'proxysql'@'%';
 
@@ -23,6 +23,8 @@ import (
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	berrors "github.com/letsencrypt/boulder/errors"
+	eventspb "github.com/letsencrypt/boulder/events/proto"
+	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
@@ -1394,6 +1396,21 @@ type overrideModel struct {
 	Enabled   bool      `db:"enabled"`
 }
 
+// overrideWithExpiryModel is an overrideModel which also includes the
+// expiresAt column. It is only used when the RateLimitOverrideExpiry feature is
+// enabled, because the column does not exist in all databases.
+type overrideWithExpiryModel struct {
+	LimitEnum int64      `db:"limitEnum"`
+	BucketKey string     `db:"bucketKey"`
+	Comment   string     `db:"comment"`
+	PeriodNS  int64      `db:"periodNS"`
+	Count     int64      `db:"count"`
+	Burst     int64      `db:"burst"`
+	UpdatedAt time.Time  `db:"updatedAt"`
+	Enabled   bool       `db:"enabled"`
+	ExpiresAt *time.Time `db:"expiresAt"`
+}
+
 func overrideModelForPB(pb *sapb.RateLimitOverride, updatedAt time.Time, enabled bool) overrideModel {
 	return overrideModel{
 		LimitEnum: pb.LimitEnum,
@@ -1417,3 +1434,63 @@ func newPBFromOverrideModel(m *overrideModel) *sapb.RateLimitOverride {
 		Burst:     m.Burst,
 	}
 }
+
+func newPBFromOverrideWithExpiryModel(m *overrideWithExpiryModel) *sapb.RateLimitOverride {
+	pb := &sapb.RateLimitOverride{
+		LimitEnum: m.LimitEnum,
+		BucketKey: m.BucketKey,
+		Comment:   m.Comment,
+		Period:    durationpb.New(time.Duration(m.PeriodNS)),
+		Count:     m.Count,
+		Burst:     m.Burst,
+	}
+	if m.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+	}
+	return pb
+}
+
+// issuanceEventModel represents a row in the issuanceEvents table, which acts
+// as a transactional outbox: events are written in the same transaction as the
+// change they describe, and later read in ID order by the event-exporter.
+type issuanceEventModel struct {
+	ID      int64     `db:"id"`
+	Created time.Time `db:"created"`
+	// Event is a serialized eventspb.Event, with its Id and Created fields
+	// unset; those are populated from the columns above when read.
+	Event []byte `db:"event"`
+}
+
+// addIssuanceEvent records the given event in the issuanceEvents table using
+// the provided transaction. It is a no-op unless the WriteIssuanceEvents
+// feature is enabled.
+func addIssuanceEvent(ctx context.Context, tx db.Inserter, created time.Time, event *eventspb.Event) error {
+	if !features.Get().WriteIssuanceEvents {
+		return nil
+	}
+	raw, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshaling issuance event: %w", err)
+	}
+	err = tx.Insert(ctx, &issuanceEventModel{
+		Created: created,
+		Event:   raw,
+	})
+	if err != nil {
+		return fmt.Errorf("inserting issuance event: %w", err)
+	}
+	return nil
+}
+
+// newPBFromIssuanceEventModel unmarshals the event stored in the given row and
+// populates its Id and Created fields.
+func newPBFromIssuanceEventModel(m *issuanceEventModel) (*eventspb.Event, error) {
+	var event eventspb.Event
+	err := proto.Unmarshal(m.Event, &event)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling issuance event %d: %w", m.ID, err)
+	}
+	event.Id = m.ID
+	event.Created = timestamppb.New(m.Created)
+	return &event, nil
+}
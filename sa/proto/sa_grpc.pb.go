@@ -9,6 +9,7 @@ package proto
 import (
 	context "context"
 	proto "github.com/letsencrypt/boulder/core/proto"
+	proto1 "github.com/letsencrypt/boulder/events/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -28,6 +29,7 @@ const (
 	StorageAuthorityReadOnly_GetCertificateStatus_FullMethodName         = "/sa.StorageAuthorityReadOnly/GetCertificateStatus"
 	StorageAuthorityReadOnly_GetOrder_FullMethodName                     = "/sa.StorageAuthorityReadOnly/GetOrder"
 	StorageAuthorityReadOnly_GetOrderForNames_FullMethodName             = "/sa.StorageAuthorityReadOnly/GetOrderForNames"
+	StorageAuthorityReadOnly_GetOrdersByAccount_FullMethodName           = "/sa.StorageAuthorityReadOnly/GetOrdersByAccount"
 	StorageAuthorityReadOnly_GetRegistration_FullMethodName              = "/sa.StorageAuthorityReadOnly/GetRegistration"
 	StorageAuthorityReadOnly_GetRegistrationByKey_FullMethodName         = "/sa.StorageAuthorityReadOnly/GetRegistrationByKey"
 	StorageAuthorityReadOnly_GetRevocationStatus_FullMethodName          = "/sa.StorageAuthorityReadOnly/GetRevocationStatus"
@@ -35,6 +37,7 @@ const (
 	StorageAuthorityReadOnly_GetSerialMetadata_FullMethodName            = "/sa.StorageAuthorityReadOnly/GetSerialMetadata"
 	StorageAuthorityReadOnly_GetSerialsByAccount_FullMethodName          = "/sa.StorageAuthorityReadOnly/GetSerialsByAccount"
 	StorageAuthorityReadOnly_GetSerialsByKey_FullMethodName              = "/sa.StorageAuthorityReadOnly/GetSerialsByKey"
+	StorageAuthorityReadOnly_GetSerialsByName_FullMethodName             = "/sa.StorageAuthorityReadOnly/GetSerialsByName"
 	StorageAuthorityReadOnly_GetValidAuthorizations2_FullMethodName      = "/sa.StorageAuthorityReadOnly/GetValidAuthorizations2"
 	StorageAuthorityReadOnly_GetValidOrderAuthorizations2_FullMethodName = "/sa.StorageAuthorityReadOnly/GetValidOrderAuthorizations2"
 	StorageAuthorityReadOnly_GetOrderAuthorizations_FullMethodName       = "/sa.StorageAuthorityReadOnly/GetOrderAuthorizations"
@@ -47,6 +50,7 @@ const (
 	StorageAuthorityReadOnly_GetPausedIdentifiers_FullMethodName         = "/sa.StorageAuthorityReadOnly/GetPausedIdentifiers"
 	StorageAuthorityReadOnly_GetRateLimitOverride_FullMethodName         = "/sa.StorageAuthorityReadOnly/GetRateLimitOverride"
 	StorageAuthorityReadOnly_GetEnabledRateLimitOverrides_FullMethodName = "/sa.StorageAuthorityReadOnly/GetEnabledRateLimitOverrides"
+	StorageAuthorityReadOnly_GetIssuanceEvents_FullMethodName            = "/sa.StorageAuthorityReadOnly/GetIssuanceEvents"
 )
 
 // StorageAuthorityReadOnlyClient is the client API for StorageAuthorityReadOnly service.
@@ -62,6 +66,7 @@ type StorageAuthorityReadOnlyClient interface {
 	GetCertificateStatus(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.CertificateStatus, error)
 	GetOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*proto.Order, error)
 	GetOrderForNames(ctx context.Context, in *GetOrderForNamesRequest, opts ...grpc.CallOption) (*proto.Order, error)
+	GetOrdersByAccount(ctx context.Context, in *GetOrdersByAccountRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto.Order], error)
 	GetRegistration(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*proto.Registration, error)
 	GetRegistrationByKey(ctx context.Context, in *JSONWebKey, opts ...grpc.CallOption) (*proto.Registration, error)
 	GetRevocationStatus(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*RevocationStatus, error)
@@ -69,6 +74,7 @@ type StorageAuthorityReadOnlyClient interface {
 	GetSerialMetadata(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*SerialMetadata, error)
 	GetSerialsByAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error)
 	GetSerialsByKey(ctx context.Context, in *SPKIHash, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error)
+	GetSerialsByName(ctx context.Context, in *GetSerialsByNameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error)
 	GetValidAuthorizations2(ctx context.Context, in *GetValidAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
 	GetValidOrderAuthorizations2(ctx context.Context, in *GetOrderAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
 	GetOrderAuthorizations(ctx context.Context, in *GetOrderAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
@@ -81,6 +87,7 @@ type StorageAuthorityReadOnlyClient interface {
 	GetPausedIdentifiers(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*Identifiers, error)
 	GetRateLimitOverride(ctx context.Context, in *GetRateLimitOverrideRequest, opts ...grpc.CallOption) (*RateLimitOverrideResponse, error)
 	GetEnabledRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RateLimitOverrideResponse], error)
+	GetIssuanceEvents(ctx context.Context, in *GetIssuanceEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto1.Event], error)
 }
 
 type storageAuthorityReadOnlyClient struct {
@@ -161,6 +168,25 @@ func (c *storageAuthorityReadOnlyClient) GetOrderForNames(ctx context.Context, i
 	return out, nil
 }
 
+func (c *storageAuthorityReadOnlyClient) GetOrdersByAccount(ctx context.Context, in *GetOrdersByAccountRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto.Order], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[0], StorageAuthorityReadOnly_GetOrdersByAccount_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetOrdersByAccountRequest, proto.Order]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthorityReadOnly_GetOrdersByAccountClient = grpc.ServerStreamingClient[proto.Order]
+
 func (c *storageAuthorityReadOnlyClient) GetRegistration(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*proto.Registration, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(proto.Registration)
@@ -193,7 +219,7 @@ func (c *storageAuthorityReadOnlyClient) GetRevocationStatus(ctx context.Context
 
 func (c *storageAuthorityReadOnlyClient) GetRevokedCertsByShard(ctx context.Context, in *GetRevokedCertsByShardRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto.CRLEntry], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[0], StorageAuthorityReadOnly_GetRevokedCertsByShard_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[1], StorageAuthorityReadOnly_GetRevokedCertsByShard_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +248,7 @@ func (c *storageAuthorityReadOnlyClient) GetSerialMetadata(ctx context.Context,
 
 func (c *storageAuthorityReadOnlyClient) GetSerialsByAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[1], StorageAuthorityReadOnly_GetSerialsByAccount_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[2], StorageAuthorityReadOnly_GetSerialsByAccount_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -241,7 +267,7 @@ type StorageAuthorityReadOnly_GetSerialsByAccountClient = grpc.ServerStreamingCl
 
 func (c *storageAuthorityReadOnlyClient) GetSerialsByKey(ctx context.Context, in *SPKIHash, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[2], StorageAuthorityReadOnly_GetSerialsByKey_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[3], StorageAuthorityReadOnly_GetSerialsByKey_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -258,6 +284,25 @@ func (c *storageAuthorityReadOnlyClient) GetSerialsByKey(ctx context.Context, in
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type StorageAuthorityReadOnly_GetSerialsByKeyClient = grpc.ServerStreamingClient[Serial]
 
+func (c *storageAuthorityReadOnlyClient) GetSerialsByName(ctx context.Context, in *GetSerialsByNameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[4], StorageAuthorityReadOnly_GetSerialsByName_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetSerialsByNameRequest, Serial]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthorityReadOnly_GetSerialsByNameClient = grpc.ServerStreamingClient[Serial]
+
 func (c *storageAuthorityReadOnlyClient) GetValidAuthorizations2(ctx context.Context, in *GetValidAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Authorizations)
@@ -330,7 +375,7 @@ func (c *storageAuthorityReadOnlyClient) ReplacementOrderExists(ctx context.Cont
 
 func (c *storageAuthorityReadOnlyClient) SerialsForIncident(ctx context.Context, in *SerialsForIncidentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IncidentSerial], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[3], StorageAuthorityReadOnly_SerialsForIncident_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[5], StorageAuthorityReadOnly_SerialsForIncident_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -379,7 +424,7 @@ func (c *storageAuthorityReadOnlyClient) GetRateLimitOverride(ctx context.Contex
 
 func (c *storageAuthorityReadOnlyClient) GetEnabledRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RateLimitOverrideResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[4], StorageAuthorityReadOnly_GetEnabledRateLimitOverrides_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[6], StorageAuthorityReadOnly_GetEnabledRateLimitOverrides_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -396,6 +441,25 @@ func (c *storageAuthorityReadOnlyClient) GetEnabledRateLimitOverrides(ctx contex
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type StorageAuthorityReadOnly_GetEnabledRateLimitOverridesClient = grpc.ServerStreamingClient[RateLimitOverrideResponse]
 
+func (c *storageAuthorityReadOnlyClient) GetIssuanceEvents(ctx context.Context, in *GetIssuanceEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto1.Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[7], StorageAuthorityReadOnly_GetIssuanceEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetIssuanceEventsRequest, proto1.Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthorityReadOnly_GetIssuanceEventsClient = grpc.ServerStreamingClient[proto1.Event]
+
 // StorageAuthorityReadOnlyServer is the server API for StorageAuthorityReadOnly service.
 // All implementations must embed UnimplementedStorageAuthorityReadOnlyServer
 // for forward compatibility.
@@ -409,6 +473,7 @@ type StorageAuthorityReadOnlyServer interface {
 	GetCertificateStatus(context.Context, *Serial) (*proto.CertificateStatus, error)
 	GetOrder(context.Context, *OrderRequest) (*proto.Order, error)
 	GetOrderForNames(context.Context, *GetOrderForNamesRequest) (*proto.Order, error)
+	GetOrdersByAccount(*GetOrdersByAccountRequest, grpc.ServerStreamingServer[proto.Order]) error
 	GetRegistration(context.Context, *RegistrationID) (*proto.Registration, error)
 	GetRegistrationByKey(context.Context, *JSONWebKey) (*proto.Registration, error)
 	GetRevocationStatus(context.Context, *Serial) (*RevocationStatus, error)
@@ -416,6 +481,7 @@ type StorageAuthorityReadOnlyServer interface {
 	GetSerialMetadata(context.Context, *Serial) (*SerialMetadata, error)
 	GetSerialsByAccount(*RegistrationID, grpc.ServerStreamingServer[Serial]) error
 	GetSerialsByKey(*SPKIHash, grpc.ServerStreamingServer[Serial]) error
+	GetSerialsByName(*GetSerialsByNameRequest, grpc.ServerStreamingServer[Serial]) error
 	GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error)
 	GetValidOrderAuthorizations2(context.Context, *GetOrderAuthorizationsRequest) (*Authorizations, error)
 	GetOrderAuthorizations(context.Context, *GetOrderAuthorizationsRequest) (*Authorizations, error)
@@ -428,6 +494,7 @@ type StorageAuthorityReadOnlyServer interface {
 	GetPausedIdentifiers(context.Context, *RegistrationID) (*Identifiers, error)
 	GetRateLimitOverride(context.Context, *GetRateLimitOverrideRequest) (*RateLimitOverrideResponse, error)
 	GetEnabledRateLimitOverrides(*emptypb.Empty, grpc.ServerStreamingServer[RateLimitOverrideResponse]) error
+	GetIssuanceEvents(*GetIssuanceEventsRequest, grpc.ServerStreamingServer[proto1.Event]) error
 	mustEmbedUnimplementedStorageAuthorityReadOnlyServer()
 }
 
@@ -459,6 +526,9 @@ func (UnimplementedStorageAuthorityReadOnlyServer) GetOrder(context.Context, *Or
 func (UnimplementedStorageAuthorityReadOnlyServer) GetOrderForNames(context.Context, *GetOrderForNamesRequest) (*proto.Order, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderForNames not implemented")
 }
+func (UnimplementedStorageAuthorityReadOnlyServer) GetOrdersByAccount(*GetOrdersByAccountRequest, grpc.ServerStreamingServer[proto.Order]) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrdersByAccount not implemented")
+}
 func (UnimplementedStorageAuthorityReadOnlyServer) GetRegistration(context.Context, *RegistrationID) (*proto.Registration, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRegistration not implemented")
 }
@@ -480,6 +550,9 @@ func (UnimplementedStorageAuthorityReadOnlyServer) GetSerialsByAccount(*Registra
 func (UnimplementedStorageAuthorityReadOnlyServer) GetSerialsByKey(*SPKIHash, grpc.ServerStreamingServer[Serial]) error {
 	return status.Errorf(codes.Unimplemented, "method GetSerialsByKey not implemented")
 }
+func (UnimplementedStorageAuthorityReadOnlyServer) GetSerialsByName(*GetSerialsByNameRequest, grpc.ServerStreamingServer[Serial]) error {
+	return status.Errorf(codes.Unimplemented, "method GetSerialsByName not implemented")
+}
 func (UnimplementedStorageAuthorityReadOnlyServer) GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidAuthorizations2 not implemented")
 }
@@ -516,6 +589,9 @@ func (UnimplementedStorageAuthorityReadOnlyServer) GetRateLimitOverride(context.
 func (UnimplementedStorageAuthorityReadOnlyServer) GetEnabledRateLimitOverrides(*emptypb.Empty, grpc.ServerStreamingServer[RateLimitOverrideResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method GetEnabledRateLimitOverrides not implemented")
 }
+func (UnimplementedStorageAuthorityReadOnlyServer) GetIssuanceEvents(*GetIssuanceEventsRequest, grpc.ServerStreamingServer[proto1.Event]) error {
+	return status.Errorf(codes.Unimplemented, "method GetIssuanceEvents not implemented")
+}
 func (UnimplementedStorageAuthorityReadOnlyServer) mustEmbedUnimplementedStorageAuthorityReadOnlyServer() {
 }
 func (UnimplementedStorageAuthorityReadOnlyServer) testEmbeddedByValue() {}
@@ -664,6 +740,17 @@ func _StorageAuthorityReadOnly_GetOrderForNames_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthorityReadOnly_GetOrdersByAccount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrdersByAccountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityReadOnlyServer).GetOrdersByAccount(m, &grpc.GenericServerStream[GetOrdersByAccountRequest, proto.Order]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthorityReadOnly_GetOrdersByAccountServer = grpc.ServerStreamingServer[proto.Order]
+
 func _StorageAuthorityReadOnly_GetRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegistrationID)
 	if err := dec(in); err != nil {
@@ -769,6 +856,17 @@ func _StorageAuthorityReadOnly_GetSerialsByKey_Handler(srv interface{}, stream g
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type StorageAuthorityReadOnly_GetSerialsByKeyServer = grpc.ServerStreamingServer[Serial]
 
+func _StorageAuthorityReadOnly_GetSerialsByName_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSerialsByNameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityReadOnlyServer).GetSerialsByName(m, &grpc.GenericServerStream[GetSerialsByNameRequest, Serial]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthorityReadOnly_GetSerialsByNameServer = grpc.ServerStreamingServer[Serial]
+
 func _StorageAuthorityReadOnly_GetValidAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidAuthorizationsRequest)
 	if err := dec(in); err != nil {
@@ -971,6 +1069,17 @@ func _StorageAuthorityReadOnly_GetEnabledRateLimitOverrides_Handler(srv interfac
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type StorageAuthorityReadOnly_GetEnabledRateLimitOverridesServer = grpc.ServerStreamingServer[RateLimitOverrideResponse]
 
+func _StorageAuthorityReadOnly_GetIssuanceEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetIssuanceEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityReadOnlyServer).GetIssuanceEvents(m, &grpc.GenericServerStream[GetIssuanceEventsRequest, proto1.Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthorityReadOnly_GetIssuanceEventsServer = grpc.ServerStreamingServer[proto1.Event]
+
 // StorageAuthorityReadOnly_ServiceDesc is the grpc.ServiceDesc for StorageAuthorityReadOnly service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1064,6 +1173,11 @@ var StorageAuthorityReadOnly_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetOrdersByAccount",
+			Handler:       _StorageAuthorityReadOnly_GetOrdersByAccount_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetRevokedCertsByShard",
 			Handler:       _StorageAuthorityReadOnly_GetRevokedCertsByShard_Handler,
@@ -1079,6 +1193,11 @@ var StorageAuthorityReadOnly_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _StorageAuthorityReadOnly_GetSerialsByKey_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetSerialsByName",
+			Handler:       _StorageAuthorityReadOnly_GetSerialsByName_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SerialsForIncident",
 			Handler:       _StorageAuthorityReadOnly_SerialsForIncident_Handler,
@@ -1089,61 +1208,70 @@ var StorageAuthorityReadOnly_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _StorageAuthorityReadOnly_GetEnabledRateLimitOverrides_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetIssuanceEvents",
+			Handler:       _StorageAuthorityReadOnly_GetIssuanceEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "sa.proto",
 }
 
 const (
-	StorageAuthority_FQDNSetTimestampsForWindow_FullMethodName   = "/sa.StorageAuthority/FQDNSetTimestampsForWindow"
-	StorageAuthority_GetAuthorization2_FullMethodName            = "/sa.StorageAuthority/GetAuthorization2"
-	StorageAuthority_GetCertificate_FullMethodName               = "/sa.StorageAuthority/GetCertificate"
-	StorageAuthority_GetLintPrecertificate_FullMethodName        = "/sa.StorageAuthority/GetLintPrecertificate"
-	StorageAuthority_GetCertificateStatus_FullMethodName         = "/sa.StorageAuthority/GetCertificateStatus"
-	StorageAuthority_GetOrder_FullMethodName                     = "/sa.StorageAuthority/GetOrder"
-	StorageAuthority_GetOrderForNames_FullMethodName             = "/sa.StorageAuthority/GetOrderForNames"
-	StorageAuthority_GetRegistration_FullMethodName              = "/sa.StorageAuthority/GetRegistration"
-	StorageAuthority_GetRegistrationByKey_FullMethodName         = "/sa.StorageAuthority/GetRegistrationByKey"
-	StorageAuthority_GetRevocationStatus_FullMethodName          = "/sa.StorageAuthority/GetRevocationStatus"
-	StorageAuthority_GetRevokedCertsByShard_FullMethodName       = "/sa.StorageAuthority/GetRevokedCertsByShard"
-	StorageAuthority_GetSerialMetadata_FullMethodName            = "/sa.StorageAuthority/GetSerialMetadata"
-	StorageAuthority_GetSerialsByAccount_FullMethodName          = "/sa.StorageAuthority/GetSerialsByAccount"
-	StorageAuthority_GetSerialsByKey_FullMethodName              = "/sa.StorageAuthority/GetSerialsByKey"
-	StorageAuthority_GetValidAuthorizations2_FullMethodName      = "/sa.StorageAuthority/GetValidAuthorizations2"
-	StorageAuthority_GetValidOrderAuthorizations2_FullMethodName = "/sa.StorageAuthority/GetValidOrderAuthorizations2"
-	StorageAuthority_GetOrderAuthorizations_FullMethodName       = "/sa.StorageAuthority/GetOrderAuthorizations"
-	StorageAuthority_IncidentsForSerial_FullMethodName           = "/sa.StorageAuthority/IncidentsForSerial"
-	StorageAuthority_KeyBlocked_FullMethodName                   = "/sa.StorageAuthority/KeyBlocked"
-	StorageAuthority_ListIncidents_FullMethodName                = "/sa.StorageAuthority/ListIncidents"
-	StorageAuthority_ReplacementOrderExists_FullMethodName       = "/sa.StorageAuthority/ReplacementOrderExists"
-	StorageAuthority_SerialsForIncident_FullMethodName           = "/sa.StorageAuthority/SerialsForIncident"
-	StorageAuthority_CheckIdentifiersPaused_FullMethodName       = "/sa.StorageAuthority/CheckIdentifiersPaused"
-	StorageAuthority_GetPausedIdentifiers_FullMethodName         = "/sa.StorageAuthority/GetPausedIdentifiers"
-	StorageAuthority_GetRateLimitOverride_FullMethodName         = "/sa.StorageAuthority/GetRateLimitOverride"
-	StorageAuthority_GetEnabledRateLimitOverrides_FullMethodName = "/sa.StorageAuthority/GetEnabledRateLimitOverrides"
-	StorageAuthority_AddBlockedKey_FullMethodName                = "/sa.StorageAuthority/AddBlockedKey"
-	StorageAuthority_AddCertificate_FullMethodName               = "/sa.StorageAuthority/AddCertificate"
-	StorageAuthority_AddPrecertificate_FullMethodName            = "/sa.StorageAuthority/AddPrecertificate"
-	StorageAuthority_AddSerial_FullMethodName                    = "/sa.StorageAuthority/AddSerial"
-	StorageAuthority_DeactivateAuthorization2_FullMethodName     = "/sa.StorageAuthority/DeactivateAuthorization2"
-	StorageAuthority_DeactivateRegistration_FullMethodName       = "/sa.StorageAuthority/DeactivateRegistration"
-	StorageAuthority_FinalizeAuthorization2_FullMethodName       = "/sa.StorageAuthority/FinalizeAuthorization2"
-	StorageAuthority_FinalizeOrder_FullMethodName                = "/sa.StorageAuthority/FinalizeOrder"
-	StorageAuthority_NewOrderAndAuthzs_FullMethodName            = "/sa.StorageAuthority/NewOrderAndAuthzs"
-	StorageAuthority_NewRegistration_FullMethodName              = "/sa.StorageAuthority/NewRegistration"
-	StorageAuthority_RevokeCertificate_FullMethodName            = "/sa.StorageAuthority/RevokeCertificate"
-	StorageAuthority_SetAuthzProcessing_FullMethodName           = "/sa.StorageAuthority/SetAuthzProcessing"
-	StorageAuthority_SetOrderError_FullMethodName                = "/sa.StorageAuthority/SetOrderError"
-	StorageAuthority_SetOrderProcessing_FullMethodName           = "/sa.StorageAuthority/SetOrderProcessing"
-	StorageAuthority_UpdateRegistrationKey_FullMethodName        = "/sa.StorageAuthority/UpdateRegistrationKey"
-	StorageAuthority_UpdateRevokedCertificate_FullMethodName     = "/sa.StorageAuthority/UpdateRevokedCertificate"
-	StorageAuthority_LeaseCRLShard_FullMethodName                = "/sa.StorageAuthority/LeaseCRLShard"
-	StorageAuthority_UpdateCRLShard_FullMethodName               = "/sa.StorageAuthority/UpdateCRLShard"
-	StorageAuthority_PauseIdentifiers_FullMethodName             = "/sa.StorageAuthority/PauseIdentifiers"
-	StorageAuthority_UnpauseAccount_FullMethodName               = "/sa.StorageAuthority/UnpauseAccount"
-	StorageAuthority_AddRateLimitOverride_FullMethodName         = "/sa.StorageAuthority/AddRateLimitOverride"
-	StorageAuthority_DisableRateLimitOverride_FullMethodName     = "/sa.StorageAuthority/DisableRateLimitOverride"
-	StorageAuthority_EnableRateLimitOverride_FullMethodName      = "/sa.StorageAuthority/EnableRateLimitOverride"
-	StorageAuthority_RevokeAuthorizationsFor_FullMethodName      = "/sa.StorageAuthority/RevokeAuthorizationsFor"
+	StorageAuthority_FQDNSetTimestampsForWindow_FullMethodName       = "/sa.StorageAuthority/FQDNSetTimestampsForWindow"
+	StorageAuthority_GetAuthorization2_FullMethodName                = "/sa.StorageAuthority/GetAuthorization2"
+	StorageAuthority_GetCertificate_FullMethodName                   = "/sa.StorageAuthority/GetCertificate"
+	StorageAuthority_GetLintPrecertificate_FullMethodName            = "/sa.StorageAuthority/GetLintPrecertificate"
+	StorageAuthority_GetCertificateStatus_FullMethodName             = "/sa.StorageAuthority/GetCertificateStatus"
+	StorageAuthority_GetOrder_FullMethodName                         = "/sa.StorageAuthority/GetOrder"
+	StorageAuthority_GetOrderForNames_FullMethodName                 = "/sa.StorageAuthority/GetOrderForNames"
+	StorageAuthority_GetOrdersByAccount_FullMethodName               = "/sa.StorageAuthority/GetOrdersByAccount"
+	StorageAuthority_GetRegistration_FullMethodName                  = "/sa.StorageAuthority/GetRegistration"
+	StorageAuthority_GetRegistrationByKey_FullMethodName             = "/sa.StorageAuthority/GetRegistrationByKey"
+	StorageAuthority_GetRevocationStatus_FullMethodName              = "/sa.StorageAuthority/GetRevocationStatus"
+	StorageAuthority_GetRevokedCertsByShard_FullMethodName           = "/sa.StorageAuthority/GetRevokedCertsByShard"
+	StorageAuthority_GetSerialMetadata_FullMethodName                = "/sa.StorageAuthority/GetSerialMetadata"
+	StorageAuthority_GetSerialsByAccount_FullMethodName              = "/sa.StorageAuthority/GetSerialsByAccount"
+	StorageAuthority_GetSerialsByKey_FullMethodName                  = "/sa.StorageAuthority/GetSerialsByKey"
+	StorageAuthority_GetSerialsByName_FullMethodName                 = "/sa.StorageAuthority/GetSerialsByName"
+	StorageAuthority_GetValidAuthorizations2_FullMethodName          = "/sa.StorageAuthority/GetValidAuthorizations2"
+	StorageAuthority_GetValidOrderAuthorizations2_FullMethodName     = "/sa.StorageAuthority/GetValidOrderAuthorizations2"
+	StorageAuthority_GetOrderAuthorizations_FullMethodName           = "/sa.StorageAuthority/GetOrderAuthorizations"
+	StorageAuthority_IncidentsForSerial_FullMethodName               = "/sa.StorageAuthority/IncidentsForSerial"
+	StorageAuthority_KeyBlocked_FullMethodName                       = "/sa.StorageAuthority/KeyBlocked"
+	StorageAuthority_ListIncidents_FullMethodName                    = "/sa.StorageAuthority/ListIncidents"
+	StorageAuthority_ReplacementOrderExists_FullMethodName           = "/sa.StorageAuthority/ReplacementOrderExists"
+	StorageAuthority_SerialsForIncident_FullMethodName               = "/sa.StorageAuthority/SerialsForIncident"
+	StorageAuthority_CheckIdentifiersPaused_FullMethodName           = "/sa.StorageAuthority/CheckIdentifiersPaused"
+	StorageAuthority_GetPausedIdentifiers_FullMethodName             = "/sa.StorageAuthority/GetPausedIdentifiers"
+	StorageAuthority_GetRateLimitOverride_FullMethodName             = "/sa.StorageAuthority/GetRateLimitOverride"
+	StorageAuthority_GetEnabledRateLimitOverrides_FullMethodName     = "/sa.StorageAuthority/GetEnabledRateLimitOverrides"
+	StorageAuthority_GetIssuanceEvents_FullMethodName                = "/sa.StorageAuthority/GetIssuanceEvents"
+	StorageAuthority_AddBlockedKey_FullMethodName                    = "/sa.StorageAuthority/AddBlockedKey"
+	StorageAuthority_AddCertificate_FullMethodName                   = "/sa.StorageAuthority/AddCertificate"
+	StorageAuthority_AddPrecertificate_FullMethodName                = "/sa.StorageAuthority/AddPrecertificate"
+	StorageAuthority_AddSerial_FullMethodName                        = "/sa.StorageAuthority/AddSerial"
+	StorageAuthority_DeactivateAuthorization2_FullMethodName         = "/sa.StorageAuthority/DeactivateAuthorization2"
+	StorageAuthority_DeactivateRegistration_FullMethodName           = "/sa.StorageAuthority/DeactivateRegistration"
+	StorageAuthority_FinalizeAuthorization2_FullMethodName           = "/sa.StorageAuthority/FinalizeAuthorization2"
+	StorageAuthority_FinalizeOrder_FullMethodName                    = "/sa.StorageAuthority/FinalizeOrder"
+	StorageAuthority_NewOrderAndAuthzs_FullMethodName                = "/sa.StorageAuthority/NewOrderAndAuthzs"
+	StorageAuthority_NewRegistration_FullMethodName                  = "/sa.StorageAuthority/NewRegistration"
+	StorageAuthority_RevokeCertificate_FullMethodName                = "/sa.StorageAuthority/RevokeCertificate"
+	StorageAuthority_SetAuthzProcessing_FullMethodName               = "/sa.StorageAuthority/SetAuthzProcessing"
+	StorageAuthority_SetOrderError_FullMethodName                    = "/sa.StorageAuthority/SetOrderError"
+	StorageAuthority_SetOrderProcessing_FullMethodName               = "/sa.StorageAuthority/SetOrderProcessing"
+	StorageAuthority_UpdateRegistrationKey_FullMethodName            = "/sa.StorageAuthority/UpdateRegistrationKey"
+	StorageAuthority_UpdateRevokedCertificate_FullMethodName         = "/sa.StorageAuthority/UpdateRevokedCertificate"
+	StorageAuthority_LeaseCRLShard_FullMethodName                    = "/sa.StorageAuthority/LeaseCRLShard"
+	StorageAuthority_UpdateCRLShard_FullMethodName                   = "/sa.StorageAuthority/UpdateCRLShard"
+	StorageAuthority_PauseIdentifiers_FullMethodName                 = "/sa.StorageAuthority/PauseIdentifiers"
+	StorageAuthority_UnpauseAccount_FullMethodName                   = "/sa.StorageAuthority/UnpauseAccount"
+	StorageAuthority_AddRateLimitOverride_FullMethodName             = "/sa.StorageAuthority/AddRateLimitOverride"
+	StorageAuthority_DisableRateLimitOverride_FullMethodName         = "/sa.StorageAuthority/DisableRateLimitOverride"
+	StorageAuthority_DisableExpiredRateLimitOverrides_FullMethodName = "/sa.StorageAuthority/DisableExpiredRateLimitOverrides"
+	StorageAuthority_EnableRateLimitOverride_FullMethodName          = "/sa.StorageAuthority/EnableRateLimitOverride"
+	StorageAuthority_RevokeAuthorizationsFor_FullMethodName          = "/sa.StorageAuthority/RevokeAuthorizationsFor"
 )
 
 // StorageAuthorityClient is the client API for StorageAuthority service.
@@ -1160,6 +1288,7 @@ type StorageAuthorityClient interface {
 	GetCertificateStatus(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.CertificateStatus, error)
 	GetOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*proto.Order, error)
 	GetOrderForNames(ctx context.Context, in *GetOrderForNamesRequest, opts ...grpc.CallOption) (*proto.Order, error)
+	GetOrdersByAccount(ctx context.Context, in *GetOrdersByAccountRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto.Order], error)
 	GetRegistration(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*proto.Registration, error)
 	GetRegistrationByKey(ctx context.Context, in *JSONWebKey, opts ...grpc.CallOption) (*proto.Registration, error)
 	GetRevocationStatus(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*RevocationStatus, error)
@@ -1167,6 +1296,7 @@ type StorageAuthorityClient interface {
 	GetSerialMetadata(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*SerialMetadata, error)
 	GetSerialsByAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error)
 	GetSerialsByKey(ctx context.Context, in *SPKIHash, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error)
+	GetSerialsByName(ctx context.Context, in *GetSerialsByNameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error)
 	GetValidAuthorizations2(ctx context.Context, in *GetValidAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
 	GetValidOrderAuthorizations2(ctx context.Context, in *GetOrderAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
 	GetOrderAuthorizations(ctx context.Context, in *GetOrderAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
@@ -1179,6 +1309,7 @@ type StorageAuthorityClient interface {
 	GetPausedIdentifiers(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*Identifiers, error)
 	GetRateLimitOverride(ctx context.Context, in *GetRateLimitOverrideRequest, opts ...grpc.CallOption) (*RateLimitOverrideResponse, error)
 	GetEnabledRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RateLimitOverrideResponse], error)
+	GetIssuanceEvents(ctx context.Context, in *GetIssuanceEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto1.Event], error)
 	// Adders
 	AddBlockedKey(ctx context.Context, in *AddBlockedKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddCertificate(ctx context.Context, in *AddCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -1202,6 +1333,7 @@ type StorageAuthorityClient interface {
 	UnpauseAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*Count, error)
 	AddRateLimitOverride(ctx context.Context, in *AddRateLimitOverrideRequest, opts ...grpc.CallOption) (*AddRateLimitOverrideResponse, error)
 	DisableRateLimitOverride(ctx context.Context, in *DisableRateLimitOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DisableExpiredRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DisableExpiredRateLimitOverridesResponse, error)
 	EnableRateLimitOverride(ctx context.Context, in *EnableRateLimitOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RevokeAuthorizationsFor(ctx context.Context, in *RevokeAuthorizationsForRequest, opts ...grpc.CallOption) (*RevokeAuthorizationsForResponse, error)
 }
@@ -1284,6 +1416,25 @@ func (c *storageAuthorityClient) GetOrderForNames(ctx context.Context, in *GetOr
 	return out, nil
 }
 
+func (c *storageAuthorityClient) GetOrdersByAccount(ctx context.Context, in *GetOrdersByAccountRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto.Order], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[0], StorageAuthority_GetOrdersByAccount_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetOrdersByAccountRequest, proto.Order]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthority_GetOrdersByAccountClient = grpc.ServerStreamingClient[proto.Order]
+
 func (c *storageAuthorityClient) GetRegistration(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*proto.Registration, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(proto.Registration)
@@ -1316,7 +1467,7 @@ func (c *storageAuthorityClient) GetRevocationStatus(ctx context.Context, in *Se
 
 func (c *storageAuthorityClient) GetRevokedCertsByShard(ctx context.Context, in *GetRevokedCertsByShardRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto.CRLEntry], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[0], StorageAuthority_GetRevokedCertsByShard_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[1], StorageAuthority_GetRevokedCertsByShard_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1345,7 +1496,7 @@ func (c *storageAuthorityClient) GetSerialMetadata(ctx context.Context, in *Seri
 
 func (c *storageAuthorityClient) GetSerialsByAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[1], StorageAuthority_GetSerialsByAccount_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[2], StorageAuthority_GetSerialsByAccount_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1364,7 +1515,7 @@ type StorageAuthority_GetSerialsByAccountClient = grpc.ServerStreamingClient[Ser
 
 func (c *storageAuthorityClient) GetSerialsByKey(ctx context.Context, in *SPKIHash, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[2], StorageAuthority_GetSerialsByKey_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[3], StorageAuthority_GetSerialsByKey_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1381,6 +1532,25 @@ func (c *storageAuthorityClient) GetSerialsByKey(ctx context.Context, in *SPKIHa
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type StorageAuthority_GetSerialsByKeyClient = grpc.ServerStreamingClient[Serial]
 
+func (c *storageAuthorityClient) GetSerialsByName(ctx context.Context, in *GetSerialsByNameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Serial], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[4], StorageAuthority_GetSerialsByName_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetSerialsByNameRequest, Serial]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthority_GetSerialsByNameClient = grpc.ServerStreamingClient[Serial]
+
 func (c *storageAuthorityClient) GetValidAuthorizations2(ctx context.Context, in *GetValidAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Authorizations)
@@ -1453,7 +1623,7 @@ func (c *storageAuthorityClient) ReplacementOrderExists(ctx context.Context, in
 
 func (c *storageAuthorityClient) SerialsForIncident(ctx context.Context, in *SerialsForIncidentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IncidentSerial], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[3], StorageAuthority_SerialsForIncident_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[5], StorageAuthority_SerialsForIncident_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1502,7 +1672,7 @@ func (c *storageAuthorityClient) GetRateLimitOverride(ctx context.Context, in *G
 
 func (c *storageAuthorityClient) GetEnabledRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RateLimitOverrideResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[4], StorageAuthority_GetEnabledRateLimitOverrides_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[6], StorageAuthority_GetEnabledRateLimitOverrides_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1519,6 +1689,25 @@ func (c *storageAuthorityClient) GetEnabledRateLimitOverrides(ctx context.Contex
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type StorageAuthority_GetEnabledRateLimitOverridesClient = grpc.ServerStreamingClient[RateLimitOverrideResponse]
 
+func (c *storageAuthorityClient) GetIssuanceEvents(ctx context.Context, in *GetIssuanceEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto1.Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[7], StorageAuthority_GetIssuanceEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetIssuanceEventsRequest, proto1.Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthority_GetIssuanceEventsClient = grpc.ServerStreamingClient[proto1.Event]
+
 func (c *storageAuthorityClient) AddBlockedKey(ctx context.Context, in *AddBlockedKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -1739,6 +1928,16 @@ func (c *storageAuthorityClient) DisableRateLimitOverride(ctx context.Context, i
 	return out, nil
 }
 
+func (c *storageAuthorityClient) DisableExpiredRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DisableExpiredRateLimitOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableExpiredRateLimitOverridesResponse)
+	err := c.cc.Invoke(ctx, StorageAuthority_DisableExpiredRateLimitOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) EnableRateLimitOverride(ctx context.Context, in *EnableRateLimitOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -1773,6 +1972,7 @@ type StorageAuthorityServer interface {
 	GetCertificateStatus(context.Context, *Serial) (*proto.CertificateStatus, error)
 	GetOrder(context.Context, *OrderRequest) (*proto.Order, error)
 	GetOrderForNames(context.Context, *GetOrderForNamesRequest) (*proto.Order, error)
+	GetOrdersByAccount(*GetOrdersByAccountRequest, grpc.ServerStreamingServer[proto.Order]) error
 	GetRegistration(context.Context, *RegistrationID) (*proto.Registration, error)
 	GetRegistrationByKey(context.Context, *JSONWebKey) (*proto.Registration, error)
 	GetRevocationStatus(context.Context, *Serial) (*RevocationStatus, error)
@@ -1780,6 +1980,7 @@ type StorageAuthorityServer interface {
 	GetSerialMetadata(context.Context, *Serial) (*SerialMetadata, error)
 	GetSerialsByAccount(*RegistrationID, grpc.ServerStreamingServer[Serial]) error
 	GetSerialsByKey(*SPKIHash, grpc.ServerStreamingServer[Serial]) error
+	GetSerialsByName(*GetSerialsByNameRequest, grpc.ServerStreamingServer[Serial]) error
 	GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error)
 	GetValidOrderAuthorizations2(context.Context, *GetOrderAuthorizationsRequest) (*Authorizations, error)
 	GetOrderAuthorizations(context.Context, *GetOrderAuthorizationsRequest) (*Authorizations, error)
@@ -1792,6 +1993,7 @@ type StorageAuthorityServer interface {
 	GetPausedIdentifiers(context.Context, *RegistrationID) (*Identifiers, error)
 	GetRateLimitOverride(context.Context, *GetRateLimitOverrideRequest) (*RateLimitOverrideResponse, error)
 	GetEnabledRateLimitOverrides(*emptypb.Empty, grpc.ServerStreamingServer[RateLimitOverrideResponse]) error
+	GetIssuanceEvents(*GetIssuanceEventsRequest, grpc.ServerStreamingServer[proto1.Event]) error
 	// Adders
 	AddBlockedKey(context.Context, *AddBlockedKeyRequest) (*emptypb.Empty, error)
 	AddCertificate(context.Context, *AddCertificateRequest) (*emptypb.Empty, error)
@@ -1815,6 +2017,7 @@ type StorageAuthorityServer interface {
 	UnpauseAccount(context.Context, *RegistrationID) (*Count, error)
 	AddRateLimitOverride(context.Context, *AddRateLimitOverrideRequest) (*AddRateLimitOverrideResponse, error)
 	DisableRateLimitOverride(context.Context, *DisableRateLimitOverrideRequest) (*emptypb.Empty, error)
+	DisableExpiredRateLimitOverrides(context.Context, *emptypb.Empty) (*DisableExpiredRateLimitOverridesResponse, error)
 	EnableRateLimitOverride(context.Context, *EnableRateLimitOverrideRequest) (*emptypb.Empty, error)
 	RevokeAuthorizationsFor(context.Context, *RevokeAuthorizationsForRequest) (*RevokeAuthorizationsForResponse, error)
 	mustEmbedUnimplementedStorageAuthorityServer()
@@ -1848,6 +2051,9 @@ func (UnimplementedStorageAuthorityServer) GetOrder(context.Context, *OrderReque
 func (UnimplementedStorageAuthorityServer) GetOrderForNames(context.Context, *GetOrderForNamesRequest) (*proto.Order, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderForNames not implemented")
 }
+func (UnimplementedStorageAuthorityServer) GetOrdersByAccount(*GetOrdersByAccountRequest, grpc.ServerStreamingServer[proto.Order]) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrdersByAccount not implemented")
+}
 func (UnimplementedStorageAuthorityServer) GetRegistration(context.Context, *RegistrationID) (*proto.Registration, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRegistration not implemented")
 }
@@ -1869,6 +2075,9 @@ func (UnimplementedStorageAuthorityServer) GetSerialsByAccount(*RegistrationID,
 func (UnimplementedStorageAuthorityServer) GetSerialsByKey(*SPKIHash, grpc.ServerStreamingServer[Serial]) error {
 	return status.Errorf(codes.Unimplemented, "method GetSerialsByKey not implemented")
 }
+func (UnimplementedStorageAuthorityServer) GetSerialsByName(*GetSerialsByNameRequest, grpc.ServerStreamingServer[Serial]) error {
+	return status.Errorf(codes.Unimplemented, "method GetSerialsByName not implemented")
+}
 func (UnimplementedStorageAuthorityServer) GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidAuthorizations2 not implemented")
 }
@@ -1905,6 +2114,9 @@ func (UnimplementedStorageAuthorityServer) GetRateLimitOverride(context.Context,
 func (UnimplementedStorageAuthorityServer) GetEnabledRateLimitOverrides(*emptypb.Empty, grpc.ServerStreamingServer[RateLimitOverrideResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method GetEnabledRateLimitOverrides not implemented")
 }
+func (UnimplementedStorageAuthorityServer) GetIssuanceEvents(*GetIssuanceEventsRequest, grpc.ServerStreamingServer[proto1.Event]) error {
+	return status.Errorf(codes.Unimplemented, "method GetIssuanceEvents not implemented")
+}
 func (UnimplementedStorageAuthorityServer) AddBlockedKey(context.Context, *AddBlockedKeyRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedKey not implemented")
 }
@@ -1971,6 +2183,9 @@ func (UnimplementedStorageAuthorityServer) AddRateLimitOverride(context.Context,
 func (UnimplementedStorageAuthorityServer) DisableRateLimitOverride(context.Context, *DisableRateLimitOverrideRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisableRateLimitOverride not implemented")
 }
+func (UnimplementedStorageAuthorityServer) DisableExpiredRateLimitOverrides(context.Context, *emptypb.Empty) (*DisableExpiredRateLimitOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExpiredRateLimitOverrides not implemented")
+}
 func (UnimplementedStorageAuthorityServer) EnableRateLimitOverride(context.Context, *EnableRateLimitOverrideRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnableRateLimitOverride not implemented")
 }
@@ -2124,6 +2339,17 @@ func _StorageAuthority_GetOrderForNames_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_GetOrdersByAccount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrdersByAccountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).GetOrdersByAccount(m, &grpc.GenericServerStream[GetOrdersByAccountRequest, proto.Order]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthority_GetOrdersByAccountServer = grpc.ServerStreamingServer[proto.Order]
+
 func _StorageAuthority_GetRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegistrationID)
 	if err := dec(in); err != nil {
@@ -2229,6 +2455,17 @@ func _StorageAuthority_GetSerialsByKey_Handler(srv interface{}, stream grpc.Serv
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type StorageAuthority_GetSerialsByKeyServer = grpc.ServerStreamingServer[Serial]
 
+func _StorageAuthority_GetSerialsByName_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSerialsByNameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).GetSerialsByName(m, &grpc.GenericServerStream[GetSerialsByNameRequest, Serial]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthority_GetSerialsByNameServer = grpc.ServerStreamingServer[Serial]
+
 func _StorageAuthority_GetValidAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidAuthorizationsRequest)
 	if err := dec(in); err != nil {
@@ -2431,6 +2668,17 @@ func _StorageAuthority_GetEnabledRateLimitOverrides_Handler(srv interface{}, str
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type StorageAuthority_GetEnabledRateLimitOverridesServer = grpc.ServerStreamingServer[RateLimitOverrideResponse]
 
+func _StorageAuthority_GetIssuanceEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetIssuanceEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).GetIssuanceEvents(m, &grpc.GenericServerStream[GetIssuanceEventsRequest, proto1.Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthority_GetIssuanceEventsServer = grpc.ServerStreamingServer[proto1.Event]
+
 func _StorageAuthority_AddBlockedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddBlockedKeyRequest)
 	if err := dec(in); err != nil {
@@ -2827,6 +3075,24 @@ func _StorageAuthority_DisableRateLimitOverride_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_DisableExpiredRateLimitOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).DisableExpiredRateLimitOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_DisableExpiredRateLimitOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).DisableExpiredRateLimitOverrides(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_EnableRateLimitOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnableRateLimitOverrideRequest)
 	if err := dec(in); err != nil {
@@ -3042,6 +3308,10 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisableRateLimitOverride",
 			Handler:    _StorageAuthority_DisableRateLimitOverride_Handler,
 		},
+		{
+			MethodName: "DisableExpiredRateLimitOverrides",
+			Handler:    _StorageAuthority_DisableExpiredRateLimitOverrides_Handler,
+		},
 		{
 			MethodName: "EnableRateLimitOverride",
 			Handler:    _StorageAuthority_EnableRateLimitOverride_Handler,
@@ -3052,6 +3322,11 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetOrdersByAccount",
+			Handler:       _StorageAuthority_GetOrdersByAccount_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetRevokedCertsByShard",
 			Handler:       _StorageAuthority_GetRevokedCertsByShard_Handler,
@@ -3067,6 +3342,11 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _StorageAuthority_GetSerialsByKey_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetSerialsByName",
+			Handler:       _StorageAuthority_GetSerialsByName_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SerialsForIncident",
 			Handler:       _StorageAuthority_SerialsForIncident_Handler,
@@ -3077,6 +3357,11 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _StorageAuthority_GetEnabledRateLimitOverrides_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetIssuanceEvents",
+			Handler:       _StorageAuthority_GetIssuanceEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "sa.proto",
 }
@@ -23,6 +23,8 @@ import (
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	berrors "github.com/letsencrypt/boulder/errors"
+	eventspb "github.com/letsencrypt/boulder/events/proto"
+	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
 	blog "github.com/letsencrypt/boulder/log"
@@ -433,6 +435,17 @@ func (ssa *SQLStorageAuthority) DeactivateRegistration(ctx context.Context, req
 			return nil, err
 		}
 
+		err = addIssuanceEvent(ctx, tx, ssa.clk.Now(), &eventspb.Event{
+			Payload: &eventspb.Event_AccountDeactivated{
+				AccountDeactivated: &eventspb.AccountDeactivated{
+					RegistrationID: req.Id,
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
 		return updatedRegistration, nil
 	})
 	if overallError != nil {
@@ -797,6 +810,26 @@ func (ssa *SQLStorageAuthority) FinalizeOrder(ctx context.Context, req *sapb.Fin
 			return nil, err
 		}
 
+		if features.Get().WriteIssuanceEvents {
+			var regID int64
+			err = tx.SelectOne(ctx, &regID, "SELECT registrationID FROM orders WHERE id = ?", req.Id)
+			if err != nil {
+				return nil, fmt.Errorf("selecting registration ID for order %d: %w", req.Id, err)
+			}
+			err = addIssuanceEvent(ctx, tx, ssa.clk.Now(), &eventspb.Event{
+				Payload: &eventspb.Event_OrderFinalized{
+					OrderFinalized: &eventspb.OrderFinalized{
+						OrderID:           req.Id,
+						RegistrationID:    regID,
+						CertificateSerial: req.CertificateSerial,
+					},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		return nil, nil
 	})
 	if overallError != nil {
@@ -928,6 +961,24 @@ func addRevokedCertificate(ctx context.Context, tx db.Executor, req *sapb.Revoke
 	return nil
 }
 
+// newCertificateRevokedEvent builds the issuance event recorded by both
+// RevokeCertificate and UpdateRevokedCertificate. The revokedDate is passed
+// separately because UpdateRevokedCertificate carries the original revocation
+// date in req.Backdate.
+func newCertificateRevokedEvent(req *sapb.RevokeCertificateRequest, revokedDate *timestamppb.Timestamp, reasonUpdated bool) *eventspb.Event {
+	return &eventspb.Event{
+		Payload: &eventspb.Event_CertificateRevoked{
+			CertificateRevoked: &eventspb.CertificateRevoked{
+				Serial:        req.Serial,
+				IssuerID:      req.IssuerID,
+				Reason:        req.Reason,
+				RevokedDate:   revokedDate,
+				ReasonUpdated: reasonUpdated,
+			},
+		},
+	}
+}
+
 // RevokeCertificate stores revocation information about a certificate. It will only store this
 // information if the certificate is not already marked as revoked.
 //
@@ -971,6 +1022,11 @@ func (ssa *SQLStorageAuthority) RevokeCertificate(ctx context.Context, req *sapb
 			return nil, err
 		}
 
+		err = addIssuanceEvent(ctx, tx, ssa.clk.Now(), newCertificateRevokedEvent(req, req.Date, false))
+		if err != nil {
+			return nil, err
+		}
+
 		return nil, nil
 	})
 	if overallError != nil {
@@ -1048,6 +1104,11 @@ func (ssa *SQLStorageAuthority) UpdateRevokedCertificate(ctx context.Context, re
 				req.Serial, req.ShardIdx)
 		}
 
+		err = addIssuanceEvent(ctx, tx, ssa.clk.Now(), newCertificateRevokedEvent(req, req.Backdate, true))
+		if err != nil {
+			return nil, err
+		}
+
 		return nil, nil
 	})
 	if overallError != nil {
@@ -1386,7 +1447,8 @@ func (ssa *SQLStorageAuthority) PauseIdentifiers(ctx context.Context, req *sapb.
 
 	response := &sapb.PauseIdentifiersResponse{}
 	_, err = db.WithTransaction(ctx, ssa.dbMap, func(tx db.Executor) (any, error) {
-		for _, ident := range idents {
+		var newlyPaused []*corepb.Identifier
+		for i, ident := range idents {
 			pauseError := func(op string, err error) error {
 				return fmt.Errorf("while %s identifier %s for registration ID %d: %w",
 					op, ident.Value, req.RegistrationID, err,
@@ -1427,6 +1489,7 @@ func (ssa *SQLStorageAuthority) PauseIdentifiers(ctx context.Context, req *sapb.
 
 				// Identifier successfully paused.
 				response.Paused++
+				newlyPaused = append(newlyPaused, req.Identifiers[i])
 				continue
 
 			case entry.UnpausedAt == nil || entry.PausedAt.After(*entry.UnpausedAt):
@@ -1459,6 +1522,7 @@ func (ssa *SQLStorageAuthority) PauseIdentifiers(ctx context.Context, req *sapb.
 
 				// Identifier successfully repaused.
 				response.Repaused++
+				newlyPaused = append(newlyPaused, req.Identifiers[i])
 				continue
 
 			default:
@@ -1468,6 +1532,20 @@ func (ssa *SQLStorageAuthority) PauseIdentifiers(ctx context.Context, req *sapb.
 				)
 			}
 		}
+
+		if len(newlyPaused) > 0 {
+			err := addIssuanceEvent(ctx, tx, ssa.clk.Now(), &eventspb.Event{
+				Payload: &eventspb.Event_IdentifiersPaused{
+					IdentifiersPaused: &eventspb.IdentifiersPaused{
+						RegistrationID: req.RegistrationID,
+						Identifiers:    newlyPaused,
+					},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
 		return nil, nil
 	})
 	if err != nil {
@@ -1538,6 +1616,13 @@ func overrideLowerThanExisting(new *sapb.RateLimitOverride, existing overrideMod
 // If the override exists but is disabled, it may be updated but will not be
 // re-enabled. The current status is returned in the Enabled field of the
 // response. To re-enable an override, use EnableRateLimitOverride.
+//
+// If the RateLimitOverrideExpiry feature is enabled and ExpiresAt is set, the
+// expiry time of the inserted or updated override is set to ExpiresAt. If
+// ExpiresAt is unset, an inserted override never expires and an updated
+// override keeps its existing expiry time, if any, unless ClearExpiry is set.
+// Without the feature no override can have an expiry, so ClearExpiry is
+// ignored.
 func (ssa *SQLStorageAuthority) AddRateLimitOverride(ctx context.Context, req *sapb.AddRateLimitOverrideRequest) (*sapb.AddRateLimitOverrideResponse, error) {
 	if core.IsAnyNilOrZero(req.Override, req.Override.LimitEnum, req.Override.BucketKey, req.Override.Count, req.Override.Burst, req.Override.Period, req.Override.Comment) {
 		return nil, errIncompleteRequest
@@ -1548,6 +1633,22 @@ func (ssa *SQLStorageAuthority) AddRateLimitOverride(ctx context.Context, req *s
 	var existingOverride *sapb.RateLimitOverride
 	now := ssa.clk.Now()
 
+	var expiresAt *time.Time
+	if req.Override.ExpiresAt != nil {
+		if !features.Get().RateLimitOverrideExpiry {
+			return nil, berrors.InternalServerError("rate limit override expiry is not enabled")
+		}
+		t := req.Override.ExpiresAt.AsTime()
+		if !t.After(now) {
+			return nil, berrors.MalformedError("override expiry %s is not in the future", t)
+		}
+		expiresAt = &t
+	}
+	if req.ClearExpiry && expiresAt != nil {
+		return nil, berrors.MalformedError("cannot both set and clear an override's expiry")
+	}
+	clearExpiry := req.ClearExpiry && features.Get().RateLimitOverrideExpiry
+
 	overrideColumnsList, err := ssa.dbMap.ColumnsForModel(overrideModel{})
 	if err != nil {
 		return nil, fmt.Errorf("getting columns for override model: %w", err)
@@ -1609,6 +1710,24 @@ func (ssa *SQLStorageAuthority) AddRateLimitOverride(ctx context.Context, req *s
 			inserted = false
 			enabled = existing.Enabled
 		}
+
+		if expiresAt != nil || clearExpiry {
+			// A nil expiresAt clears the expiry.
+			_, err = tx.ExecContext(ctx, `
+				UPDATE overrides
+				SET expiresAt = ?
+				WHERE limitEnum = ? AND bucketKey = ?`,
+				expiresAt,
+				req.Override.LimitEnum,
+				req.Override.BucketKey,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("setting expiry of override for rate limit %d and bucket key %s: %w",
+					req.Override.LimitEnum,
+					req.Override.BucketKey,
+					err)
+			}
+		}
 		return nil, nil
 	})
 	if err != nil {
@@ -1740,3 +1859,64 @@ func (ssa *SQLStorageAuthority) EnableRateLimitOverride(ctx context.Context, req
 	}
 	return ssa.setRateLimitOverride(ctx, req.LimitEnum, req.BucketKey, true)
 }
+
+// DisableExpiredRateLimitOverrides disables every enabled rate limit override
+// whose expiry time has passed, and returns the overrides it disabled. It
+// requires the RateLimitOverrideExpiry feature.
+func (ssa *SQLStorageAuthority) DisableExpiredRateLimitOverrides(ctx context.Context, _ *emptypb.Empty) (*sapb.DisableExpiredRateLimitOverridesResponse, error) {
+	if !features.Get().RateLimitOverrideExpiry {
+		return nil, berrors.InternalServerError("rate limit override expiry is not enabled")
+	}
+
+	overrideColumnsList, err := ssa.dbMap.ColumnsForModel(overrideWithExpiryModel{})
+	if err != nil {
+		// This should never happen, the model is registered at init time.
+		return nil, fmt.Errorf("getting columns for override model: %w", err)
+	}
+	overrideColumns := strings.Join(overrideColumnsList, ", ")
+	now := ssa.clk.Now()
+
+	result, err := db.WithTransaction(ctx, ssa.dbMap, func(tx db.Executor) (any, error) {
+		var expired []overrideWithExpiryModel
+		_, err := tx.Select(ctx, &expired,
+			`SELECT `+overrideColumns+` FROM overrides
+			 WHERE enabled = true AND
+			       expiresAt <= ?
+			 FOR UPDATE`,
+			now,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("querying expired overrides: %w", err)
+		}
+
+		disabled := make([]*sapb.RateLimitOverride, 0, len(expired))
+		for _, m := range expired {
+			_, err := tx.ExecContext(ctx, `
+				UPDATE overrides
+				SET enabled = false,
+					updatedAt = ?
+				WHERE limitEnum = ? AND bucketKey = ?`,
+				now,
+				m.LimitEnum,
+				m.BucketKey,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("disabling expired override for rate limit %d and bucket key %s: %w",
+					m.LimitEnum,
+					m.BucketKey,
+					err)
+			}
+			disabled = append(disabled, newPBFromOverrideWithExpiryModel(&m))
+		}
+		return disabled, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	disabled, ok := result.([]*sapb.RateLimitOverride)
+	if !ok {
+		return nil, fmt.Errorf("unexpected casting failure in DisableExpiredRateLimitOverrides")
+	}
+	return &sapb.DisableExpiredRateLimitOverridesResponse{Disabled: disabled}, nil
+}
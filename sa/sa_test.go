@@ -38,6 +38,7 @@ import (
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	berrors "github.com/letsencrypt/boulder/errors"
+	eventspb "github.com/letsencrypt/boulder/events/proto"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
@@ -3838,6 +3839,142 @@ func TestGetSerialsByAccount(t *testing.T) {
 	test.AssertEquals(t, len(seen), 2)
 }
 
+func TestGetSerialsByName(t *testing.T) {
+	sa, fc := initSA(t)
+
+	// Insert issuedNames and certificateStatus rows for five certificates:
+	// "1" for example.com and www.example.com, "2" for www.example.com only,
+	// "3" for example.com but expired, "4" for example.com but revoked, and
+	// "5" for notexample.com, which is not a subdomain of example.com.
+	type cert struct {
+		serial   string
+		names    []string
+		notAfter time.Time
+		status   core.OCSPStatus
+	}
+	certs := []cert{
+		{"1", []string{"example.com", "www.example.com"}, fc.Now().Add(time.Hour), core.OCSPStatusGood},
+		{"2", []string{"www.example.com"}, fc.Now().Add(time.Hour), core.OCSPStatusGood},
+		{"3", []string{"example.com"}, fc.Now().Add(-time.Hour), core.OCSPStatusGood},
+		{"4", []string{"example.com"}, fc.Now().Add(time.Hour), core.OCSPStatusRevoked},
+		{"5", []string{"notexample.com"}, fc.Now().Add(time.Hour), core.OCSPStatusGood},
+	}
+	for _, c := range certs {
+		for _, name := range c.names {
+			err := sa.dbMap.Insert(context.Background(), &issuedNameModel{
+				ReversedName: reverseFQDN(name),
+				NotBefore:    fc.Now().Add(-24 * time.Hour),
+				Serial:       c.serial,
+			})
+			test.AssertNotError(t, err, "inserting test issuedName")
+		}
+		err := sa.dbMap.Insert(context.Background(), &certificateStatusModel{
+			Serial:                c.serial,
+			Status:                c.status,
+			OCSPLastUpdated:       fc.Now(),
+			RevokedDate:           time.Time{},
+			LastExpirationNagSent: time.Time{},
+			NotAfter:              c.notAfter,
+		})
+		test.AssertNotError(t, err, "inserting test certificateStatus")
+	}
+
+	getSerials := func(req *sapb.GetSerialsByNameRequest) []string {
+		res := make(chan *sapb.Serial)
+		stream := &fakeServerStream[sapb.Serial]{output: res}
+		var err error
+		go func() {
+			err = sa.GetSerialsByName(req, stream)
+			close(res) // Let our main test thread continue.
+		}()
+
+		var seen []string
+		for serial := range res {
+			if slices.Contains(seen, serial.Serial) {
+				t.Errorf("Received serial %q more than once", serial.Serial)
+			}
+			seen = append(seen, serial.Serial)
+		}
+		test.AssertNotError(t, err, "calling GetSerialsByName")
+		slices.Sort(seen)
+		return seen
+	}
+
+	after := timestamppb.New(fc.Now().Add(-48 * time.Hour))
+	test.AssertDeepEquals(t, getSerials(&sapb.GetSerialsByNameRequest{DnsName: "example.com", NotBeforeAfter: after}), []string{"1"})
+	test.AssertDeepEquals(t, getSerials(&sapb.GetSerialsByNameRequest{DnsName: "EXAMPLE.com", IncludeSubdomains: true, NotBeforeAfter: after}), []string{"1", "2"})
+	test.AssertDeepEquals(t, getSerials(&sapb.GetSerialsByNameRequest{DnsName: "www.example.com", NotBeforeAfter: after}), []string{"1", "2"})
+	test.AssertEquals(t, len(getSerials(&sapb.GetSerialsByNameRequest{DnsName: "example.net", IncludeSubdomains: true, NotBeforeAfter: after})), 0)
+
+	// Certificates issued before notBeforeAfter aren't considered.
+	test.AssertEquals(t, len(getSerials(&sapb.GetSerialsByNameRequest{DnsName: "example.com", NotBeforeAfter: timestamppb.New(fc.Now().Add(-time.Hour))})), 0)
+
+	err := sa.GetSerialsByName(&sapb.GetSerialsByNameRequest{DnsName: "example.com"}, &fakeServerStream[sapb.Serial]{})
+	test.AssertErrorIs(t, err, errIncompleteRequest)
+}
+
+func TestGetOrdersByAccount(t *testing.T) {
+	sa, fc := initSA(t)
+
+	reg := createWorkingRegistration(t, sa)
+	otherReg := createWorkingRegistration(t, sa)
+
+	newOrder := func(regID int64) int64 {
+		t.Helper()
+		ident := identifier.NewDNS("example.com")
+		authzID := createPendingAuthorization(t, sa, regID, ident, fc.Now().Add(time.Hour))
+		order, err := sa.NewOrderAndAuthzs(context.Background(), &sapb.NewOrderAndAuthzsRequest{
+			NewOrder: &sapb.NewOrderRequest{
+				RegistrationID:   regID,
+				Expires:          timestamppb.New(fc.Now().Add(2 * time.Hour)),
+				Identifiers:      []*corepb.Identifier{ident.ToProto()},
+				V2Authorizations: []int64{authzID},
+			},
+		})
+		test.AssertNotError(t, err, "sa.NewOrderAndAuthzs failed")
+		return order.Id
+	}
+
+	start := fc.Now()
+	var ids []int64
+	for range 3 {
+		fc.Add(time.Minute)
+		ids = append(ids, newOrder(reg.Id))
+	}
+	newOrder(otherReg.Id)
+
+	getOrders := func(req *sapb.GetOrdersByAccountRequest) []int64 {
+		res := make(chan *corepb.Order)
+		stream := &fakeServerStream[corepb.Order]{output: res}
+		var err error
+		go func() {
+			err = sa.GetOrdersByAccount(req, stream)
+			close(res) // Let our main test thread continue.
+		}()
+
+		var got []int64
+		for order := range res {
+			test.AssertEquals(t, order.RegistrationID, reg.Id)
+			got = append(got, order.Id)
+		}
+		test.AssertNotError(t, err, "calling GetOrdersByAccount")
+		return got
+	}
+
+	// Orders are returned most recently created first.
+	got := getOrders(&sapb.GetOrdersByAccountRequest{RegistrationID: reg.Id, CreatedAfter: timestamppb.New(start)})
+	test.AssertDeepEquals(t, got, []int64{ids[2], ids[1], ids[0]})
+
+	got = getOrders(&sapb.GetOrdersByAccountRequest{RegistrationID: reg.Id, CreatedAfter: timestamppb.New(start), Limit: 2})
+	test.AssertDeepEquals(t, got, []int64{ids[2], ids[1]})
+
+	got = getOrders(&sapb.GetOrdersByAccountRequest{RegistrationID: reg.Id, CreatedAfter: timestamppb.New(start.Add(90 * time.Second))})
+	test.AssertDeepEquals(t, got, []int64{ids[2], ids[1]})
+
+	err := sa.GetOrdersByAccount(&sapb.GetOrdersByAccountRequest{RegistrationID: reg.Id}, &fakeServerStream[corepb.Order]{})
+	test.AssertErrorIs(t, err, errIncompleteRequest)
+}
+
 func TestUnpauseAccount(t *testing.T) {
 	sa, _ := initSA(t)
 
@@ -4690,6 +4827,106 @@ func TestGetEnabledRateLimitOverrides(t *testing.T) {
 	test.AssertEquals(t, stream.sent[0].Override.BucketKey, "on")
 }
 
+func TestRateLimitOverrideExpiry(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("TestRateLimitOverrideExpiry requires config-next")
+	}
+
+	sa, fc := initSA(t)
+	features.Set(features.Config{RateLimitOverrideExpiry: true})
+	defer features.Reset()
+
+	makeOverride := func(bucketKey string, expiresAt *timestamppb.Timestamp) *sapb.RateLimitOverride {
+		return &sapb.RateLimitOverride{
+			LimitEnum: 10, BucketKey: bucketKey, Period: durationpb.New(time.Second), Count: 1, Burst: 1, Comment: bucketKey, ExpiresAt: expiresAt,
+		}
+	}
+
+	// An expiry in the past is rejected.
+	_, err := sa.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{Override: makeOverride("past", timestamppb.New(fc.Now().Add(-time.Hour)))})
+	test.AssertErrorIs(t, err, berrors.Malformed)
+
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{Override: makeOverride("forever", nil)})
+	test.AssertNotError(t, err, "expected successful insert of override without expiry")
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{Override: makeOverride("soon", timestamppb.New(fc.Now().Add(time.Hour)))})
+	test.AssertNotError(t, err, "expected successful insert of expiring override")
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{Override: makeOverride("later", timestamppb.New(fc.Now().Add(48*time.Hour)))})
+	test.AssertNotError(t, err, "expected successful insert of expiring override")
+
+	got, err := sa.GetRateLimitOverride(ctx, &sapb.GetRateLimitOverrideRequest{LimitEnum: 10, BucketKey: "soon"})
+	test.AssertNotError(t, err, "expected GetRateLimitOverride to succeed")
+	test.AssertEquals(t, got.Override.ExpiresAt.AsTime(), fc.Now().Add(time.Hour))
+
+	// Nothing has expired yet.
+	resp, err := sa.DisableExpiredRateLimitOverrides(ctx, &emptypb.Empty{})
+	test.AssertNotError(t, err, "expected DisableExpiredRateLimitOverrides to succeed")
+	test.AssertEquals(t, len(resp.Disabled), 0)
+
+	fc.Add(2 * time.Hour)
+
+	// The expired override is no longer returned, even before it is disabled.
+	stream := newMockRLOStream()
+	err = sa.GetEnabledRateLimitOverrides(&emptypb.Empty{}, stream)
+	test.AssertNotError(t, err, "expected streaming enabled overrides to succeed")
+	test.AssertEquals(t, len(stream.sent), 2)
+	for _, r := range stream.sent {
+		test.AssertNotEquals(t, r.Override.BucketKey, "soon")
+	}
+
+	resp, err = sa.DisableExpiredRateLimitOverrides(ctx, &emptypb.Empty{})
+	test.AssertNotError(t, err, "expected DisableExpiredRateLimitOverrides to succeed")
+	test.AssertEquals(t, len(resp.Disabled), 1)
+	test.AssertEquals(t, resp.Disabled[0].BucketKey, "soon")
+
+	got, err = sa.GetRateLimitOverride(ctx, &sapb.GetRateLimitOverrideRequest{LimitEnum: 10, BucketKey: "soon"})
+	test.AssertNotError(t, err, "expected GetRateLimitOverride to succeed")
+	test.Assert(t, !got.Enabled, "expired override should have been disabled")
+
+	// Updating an override without an expiry keeps its existing expiry.
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{Override: makeOverride("later", nil)})
+	test.AssertNotError(t, err, "expected successful update of override")
+	got, err = sa.GetRateLimitOverride(ctx, &sapb.GetRateLimitOverrideRequest{LimitEnum: 10, BucketKey: "later"})
+	test.AssertNotError(t, err, "expected GetRateLimitOverride to succeed")
+	test.AssertNotNil(t, got.Override.ExpiresAt, "expiry should have been kept")
+	test.AssertEquals(t, got.Override.ExpiresAt.AsTime(), fc.Now().Add(46*time.Hour))
+
+	// Updating an override with an expiry replaces its existing expiry.
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{Override: makeOverride("later", timestamppb.New(fc.Now().Add(72*time.Hour)))})
+	test.AssertNotError(t, err, "expected successful update of override")
+	got, err = sa.GetRateLimitOverride(ctx, &sapb.GetRateLimitOverrideRequest{LimitEnum: 10, BucketKey: "later"})
+	test.AssertNotError(t, err, "expected GetRateLimitOverride to succeed")
+	test.AssertEquals(t, got.Override.ExpiresAt.AsTime(), fc.Now().Add(72*time.Hour))
+
+	// An override can't have its expiry both set and cleared.
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{Override: makeOverride("later", timestamppb.New(fc.Now().Add(time.Hour))), ClearExpiry: true})
+	test.AssertErrorIs(t, err, berrors.Malformed)
+
+	// Clearing an override's expiry makes it permanent again.
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{Override: makeOverride("later", nil), ClearExpiry: true})
+	test.AssertNotError(t, err, "expected successful update of override")
+	got, err = sa.GetRateLimitOverride(ctx, &sapb.GetRateLimitOverrideRequest{LimitEnum: 10, BucketKey: "later"})
+	test.AssertNotError(t, err, "expected GetRateLimitOverride to succeed")
+	test.AssertBoxedNil(t, got.Override.ExpiresAt, "expiry should have been cleared")
+}
+
+func TestRateLimitOverrideExpiryDisabled(t *testing.T) {
+	sa, fc := initSA(t)
+
+	_, err := sa.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{Override: &sapb.RateLimitOverride{
+		LimitEnum: 10, BucketKey: "soon", Period: durationpb.New(time.Second), Count: 1, Burst: 1, Comment: "soon", ExpiresAt: timestamppb.New(fc.Now().Add(time.Hour)),
+	}})
+	test.AssertError(t, err, "expected an expiring override to be rejected without RateLimitOverrideExpiry")
+
+	// No override can have an expiry, so clearing one is a no-op.
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.AddRateLimitOverrideRequest{Override: &sapb.RateLimitOverride{
+		LimitEnum: 10, BucketKey: "forever", Period: durationpb.New(time.Second), Count: 1, Burst: 1, Comment: "forever",
+	}, ClearExpiry: true})
+	test.AssertNotError(t, err, "expected clearing an expiry to be ignored without RateLimitOverrideExpiry")
+
+	_, err = sa.DisableExpiredRateLimitOverrides(ctx, &emptypb.Empty{})
+	test.AssertError(t, err, "expected DisableExpiredRateLimitOverrides to fail without RateLimitOverrideExpiry")
+}
+
 func TestOverrideLowerThanExisting(t *testing.T) {
 	t.Parallel()
 
@@ -4765,3 +5002,110 @@ func TestCreateAndFetchRegistrations(t *testing.T) {
 		}
 	}
 }
+
+func TestIssuanceEvents(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("TestIssuanceEvents requires config-next")
+	}
+
+	sa, fc := initSA(t)
+	features.Set(features.Config{WriteIssuanceEvents: true})
+	defer features.Reset()
+
+	reg := createWorkingRegistration(t, sa)
+	serial, testCert := test.ThrowAwayCert(t, fc)
+	_, err := sa.AddSerial(ctx, &sapb.AddSerialRequest{
+		RegID:   reg.Id,
+		Serial:  serial,
+		Created: timestamppb.New(testCert.NotBefore),
+		Expires: timestamppb.New(testCert.NotAfter),
+	})
+	test.AssertNotError(t, err, "failed to add test serial")
+	_, err = sa.AddPrecertificate(ctx, &sapb.AddCertificateRequest{
+		Der:          testCert.Raw,
+		RegID:        reg.Id,
+		Issued:       timestamppb.New(fc.Now()),
+		IssuerNameID: 1,
+	})
+	test.AssertNotError(t, err, "failed to add test precertificate")
+
+	_, err = sa.RevokeCertificate(ctx, &sapb.RevokeCertificateRequest{
+		IssuerID: 1,
+		Serial:   serial,
+		Date:     timestamppb.New(fc.Now()),
+		Reason:   4,
+		ShardIdx: 1,
+	})
+	test.AssertNotError(t, err, "RevokeCertificate failed")
+
+	idents := []*corepb.Identifier{identifier.NewDNS("example.com").ToProto()}
+	_, err = sa.PauseIdentifiers(ctx, &sapb.PauseRequest{RegistrationID: reg.Id, Identifiers: idents})
+	test.AssertNotError(t, err, "PauseIdentifiers failed")
+
+	// Pausing an already-paused identifier should not produce another event.
+	_, err = sa.PauseIdentifiers(ctx, &sapb.PauseRequest{RegistrationID: reg.Id, Identifiers: idents})
+	test.AssertNotError(t, err, "PauseIdentifiers failed")
+
+	_, err = sa.DeactivateRegistration(ctx, &sapb.RegistrationID{Id: reg.Id})
+	test.AssertNotError(t, err, "DeactivateRegistration failed")
+
+	getEvents := func(createdAfter time.Time, afterID int64, createdBefore time.Time, limit int64) []*eventspb.Event {
+		t.Helper()
+		output := make(chan *eventspb.Event)
+		var err error
+		go func() {
+			err = sa.GetIssuanceEvents(&sapb.GetIssuanceEventsRequest{
+				CreatedAfter:  timestamppb.New(createdAfter),
+				AfterID:       afterID,
+				CreatedBefore: timestamppb.New(createdBefore),
+				Limit:         limit,
+			}, &fakeServerStream[eventspb.Event]{output: output})
+			close(output)
+		}()
+		var events []*eventspb.Event
+		for event := range output {
+			events = append(events, event)
+		}
+		test.AssertNotError(t, err, "GetIssuanceEvents failed")
+		return events
+	}
+
+	events := getEvents(fc.Now().Add(-time.Second), 0, fc.Now().Add(time.Second), 10)
+	test.AssertEquals(t, len(events), 3)
+	revoked := events[0].GetCertificateRevoked()
+	test.AssertNotNil(t, revoked, "first event should be a revocation")
+	test.AssertEquals(t, revoked.Serial, serial)
+	test.AssertEquals(t, revoked.Reason, int64(4))
+	test.Assert(t, !revoked.ReasonUpdated, "reason should not be marked as updated")
+	paused := events[1].GetIdentifiersPaused()
+	test.AssertNotNil(t, paused, "second event should be a pause")
+	test.AssertEquals(t, paused.RegistrationID, reg.Id)
+	test.AssertEquals(t, len(paused.Identifiers), 1)
+	deactivated := events[2].GetAccountDeactivated()
+	test.AssertNotNil(t, deactivated, "third event should be a deactivation")
+	test.AssertEquals(t, deactivated.RegistrationID, reg.Id)
+	for _, event := range events {
+		test.Assert(t, event.Id > 0, "event ID should be populated")
+		test.AssertEquals(t, event.Created.AsTime(), fc.Now())
+	}
+
+	// Resuming after the first event should return only the remaining two.
+	events = getEvents(fc.Now(), events[0].Id, fc.Now().Add(time.Second), 10)
+	test.AssertEquals(t, len(events), 2)
+	test.AssertNotNil(t, events[0].GetIdentifiersPaused(), "first event should be a pause")
+
+	// Events created at createdAfter are included when afterID is zero, but
+	// not those created before it.
+	events = getEvents(fc.Now(), 0, fc.Now().Add(time.Second), 10)
+	test.AssertEquals(t, len(events), 3)
+	events = getEvents(fc.Now().Add(time.Second), 0, fc.Now().Add(2*time.Second), 10)
+	test.AssertEquals(t, len(events), 0)
+
+	// The limit should be respected.
+	events = getEvents(fc.Now().Add(-time.Second), 0, fc.Now().Add(time.Second), 1)
+	test.AssertEquals(t, len(events), 1)
+
+	// Events recorded at or after createdBefore should not be returned.
+	events = getEvents(fc.Now().Add(-time.Second), 0, fc.Now(), 10)
+	test.AssertEquals(t, len(events), 0)
+}
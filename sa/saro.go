@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,6 +23,8 @@ import (
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	berrors "github.com/letsencrypt/boulder/errors"
+	eventspb "github.com/letsencrypt/boulder/events/proto"
+	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/identifier"
 	blog "github.com/letsencrypt/boulder/log"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
@@ -951,6 +954,136 @@ func (ssa *SQLStorageAuthorityRO) GetSerialsByAccount(req *sapb.RegistrationID,
 	})
 }
 
+// GetSerialsByName returns a stream of serials for all unexpired, unrevoked
+// certificates containing the given DNS name and, if includeSubdomains is set,
+// any of its subdomains. Matching serials are found using the reversedName
+// index of the issuedNames table, bounded by notBeforeAfter, then filtered by
+// their certificateStatus. This is useful for revoking every certificate for a
+// domain upon request.
+func (ssa *SQLStorageAuthorityRO) GetSerialsByName(req *sapb.GetSerialsByNameRequest, stream grpc.ServerStreamingServer[sapb.Serial]) error {
+	if core.IsAnyNilOrZero(req.DnsName, req.NotBeforeAfter) {
+		return errIncompleteRequest
+	}
+
+	reversedName := reverseFQDN(strings.ToLower(req.DnsName))
+	now := ssa.clk.Now()
+
+	clauses := `
+		WHERE reversedName = ?
+		AND notBefore > ?`
+	params := []any{reversedName, req.NotBeforeAfter.AsTime()}
+	if req.IncludeSubdomains {
+		// Subdomains share the reversed name as a prefix, so this is a range
+		// scan over the same index. The LIKE wildcards must be escaped in the
+		// name itself.
+		clauses = `
+			WHERE (reversedName = ? OR reversedName LIKE ?)
+			AND notBefore > ?`
+		escaper := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+		params = []any{reversedName, escaper.Replace(reversedName) + ".%", req.NotBeforeAfter.AsTime()}
+	}
+
+	nameSelector, err := db.NewMappedSelector[issuedNameModel](ssa.dbReadOnlyMap)
+	if err != nil {
+		return fmt.Errorf("initializing db map: %w", err)
+	}
+
+	rows, err := nameSelector.QueryContext(stream.Context(), clauses, params...)
+	if err != nil {
+		return fmt.Errorf("reading db: %w", err)
+	}
+
+	// A certificate appears once for each of its names, so with subdomains
+	// included the same serial may be seen more than once.
+	seen := make(map[string]bool)
+	var serials []string
+	err = rows.ForEach(func(row *issuedNameModel) error {
+		if !seen[row.Serial] {
+			seen[row.Serial] = true
+			serials = append(serials, row.Serial)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("reading db: %w", err)
+	}
+
+	statusSelector, err := db.NewMappedSelector[CertStatusMetadata](ssa.dbReadOnlyMap)
+	if err != nil {
+		return fmt.Errorf("initializing db map: %w", err)
+	}
+
+	for batch := range slices.Chunk(serials, 1000) {
+		params := make([]any, 0, len(batch)+2)
+		for _, serial := range batch {
+			params = append(params, serial)
+		}
+		params = append(params, now, string(core.OCSPStatusRevoked))
+
+		rows, err := statusSelector.QueryContext(stream.Context(), fmt.Sprintf(`
+			WHERE serial IN (%s)
+			AND notAfter > ?
+			AND status != ?`, db.QuestionMarks(len(batch))), params...)
+		if err != nil {
+			return fmt.Errorf("reading db: %w", err)
+		}
+
+		err = rows.ForEach(func(row *CertStatusMetadata) error {
+			return stream.Send(&sapb.Serial{Serial: row.Serial})
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// maxRecentOrders bounds the number of orders returned by GetOrdersByAccount.
+const maxRecentOrders = 1000
+
+// GetOrdersByAccount returns a stream of the orders created by the given
+// account after the given time, most recently created first. The orders'
+// identifiers and statuses are not populated, since computing them requires
+// fetching their authorizations; use GetOrder for those.
+func (ssa *SQLStorageAuthorityRO) GetOrdersByAccount(req *sapb.GetOrdersByAccountRequest, stream grpc.ServerStreamingServer[corepb.Order]) error {
+	if core.IsAnyNilOrZero(req.RegistrationID, req.CreatedAfter) {
+		return errIncompleteRequest
+	}
+
+	limit := req.Limit
+	if limit <= 0 || limit > maxRecentOrders {
+		limit = maxRecentOrders
+	}
+
+	selector, err := db.NewMappedSelector[orderModel](ssa.dbReadOnlyMap)
+	if err != nil {
+		return fmt.Errorf("initializing db map: %w", err)
+	}
+
+	rows, err := selector.QueryContext(
+		stream.Context(),
+		`WHERE registrationID = ?
+		AND created > ?
+		ORDER BY created DESC
+		LIMIT ?`,
+		req.RegistrationID,
+		req.CreatedAfter.AsTime(),
+		limit,
+	)
+	if err != nil {
+		return fmt.Errorf("reading db: %w", err)
+	}
+
+	return rows.ForEach(func(row *orderModel) error {
+		order, err := modelToOrder(row)
+		if err != nil {
+			return err
+		}
+		return stream.Send(order)
+	})
+}
+
 // CheckIdentifiersPaused takes a slice of identifiers and returns a slice of
 // the first 15 identifier values which are currently paused for the provided
 // account. If no matches are found, an empty slice is returned.
@@ -1051,6 +1184,10 @@ func (ssa *SQLStorageAuthorityRO) GetRateLimitOverride(ctx context.Context, req
 		return nil, errIncompleteRequest
 	}
 
+	if features.Get().RateLimitOverrideExpiry {
+		return ssa.getRateLimitOverrideWithExpiry(ctx, req)
+	}
+
 	obj, err := ssa.dbReadOnlyMap.Get(ctx, overrideModel{}, req.LimitEnum, req.BucketKey)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, berrors.NotFoundError(
@@ -1071,10 +1208,67 @@ func (ssa *SQLStorageAuthorityRO) GetRateLimitOverride(ctx context.Context, req
 	}, nil
 }
 
+// getRateLimitOverrideWithExpiry is the implementation of GetRateLimitOverride
+// used when the RateLimitOverrideExpiry feature is enabled.
+func (ssa *SQLStorageAuthorityRO) getRateLimitOverrideWithExpiry(ctx context.Context, req *sapb.GetRateLimitOverrideRequest) (*sapb.RateLimitOverrideResponse, error) {
+	overrideColumnsList, err := ssa.dbReadOnlyMap.ColumnsForModel(overrideWithExpiryModel{})
+	if err != nil {
+		// This should never happen, the model is registered at init time.
+		return nil, fmt.Errorf("getting columns for override model: %w", err)
+	}
+
+	var row overrideWithExpiryModel
+	err = ssa.dbReadOnlyMap.SelectOne(ctx, &row,
+		`SELECT `+strings.Join(overrideColumnsList, ", ")+` FROM overrides
+		 WHERE limitEnum = ? AND
+		       bucketKey = ?`,
+		req.LimitEnum,
+		req.BucketKey,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, berrors.NotFoundError(
+			"no rate limit override found for limit %d and bucket key %s",
+			req.LimitEnum,
+			req.BucketKey,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &sapb.RateLimitOverrideResponse{
+		Override:  newPBFromOverrideWithExpiryModel(&row),
+		Enabled:   row.Enabled,
+		UpdatedAt: timestamppb.New(row.UpdatedAt),
+	}, nil
+}
+
 // GetEnabledRateLimitOverrides retrieves all enabled rate limit overrides from
 // the database. The results are returned as a stream. If no enabled overrides
-// are found, an empty stream is returned.
+// are found, an empty stream is returned. If the RateLimitOverrideExpiry
+// feature is enabled, expired overrides are omitted even if they have not yet
+// been disabled.
 func (ssa *SQLStorageAuthorityRO) GetEnabledRateLimitOverrides(_ *emptypb.Empty, stream sapb.StorageAuthorityReadOnly_GetEnabledRateLimitOverridesServer) error {
+	if features.Get().RateLimitOverrideExpiry {
+		selector, err := db.NewMappedSelector[overrideWithExpiryModel](ssa.dbReadOnlyMap)
+		if err != nil {
+			return fmt.Errorf("initializing selector: %w", err)
+		}
+
+		rows, err := selector.QueryContext(stream.Context(), "WHERE enabled = true AND (expiresAt IS NULL OR expiresAt > ?)", ssa.clk.Now())
+		if err != nil {
+			return fmt.Errorf("querying enabled overrides: %w", err)
+		}
+
+		return rows.ForEach(func(m *overrideWithExpiryModel) error {
+			return stream.Send(&sapb.RateLimitOverrideResponse{
+				Override:  newPBFromOverrideWithExpiryModel(m),
+				Enabled:   m.Enabled,
+				UpdatedAt: timestamppb.New(m.UpdatedAt),
+			})
+		})
+	}
+
 	selector, err := db.NewMappedSelector[overrideModel](ssa.dbReadOnlyMap)
 	if err != nil {
 		return fmt.Errorf("initializing selector: %w", err)
@@ -1093,3 +1287,42 @@ func (ssa *SQLStorageAuthorityRO) GetEnabledRateLimitOverrides(_ *emptypb.Empty,
 		})
 	})
 }
+
+// GetIssuanceEvents returns, in order of creation time and then ID, up to
+// req.Limit events from the issuanceEvents table which were recorded before
+// req.CreatedBefore, starting after the event created at req.CreatedAfter with
+// ID req.AfterID.
+//
+// IDs are unique, but aren't allocated in order of creation, or of commit, so
+// callers must not treat them as a position in the table.
+func (ssa *SQLStorageAuthorityRO) GetIssuanceEvents(req *sapb.GetIssuanceEventsRequest, stream grpc.ServerStreamingServer[eventspb.Event]) error {
+	if core.IsAnyNilOrZero(req.CreatedBefore, req.Limit) {
+		return errIncompleteRequest
+	}
+
+	selector, err := db.NewMappedSelector[issuanceEventModel](ssa.dbReadOnlyMap)
+	if err != nil {
+		return fmt.Errorf("initializing selector: %w", err)
+	}
+
+	rows, err := selector.QueryContext(
+		stream.Context(),
+		"WHERE (created > ? OR (created = ? AND id > ?)) AND created < ? ORDER BY created, id LIMIT ?",
+		req.CreatedAfter.AsTime(),
+		req.CreatedAfter.AsTime(),
+		req.AfterID,
+		req.CreatedBefore.AsTime(),
+		req.Limit,
+	)
+	if err != nil {
+		return fmt.Errorf("querying issuance events: %w", err)
+	}
+
+	return rows.ForEach(func(m *issuanceEventModel) error {
+		event, err := newPBFromIssuanceEventModel(m)
+		if err != nil {
+			return err
+		}
+		return stream.Send(event)
+	})
+}
@@ -30,6 +30,10 @@ const (
 	// Meta fields (not user-entered)
 	ReviewStatusFieldName = "reviewStatus"
 	RateLimitFieldName    = "rateLimit"
+	// DurationFieldName is optionally set by support staff when approving a
+	// request, to a Go duration string (e.g. "720h") after which the override
+	// expires. If unset, the override never expires.
+	DurationFieldName = "duration"
 
 	// Shared user-entered fields (UI + API/Zendesk)
 	OrganizationFieldName     = "organization"
@@ -11,6 +11,7 @@ import (
 
 	"github.com/jmhodges/clock"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/letsencrypt/boulder/identifier"
 	blog "github.com/letsencrypt/boulder/log"
@@ -242,6 +243,24 @@ func makeAddOverrideRequest(rateLimitFieldValue string, fields map[string]string
 	return req, accountDomainOrIP, nil
 }
 
+// overrideExpiry returns the expiry time of an override approved at the given
+// time, based on the optional duration field of its ticket. It returns nil if
+// the duration field is unset, meaning the override never expires.
+func overrideExpiry(fields map[string]string, now time.Time) (*timestamppb.Timestamp, error) {
+	val := fields[DurationFieldName]
+	if val == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		return nil, fmt.Errorf("parsing duration: %w", err)
+	}
+	if d <= 0 {
+		return nil, fmt.Errorf("duration %s is not positive", d)
+	}
+	return timestamppb.New(now.Add(d)), nil
+}
+
 func (im *OverridesImporter) processTicket(ctx context.Context, ticketID int64, fields map[string]string) error {
 	req, accountDomainOrIP, err := makeAddOverrideRequest(fields[RateLimitFieldName], fields)
 	if err != nil {
@@ -249,6 +268,15 @@ func (im *OverridesImporter) processTicket(ctx context.Context, ticketID int64,
 		im.transitionToPendingWithComment(ticketID, err.Error())
 		return fmt.Errorf("preparing override request: %w", err)
 	}
+	req.Override.ExpiresAt, err = overrideExpiry(fields, im.clk.Now())
+	if err != nil {
+		// Move to "pending" so the next tick won't comment again.
+		im.transitionToPendingWithComment(ticketID, err.Error())
+		return fmt.Errorf("preparing override request: %w", err)
+	}
+	// An approved ticket without a duration is for a permanent override, even
+	// if it replaces one which was due to expire.
+	req.ClearExpiry = req.Override.ExpiresAt == nil
 
 	resp, err := im.ra.AddRateLimitOverride(ctx, req)
 	if err != nil {
@@ -296,6 +324,10 @@ ignore the request entirely.`,
 			"Please allow up to 30 minutes for this change to take effect.",
 		rateLimit, accountDomainOrIP, req.Override.Count,
 	)
+	if req.Override.ExpiresAt != nil {
+		successCommentBody += fmt.Sprintf(" This override will expire on %s.",
+			req.Override.ExpiresAt.AsTime().Format("2006-01-02 15:04 MST"))
+	}
 
 	err = im.zendesk.UpdateTicketStatus(ticketID, "solved", successCommentBody, true)
 	if err != nil {
@@ -154,6 +154,7 @@ func TestOverridesImporterProcessTicketHappyPath(t *testing.T) {
 		expectCount                int64
 		expectPeriod               time.Duration
 		expectOrgComment           string
+		expectExpiry               time.Duration
 		expectLastCommentSubstring string
 	}{
 		{
@@ -173,6 +174,25 @@ func TestOverridesImporterProcessTicketHappyPath(t *testing.T) {
 			expectOrgComment:           "Acme Corp",
 			expectLastCommentSubstring: "has been approved. Your new limit is 1000 per period",
 		},
+		{
+			name: "NewOrdersPerAccount with duration",
+			fields: map[string]string{
+				RateLimitFieldName:    rl.NewOrdersPerAccount.String(),
+				TierFieldName:         "1000",
+				OrganizationFieldName: "Acme Corp",
+				AccountURIFieldName:   "https://acme-v02.api.letsencrypt.org/acme/acct/12345",
+				DurationFieldName:     "720h",
+			},
+			expectLimit:                rl.NewOrdersPerAccount,
+			expectBucketKey:            "3:12345",
+			expectTier:                 1000,
+			expectBurst:                1000,
+			expectCount:                1000,
+			expectPeriod:               7 * 24 * time.Hour,
+			expectOrgComment:           "Acme Corp",
+			expectExpiry:               720 * time.Hour,
+			expectLastCommentSubstring: "This override will expire on",
+		},
 		{
 			name: "CertificatesPerDomain with valid Registered Domain",
 			fields: map[string]string{
@@ -281,6 +301,22 @@ func TestOverridesImporterProcessTicketHappyPath(t *testing.T) {
 			if req.Override.BucketKey != tc.expectBucketKey {
 				t.Errorf("got rapb.AddRateLimitOverrideRequest.BucketKey=%q, expected %q", req.Override.BucketKey, tc.expectBucketKey)
 			}
+			if tc.expectExpiry == 0 {
+				if req.Override.ExpiresAt != nil {
+					t.Errorf("got rapb.AddRateLimitOverrideRequest.ExpiresAt=%s, expected none", req.Override.ExpiresAt.AsTime())
+				}
+				if !req.ClearExpiry {
+					t.Errorf("got rapb.AddRateLimitOverrideRequest.ClearExpiry=false, expected true")
+				}
+			} else {
+				if req.ClearExpiry {
+					t.Errorf("got rapb.AddRateLimitOverrideRequest.ClearExpiry=true, expected false")
+				}
+				gotExpiry := time.Until(req.Override.ExpiresAt.AsTime())
+				if gotExpiry > tc.expectExpiry || gotExpiry < tc.expectExpiry-time.Minute {
+					t.Errorf("got rapb.AddRateLimitOverrideRequest.ExpiresAt %s from now, expected %s", gotExpiry, tc.expectExpiry)
+				}
+			}
 
 			got, ok := zdServer.GetTicket(ticketID)
 			if !ok {
@@ -370,6 +406,20 @@ func TestOverridesImporterProcessTicketSadPath(t *testing.T) {
 			expectStatus:               "pending",
 			expectLastCommentSubstring: "getting/validating ipAddress",
 		},
+		{
+			name: "invalid duration",
+			tickerFields: map[string]string{
+				RateLimitFieldName:    rl.NewOrdersPerAccount.String(),
+				TierFieldName:         "1000",
+				OrganizationFieldName: "Acme Corp",
+				AccountURIFieldName:   "https://acme-v02.api.letsencrypt.org/acme/acct/12345",
+				DurationFieldName:     "30 days",
+			},
+			raFakeBehavior:             ok,
+			expectErrSubstring:         "parsing duration",
+			expectStatus:               "pending",
+			expectLastCommentSubstring: "parsing duration",
+		},
 		{
 			name: "RA administratively disabled",
 			tickerFields: map[string]string{
@@ -0,0 +1,141 @@
+package sfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	blog "github.com/letsencrypt/boulder/log"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	rl "github.com/letsencrypt/boulder/ratelimits"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// overridesSweeperRA is the subset of the RA's gRPC client used by the
+// OverridesSweeper.
+type overridesSweeperRA interface {
+	DisableExpiredRateLimitOverrides(ctx context.Context, req *emptypb.Empty, opts ...grpc.CallOption) (*rapb.DisableExpiredRateLimitOverridesResponse, error)
+}
+
+// overridesSweeperSA is the subset of the SA's gRPC client used by the
+// OverridesSweeper.
+type overridesSweeperSA interface {
+	GetEnabledRateLimitOverrides(ctx context.Context, req *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[sapb.RateLimitOverrideResponse], error)
+}
+
+// OverridesSweeper periodically disables rate limit overrides whose expiry time
+// has passed, and reports enabled overrides which will expire soon so that
+// support staff can follow up with the subscriber before they do.
+type OverridesSweeper struct {
+	interval     time.Duration
+	expiringSoon time.Duration
+
+	ra overridesSweeperRA
+	sa overridesSweeperSA
+
+	overridesDisabled prometheus.Counter
+	overridesExpiring prometheus.Gauge
+
+	clk clock.Clock
+	log blog.Logger
+}
+
+// NewOverridesSweeper creates a new OverridesSweeper which runs at the given
+// interval and reports overrides expiring within expiringSoon. An error is
+// returned if the interval is left unspecified.
+func NewOverridesSweeper(interval, expiringSoon time.Duration, ra overridesSweeperRA, sa overridesSweeperSA, stats prometheus.Registerer, clk clock.Clock, log blog.Logger) (*OverridesSweeper, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("interval cannot be 0")
+	}
+
+	overridesDisabled := promauto.With(stats).NewCounter(prometheus.CounterOpts{
+		Name: "overrides_sweeper_disabled",
+		Help: "Total number of expired rate limit overrides disabled by the overrides sweeper",
+	})
+
+	overridesExpiring := promauto.With(stats).NewGauge(prometheus.GaugeOpts{
+		Name: "overrides_sweeper_expiring_soon",
+		Help: "Number of enabled rate limit overrides which will expire soon, as of the last sweep",
+	})
+
+	return &OverridesSweeper{
+		interval:          interval,
+		expiringSoon:      expiringSoon,
+		ra:                ra,
+		sa:                sa,
+		overridesDisabled: overridesDisabled,
+		overridesExpiring: overridesExpiring,
+		clk:               clk,
+		log:               log,
+	}, nil
+}
+
+// Start begins the periodic sweep of expired rate limit overrides. This method
+// blocks until the provided context is cancelled.
+func (sw *OverridesSweeper) Start(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sw.clk.After(sw.interval):
+			sw.tick(ctx)
+		}
+	}
+}
+
+// tick performs a single sweep, disabling expired overrides and then reporting
+// those which will expire within the expiringSoon window.
+func (sw *OverridesSweeper) tick(ctx context.Context) {
+	resp, err := sw.ra.DisableExpiredRateLimitOverrides(ctx, &emptypb.Empty{})
+	if err != nil {
+		sw.log.Errf("while disabling expired rate limit overrides: %s", err)
+	} else {
+		for _, o := range resp.Disabled {
+			sw.log.Infof("overrides sweeper disabled override for rate limit %s and bucket key %q (%s), which expired at %s",
+				rl.Name(o.LimitEnum), o.BucketKey, o.Comment, o.ExpiresAt.AsTime().Format(time.RFC3339))
+		}
+		sw.overridesDisabled.Add(float64(len(resp.Disabled)))
+	}
+
+	err = sw.reportExpiringSoon(ctx)
+	if err != nil {
+		sw.log.Errf("while reporting rate limit overrides expiring soon: %s", err)
+	}
+}
+
+// reportExpiringSoon logs each enabled override which will expire within the
+// expiringSoon window, and updates the corresponding gauge.
+func (sw *OverridesSweeper) reportExpiringSoon(ctx context.Context) error {
+	stream, err := sw.sa.GetEnabledRateLimitOverrides(ctx, &emptypb.Empty{})
+	if err != nil {
+		return fmt.Errorf("fetching enabled overrides: %w", err)
+	}
+
+	cutoff := sw.clk.Now().Add(sw.expiringSoon)
+	var expiring int
+	for {
+		r, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Errorf("reading overrides stream: %w", err)
+		}
+		if r.Override.ExpiresAt == nil || r.Override.ExpiresAt.AsTime().After(cutoff) {
+			continue
+		}
+		expiring++
+		sw.log.Infof("override for rate limit %s and bucket key %q (%s) expires soon, at %s",
+			rl.Name(r.Override.LimitEnum), r.Override.BucketKey, r.Override.Comment, r.Override.ExpiresAt.AsTime().Format(time.RFC3339))
+	}
+	sw.overridesExpiring.Set(float64(expiring))
+	return nil
+}
@@ -0,0 +1,85 @@
+package sfe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/mocks"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+type sweeperFakeRA struct {
+	disabled []*rapb.RateLimitOverride
+	err      error
+}
+
+func (ra *sweeperFakeRA) DisableExpiredRateLimitOverrides(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*rapb.DisableExpiredRateLimitOverridesResponse, error) {
+	if ra.err != nil {
+		return nil, ra.err
+	}
+	return &rapb.DisableExpiredRateLimitOverridesResponse{Disabled: ra.disabled}, nil
+}
+
+type sweeperFakeSA struct {
+	enabled []*sapb.RateLimitOverrideResponse
+}
+
+func (sa *sweeperFakeSA) GetEnabledRateLimitOverrides(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (grpc.ServerStreamingClient[sapb.RateLimitOverrideResponse], error) {
+	return &mocks.ServerStreamClient[sapb.RateLimitOverrideResponse]{Results: sa.enabled}, nil
+}
+
+func TestOverridesSweeperTick(t *testing.T) {
+	t.Parallel()
+
+	fc := clock.NewFake()
+	ra := &sweeperFakeRA{
+		disabled: []*rapb.RateLimitOverride{
+			{LimitEnum: 3, BucketKey: "3:1", Comment: "Expired Corp", ExpiresAt: timestamppb.New(fc.Now().Add(-time.Hour))},
+		},
+	}
+	sa := &sweeperFakeSA{
+		enabled: []*sapb.RateLimitOverrideResponse{
+			{Override: &sapb.RateLimitOverride{LimitEnum: 3, BucketKey: "3:2", Comment: "Forever Corp"}, Enabled: true},
+			{Override: &sapb.RateLimitOverride{LimitEnum: 3, BucketKey: "3:3", Comment: "Soon Corp", ExpiresAt: timestamppb.New(fc.Now().Add(24 * time.Hour))}, Enabled: true},
+			{Override: &sapb.RateLimitOverride{LimitEnum: 3, BucketKey: "3:4", Comment: "Later Corp", ExpiresAt: timestamppb.New(fc.Now().Add(30 * 24 * time.Hour))}, Enabled: true},
+		},
+	}
+	log := blog.NewMock()
+
+	sw, err := NewOverridesSweeper(time.Hour, 7*24*time.Hour, ra, sa, metrics.NoopRegisterer, fc, log)
+	test.AssertNotError(t, err, "creating OverridesSweeper")
+
+	sw.tick(context.Background())
+
+	test.AssertMetricWithLabelsEquals(t, sw.overridesDisabled, prometheus.Labels{}, 1)
+	test.AssertMetricWithLabelsEquals(t, sw.overridesExpiring, prometheus.Labels{}, 1)
+	test.AssertEquals(t, len(log.GetAllMatching(`disabled override for rate limit NewOrdersPerAccount and bucket key "3:1" \(Expired Corp\)`)), 1)
+	test.AssertEquals(t, len(log.GetAllMatching(`bucket key "3:3" \(Soon Corp\) expires soon`)), 1)
+	test.AssertEquals(t, len(log.GetAllMatching(`Forever Corp|Later Corp`)), 0)
+
+	// An RA failure should not prevent the report.
+	ra.err = errors.New("oops")
+	log.Clear()
+	sw.tick(context.Background())
+	test.AssertEquals(t, len(log.GetAllMatching(`while disabling expired rate limit overrides`)), 1)
+	test.AssertEquals(t, len(log.GetAllMatching(`\(Soon Corp\) expires soon`)), 1)
+}
+
+func TestNewOverridesSweeperRequiresInterval(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewOverridesSweeper(0, time.Hour, &sweeperFakeRA{}, &sweeperFakeSA{}, metrics.NoopRegisterer, clock.NewFake(), blog.NewMock())
+	test.AssertError(t, err, "expected an error for a zero interval")
+}
@@ -53,7 +53,7 @@ func setupSFE(t *testing.T) (SelfServiceFrontEndImpl, clock.FakeClock) {
 	key, err := hmacKey.Load()
 	test.AssertNotError(t, err, "Unable to load HMAC key")
 
-	limiter, err := ratelimits.NewLimiter(fc, ratelimits.NewInmemSource(), stats)
+	limiter, err := ratelimits.NewLimiter(fc, ratelimits.NewInmemSource(), stats, logger)
 	test.AssertNotError(t, err, "making limiter")
 	txnBuilder, err := ratelimits.NewTransactionBuilderFromFiles("../test/config-next/sfe-ratelimit-defaults.yml", "", stats, logger)
 	test.AssertNotError(t, err, "making transaction composer")
@@ -41,7 +41,8 @@ ipki() (
 
   # Used by Boulder gRPC services as both server and client mTLS certificates.
   for SERVICE in admin consul wfe bad-key-revoker \
-    crl-updater crl-storer health-checker sfe email-exporter mtca; do
+    crl-updater crl-storer crl-auditor health-checker sfe email-exporter mtca \
+    order-recoverer event-exporter ct-monitor; do
     minica -domains "${SERVICE}.boulder" &
   done
 
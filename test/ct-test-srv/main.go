@@ -1,10 +1,13 @@
 // This is a test server that implements the subset of RFC6962 APIs needed to
-// run Boulder's CT log submission code. Currently it only implements add-chain.
-// This is used by startservers.py.
+// run Boulder's CT log submission and monitoring code: add-chain, add-pre-chain,
+// get-sth, get-proof-by-hash, and get-sth-consistency. Every submission is incorporated into the
+// log's Merkle tree immediately. This is used by startservers.py.
 package main
 
 import (
 	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
@@ -12,13 +15,18 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"math/rand/v2"
+	mrand "math/rand/v2"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	"golang.org/x/mod/sumdb/tlog"
+
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/publisher"
 )
@@ -39,6 +47,10 @@ type integrationSrv struct {
 	key           *ecdsa.PrivateKey
 	flakinessRate int
 	userAgent     string
+	// hashes holds the tlog stored hashes of the log's Merkle tree, and
+	// leafIndexes maps the hash of each leaf in the tree to its index.
+	hashes      []tlog.Hash
+	leafIndexes map[tlog.Hash]int64
 }
 
 func readJSON(r *http.Request, output any) error {
@@ -159,12 +171,224 @@ func (is *integrationSrv) addChainOrPre(w http.ResponseWriter, r *http.Request,
 
 	is.addSubmission(hostnames)
 
-	if is.flakinessRate != 0 && rand.IntN(100) < is.flakinessRate {
+	if is.flakinessRate != 0 && mrand.IntN(100) < is.flakinessRate {
 		time.Sleep(10 * time.Second)
 	}
 
+	timestamp := time.Now()
+	err = is.addLeaf(addChainReq.Chain, precert, timestamp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(publisher.CreateTestingSignedSCT(addChainReq.Chain, is.key, precert, timestamp))
+}
+
+// addLeaf incorporates the entry for the given chain, with the timestamp of
+// the SCT issued for it, into the log's Merkle tree.
+func (is *integrationSrv) addLeaf(b64Chain []string, precert bool, timestamp time.Time) error {
+	chain := make([]ct.ASN1Cert, len(b64Chain))
+	for i, str := range b64Chain {
+		b, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return err
+		}
+		chain[i] = ct.ASN1Cert{Data: b}
+	}
+	etype := ct.X509LogEntryType
+	if precert {
+		etype = ct.PrecertLogEntryType
+	}
+	leaf, err := ct.MerkleTreeLeafFromRawChain(chain, etype, uint64(timestamp.UnixMilli())) //nolint: gosec // Current-ish timestamp is guaranteed to fit in a uint64
+	if err != nil {
+		return err
+	}
+	leafHash, err := ct.LeafHashForLeaf(leaf)
+	if err != nil {
+		return err
+	}
+
+	is.Lock()
+	defer is.Unlock()
+	_, ok := is.leafIndexes[leafHash]
+	if ok {
+		return nil
+	}
+	n := int64(len(is.leafIndexes))
+	hashes, err := tlog.StoredHashesForRecordHash(n, leafHash, is.hashReader())
+	if err != nil {
+		return err
+	}
+	is.hashes = append(is.hashes, hashes...)
+	is.leafIndexes[leafHash] = n
+	return nil
+}
+
+// hashReader returns a tlog.HashReader for the log's stored hashes. The caller
+// must hold the lock.
+func (is *integrationSrv) hashReader() tlog.HashReader {
+	return tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		hashes := make([]tlog.Hash, len(indexes))
+		for i, index := range indexes {
+			if index >= int64(len(is.hashes)) {
+				return nil, fmt.Errorf("no stored hash %d", index)
+			}
+			hashes[i] = is.hashes[index]
+		}
+		return hashes, nil
+	})
+}
+
+// getSTH returns a signed tree head covering every entry submitted so far.
+func (is *integrationSrv) getSTH(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.NotFound(w, r)
+		return
+	}
+
+	is.Lock()
+	treeSize := int64(len(is.leafIndexes))
+	rootHash, err := tlog.TreeHash(treeSize, is.hashReader())
+	is.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sth := ct.SignedTreeHead{
+		Version:        ct.V1,
+		TreeSize:       uint64(treeSize),
+		Timestamp:      uint64(time.Now().UnixMilli()), //nolint: gosec // Current-ish timestamp is guaranteed to fit in a uint64
+		SHA256RootHash: ct.SHA256Hash(rootHash),
+	}
+	serialized, err := ct.SerializeSTHSignatureInput(sth)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	hashed := sha256.Sum256(serialized)
+	sig, err := ecdsa.SignASN1(rand.Reader, is.key, hashed[:])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ds, err := cttls.Marshal(ct.DigitallySigned{
+		Algorithm: cttls.SignatureAndHashAlgorithm{
+			Hash:      cttls.SHA256,
+			Signature: cttls.ECDSA,
+		},
+		Signature: sig,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output, err := json.Marshal(ct.GetSTHResponse{
+		TreeSize:          sth.TreeSize,
+		Timestamp:         sth.Timestamp,
+		SHA256RootHash:    rootHash[:],
+		TreeHeadSignature: ds,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(output)
+}
+
+// getProofByHash returns an inclusion proof for the leaf with the given hash in
+// the tree of the given size, or 404 if the tree doesn't include it.
+func (is *integrationSrv) getProofByHash(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.NotFound(w, r)
+		return
+	}
+	hash, err := base64.StdEncoding.DecodeString(r.URL.Query().Get("hash"))
+	if err != nil || len(hash) != tlog.HashSize {
+		http.Error(w, "invalid hash", http.StatusBadRequest)
+		return
+	}
+	treeSize, err := strconv.ParseInt(r.URL.Query().Get("tree_size"), 10, 64)
+	if err != nil || treeSize < 1 {
+		http.Error(w, "invalid tree_size", http.StatusBadRequest)
+		return
+	}
+
+	is.Lock()
+	defer is.Unlock()
+	if treeSize > int64(len(is.leafIndexes)) {
+		http.Error(w, "tree_size too large", http.StatusBadRequest)
+		return
+	}
+	index, ok := is.leafIndexes[tlog.Hash(hash)]
+	if !ok || index >= treeSize {
+		http.NotFound(w, r)
+		return
+	}
+	proof, err := tlog.ProveRecord(treeSize, index, is.hashReader())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	auditPath := make([][]byte, len(proof))
+	for i := range proof {
+		auditPath[i] = proof[i][:]
+	}
+
+	output, err := json.Marshal(ct.GetProofByHashResponse{LeafIndex: index, AuditPath: auditPath})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 	w.WriteHeader(http.StatusOK)
-	w.Write(publisher.CreateTestingSignedSCT(addChainReq.Chain, is.key, precert, time.Now()))
+	w.Write(output)
+}
+
+// getSTHConsistency returns a proof that the tree of size second extends the
+// tree of size first.
+func (is *integrationSrv) getSTHConsistency(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.NotFound(w, r)
+		return
+	}
+	first, err := strconv.ParseInt(r.URL.Query().Get("first"), 10, 64)
+	if err != nil || first < 0 {
+		http.Error(w, "invalid first", http.StatusBadRequest)
+		return
+	}
+	second, err := strconv.ParseInt(r.URL.Query().Get("second"), 10, 64)
+	if err != nil || second < first {
+		http.Error(w, "invalid second", http.StatusBadRequest)
+		return
+	}
+
+	is.Lock()
+	defer is.Unlock()
+	if second > int64(len(is.leafIndexes)) {
+		http.Error(w, "second too large", http.StatusBadRequest)
+		return
+	}
+	proof, err := tlog.ProveTree(second, first, is.hashReader())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	consistency := make([][]byte, len(proof))
+	for i := range proof {
+		consistency[i] = proof[i][:]
+	}
+
+	output, err := json.Marshal(ct.GetSTHConsistencyResponse{Consistency: consistency})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(output)
 }
 
 func (is *integrationSrv) addSubmission(hostnames string) {
@@ -233,11 +457,15 @@ func runPersonality(p Personality) {
 		submissionsCap: cap,
 		rejectHosts:    make(map[string]bool),
 		userAgent:      p.UserAgent,
+		leafIndexes:    make(map[tlog.Hash]int64),
 	}
 	m := http.NewServeMux()
 	m.HandleFunc("/submissions", is.getSubmissions)
 	m.HandleFunc("/ct/v1/add-pre-chain", is.addPreChain)
 	m.HandleFunc("/ct/v1/add-chain", is.addChain)
+	m.HandleFunc("/ct/v1/get-sth", is.getSTH)
+	m.HandleFunc("/ct/v1/get-proof-by-hash", is.getProofByHash)
+	m.HandleFunc("/ct/v1/get-sth-consistency", is.getSTHConsistency)
 	m.HandleFunc("/add-reject-host", is.addRejectHost)
 	m.HandleFunc("/get-rejections", is.getRejections)
 	srv := &http.Server{ //nolint: gosec // No ReadHeaderTimeout is fine for test-only code.
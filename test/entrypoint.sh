@@ -15,8 +15,10 @@ DB_URL_FILES=(
   cert_checker_dburl
   incidents_dburl
   incidents_admin_dburl
+  janitor_dburl
   mtpublisher_dburl
   mtca1_dburl
+  orderrecoverer_dburl
   revoker_dburl
   sa_dburl
   sa_ro_dburl
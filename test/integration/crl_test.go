@@ -66,6 +66,29 @@ func runUpdater(t *testing.T, configFile string) {
 	test.AssertNotError(t, err, "crl-updater failed")
 }
 
+// runAuditor executes the crl-auditor binary with the -runOnce flag, and
+// returns when it completes. The auditor fails if any CRL is missing an entry,
+// or has an extra one.
+func runAuditor(t *testing.T) {
+	t.Helper()
+	crlUpdaterMu.Lock()
+	defer crlUpdaterMu.Unlock()
+
+	configDir, ok := os.LookupEnv("BOULDER_CONFIG_DIR")
+	test.Assert(t, ok, "failed to look up test config directory")
+
+	binPath, err := filepath.Abs("bin/boulder")
+	test.AssertNotError(t, err, "computing boulder binary path")
+
+	c := exec.Command(binPath, "crl-auditor", "-config", path.Join(configDir, "crl-auditor.json"), "-debug-addr", ":8027", "-runOnce")
+	out, err := c.CombinedOutput()
+	for _, line := range strings.Split(string(out), "\n") {
+		// Print the auditor's stdout for debugging, but only if the test fails.
+		t.Log(line)
+	}
+	test.AssertNotError(t, err, "crl-auditor failed")
+}
+
 // TestCRLUpdaterStartup ensures that the crl-updater can start in daemon mode.
 // We do this here instead of in startservers so that we can shut it down after
 // we've confirmed it is running. It's important that it not be running while
@@ -165,6 +188,9 @@ func TestCRLPipeline(t *testing.T) {
 	test.AssertEquals(t, string(reason), "5")
 	resp.Body.Close()
 
+	// Confirm that the published CRLs match the revocations in the database.
+	runAuditor(t)
+
 	// Manipulate the database so it appears that the certificate is going to
 	// expire very soon. The cert should still appear on the CRL.
 	_, err = db.Exec("UPDATE revokedCertificates SET notAfterHour = ? WHERE serial = ?", time.Now().Add(time.Hour).Truncate(time.Hour).Format(time.DateTime), serial)
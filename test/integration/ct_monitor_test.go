@@ -0,0 +1,47 @@
+//go:build integration
+
+package integration
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eggsampler/acme/v3"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+// TestCTMonitor issues a certificate, then runs the ct-monitor once to check
+// that every SCT embedded in it, and in every other recently issued
+// certificate, has been incorporated by ct-test-srv.
+func TestCTMonitor(t *testing.T) {
+	t.Parallel()
+
+	client, err := makeClient()
+	test.AssertNotError(t, err, "creating acme client")
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "creating random cert key")
+	_, err = authAndIssue(client, key, []acme.Identifier{{Type: "dns", Value: random_domain()}}, true, "")
+	test.AssertNotError(t, err, "failed to issue test cert")
+
+	configDir, ok := os.LookupEnv("BOULDER_CONFIG_DIR")
+	test.Assert(t, ok, "failed to look up test config directory")
+
+	binPath, err := filepath.Abs("bin/boulder")
+	test.AssertNotError(t, err, "computing boulder binary path")
+
+	c := exec.Command(binPath, "ct-monitor", "-config", path.Join(configDir, "ct-monitor.json"), "-debug-addr", ":8028", "-runOnce")
+	out, err := c.CombinedOutput()
+	for _, line := range strings.Split(string(out), "\n") {
+		// Print the monitor's stdout for debugging, but only if the test fails.
+		t.Log(line)
+	}
+	test.AssertNotError(t, err, "ct-monitor failed")
+}
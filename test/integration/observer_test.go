@@ -173,3 +173,128 @@ monitors:
 		}
 	}
 }
+
+func TestACMEProbe(t *testing.T) {
+	t.Parallel()
+
+	// The prober's http-01 responder listens on the address used for
+	// throwaway challenge servers, which the VA reaches via this A record.
+	domain := random_domain()
+	_, err := testSrvClient.AddARecord(domain, []string{"64.112.117.134"})
+	if err != nil {
+		t.Fatalf("adding A record: %s", err)
+	}
+	defer testSrvClient.RemoveARecord(domain)
+
+	configFile, err := os.Create(path.Join(t.TempDir(), "observer.yml"))
+	if err != nil {
+		t.Fatalf("creating config file: %s", err)
+	}
+
+	_, err = configFile.WriteString(fmt.Sprintf(`---
+buckets: [.001, .002, .005, .01, .02, .05, .1, .2, .5, 1, 2, 5, 10]
+syslog:
+  stdoutlevel: 6
+  sysloglevel: 0
+monitors:
+  -
+    period: 10s
+    kind: ACME
+    settings:
+      directory: http://boulder.service.consul:4001/directory
+      domain: "%s"
+      challenge: http-01
+      listenAddress: 64.112.117.134:80
+      revoke: true`, domain))
+	if err != nil {
+		t.Fatalf("writing test config: %s", err)
+	}
+
+	binPath, err := filepath.Abs("bin/boulder")
+	if err != nil {
+		t.Fatalf("computing boulder binary path: %s", err)
+	}
+
+	c := exec.CommandContext(t.Context(), binPath, "boulder-observer", "-config", configFile.Name(), "-debug-addr", ":8029")
+	output, cancel := streamOutput(t, c)
+	defer cancel()
+
+	timeout := time.NewTimer(30 * time.Second)
+
+	for {
+		select {
+		case <-timeout.C:
+			t.Fatalf("timed out before getting desired log line from boulder-observer")
+		case line := <-output:
+			t.Log(line)
+			if strings.Contains(line, "kind=[ACME]") && strings.Contains(line, domain) {
+				if !strings.Contains(line, "success=[true]") {
+					t.Fatalf("ACME probe failed: %s", line)
+				}
+				return
+			}
+		}
+	}
+}
+
+func TestCTProbe(t *testing.T) {
+	t.Parallel()
+
+	logListFile, err := filepath.Abs("test/ct-test-srv/log_list.json")
+	if err != nil {
+		t.Fatalf("computing log list path: %s", err)
+	}
+
+	configFile, err := os.Create(path.Join(t.TempDir(), "observer.yml"))
+	if err != nil {
+		t.Fatalf("creating config file: %s", err)
+	}
+
+	_, err = configFile.WriteString(fmt.Sprintf(`---
+buckets: [.001, .002, .005, .01, .02, .05, .1, .2, .5, 1, 2, 5, 10]
+syslog:
+  stdoutlevel: 6
+  sysloglevel: 0
+monitors:
+  -
+    period: 1s
+    kind: CT
+    settings:
+      logListFile: "%s"
+      logName: A1 Current
+      maxAge: 1m`, logListFile))
+	if err != nil {
+		t.Fatalf("writing test config: %s", err)
+	}
+
+	binPath, err := filepath.Abs("bin/boulder")
+	if err != nil {
+		t.Fatalf("computing boulder binary path: %s", err)
+	}
+
+	c := exec.CommandContext(t.Context(), binPath, "boulder-observer", "-config", configFile.Name(), "-debug-addr", ":8030")
+	output, cancel := streamOutput(t, c)
+	defer cancel()
+
+	timeout := time.NewTimer(10 * time.Second)
+
+	// Wait for a second probe, which checks consistency with the first.
+	var successes int
+	for {
+		select {
+		case <-timeout.C:
+			t.Fatalf("timed out before getting desired log lines from boulder-observer")
+		case line := <-output:
+			t.Log(line)
+			if strings.Contains(line, "kind=[CT]") {
+				if !strings.Contains(line, "success=[true]") {
+					t.Fatalf("CT probe failed: %s", line)
+				}
+				successes++
+				if successes == 2 {
+					return
+				}
+			}
+		}
+	}
+}
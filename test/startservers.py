@@ -93,6 +93,14 @@ SERVICES = [
         8020, None, None,
         ('./bin/boulder', 'bad-key-revoker', '--config', os.path.join(config_dir, 'bad-key-revoker.json'), '--debug-addr', ':8020'),
         ('boulder-ra-1', 'boulder-ra-2')),
+    Service('order-recoverer',
+        8021, None, None,
+        ('./bin/boulder', 'order-recoverer', '--config', os.path.join(config_dir, 'order-recoverer.json'), '--debug-addr', ':8021'),
+        ('boulder-sa-1', 'boulder-sa-2')),
+    Service('janitor',
+        8026, None, None,
+        ('./bin/boulder', 'janitor', '--config', os.path.join(config_dir, 'janitor.json'), '--debug-addr', ':8026'),
+        None),
     # Note: the nonce-service instances bind to specific interfaces, not all
     # interfaces, because they use their explicit host:port pair to calculate
     # the nonce prefix, which is used by WFEs when deciding where to redeem
@@ -152,6 +160,10 @@ if CONFIG_NEXT:
             8025, None, None,
             ('./bin/boulder', 'boulder-mtpublisher', '--config', os.path.join(config_dir, 'mtpublisher.json'), '--debug-addr', ':8025'),
             None),
+        Service('event-exporter',
+            8115, None, None,
+            ('./bin/boulder', 'event-exporter', '--config', os.path.join(config_dir, 'event-exporter.json'), '--addr', ':9604', '--debug-addr', ':8115'),
+            ('boulder-sa-1', 'boulder-sa-2')),
     ])
 
 def _service_toposort(services):
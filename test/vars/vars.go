@@ -22,6 +22,8 @@ var (
 	DBConnSA = dsn("sa", "boulder_sa")
 	// DBConnSAFullPerms is the sa database connection with full perms
 	DBConnSAFullPerms = dsn("test_setup", "boulder_sa")
+	// DBConnRateLimits is the rate limits database connection.
+	DBConnRateLimits = dsn("ratelimits", "boulder_sa")
 	// DBInfoSchemaRoot is the root user and the information_schema connection.
 	DBInfoSchemaRoot = dsn("root", "information_schema")
 	// DBConnIncidents is the incidents database connection.
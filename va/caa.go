@@ -37,7 +37,12 @@ type caaParams struct {
 // implements the CAA portion of Multi-Perspective Issuance Corroboration as
 // defined in BRs Sections 3.2.2.9 and 5.4.1.
 func (va *ValidationAuthorityImpl) DoCAA(ctx context.Context, req *vapb.IsCAAValidRequest) (*vapb.IsCAAValidResponse, error) {
-	if core.IsAnyNilOrZero(req.AuthzID, req.Identifier, req.ValidationMethod, req.AccountURIID) {
+	if core.IsAnyNilOrZero(req.Identifier, req.ValidationMethod, req.AccountURIID) {
+		return nil, berrors.InternalServerError("incomplete IsCAAValid request")
+	}
+	// Pre-flight checks for a hypothetical order have no authorization to
+	// attribute the check to; every other check must.
+	if req.Preflight != (req.AuthzID == 0) {
 		return nil, berrors.InternalServerError("incomplete IsCAAValid request")
 	}
 
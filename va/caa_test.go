@@ -590,6 +590,26 @@ func TestDoCAAParams(t *testing.T) {
 		AccountURIID:     12345,
 	})
 	test.AssertError(t, err, "calling isCAAValid without an Authz ID")
+
+	// Calling IsCAAValid for a pre-flight check without an AuthzID should
+	// succeed.
+	_, err = va.DoCAA(ctx, &vapb.IsCAAValidRequest{
+		Identifier:       identifier.NewDNS("present.com").ToProto(),
+		ValidationMethod: string(core.ChallengeTypeHTTP01),
+		AccountURIID:     12345,
+		Preflight:        true,
+	})
+	test.AssertNotError(t, err, "calling IsCAAValid for a pre-flight check")
+
+	// Calling IsCAAValid for a pre-flight check with an AuthzID should fail.
+	_, err = va.DoCAA(ctx, &vapb.IsCAAValidRequest{
+		Identifier:       identifier.NewDNS("present.com").ToProto(),
+		ValidationMethod: string(core.ChallengeTypeHTTP01),
+		AccountURIID:     12345,
+		AuthzID:          678910,
+		Preflight:        true,
+	})
+	test.AssertError(t, err, "calling IsCAAValid for a pre-flight check with an AuthzID")
 }
 
 var errCAABrokenDNSClient = errors.New("dnsClient is broken")
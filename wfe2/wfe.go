@@ -73,6 +73,11 @@ const (
 	getCertInfoPath = "/get/certinfo/"
 	buildIDPath     = "/build"
 	healthzPath     = "/healthz"
+	checkOrderPath  = "/acme/check-order"
+
+	// acctRateLimitsSuffix is appended to an account URL to form the URL of
+	// that account's rate limit status resource.
+	acctRateLimitsSuffix = "/rate-limits"
 )
 
 const (
@@ -470,6 +475,7 @@ func (wfe *WebFrontEndImpl) Handler(stats prometheus.Registerer, oTelHTTPOptions
 	// Boulder specific endpoints
 	wfe.HandleFunc(m, getCertPath, wfe.Certificate, "GET")
 	wfe.HandleFunc(m, getCertInfoPath, wfe.CertificateInfo, "GET")
+	wfe.HandleFunc(m, checkOrderPath, wfe.CheckOrder, "POST")
 	wfe.HandleFunc(m, buildIDPath, wfe.BuildID, "GET")
 	wfe.HandleFunc(m, healthzPath, wfe.Healthz, "GET")
 
@@ -1447,8 +1453,8 @@ func (wfe *WebFrontEndImpl) Account(
 	}
 
 	// Requests to this handler should have a path that leads to a known
-	// account
-	idStr := request.URL.Path
+	// account, optionally followed by the rate limits suffix.
+	idStr, isRateLimits := strings.CutSuffix(request.URL.Path, acctRateLimitsSuffix)
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		wfe.sendError(response, logEvent, probs.Malformed(fmt.Sprintf("Account ID must be an integer, was %q", idStr)), err)
@@ -1461,6 +1467,11 @@ func (wfe *WebFrontEndImpl) Account(
 		return
 	}
 
+	if isRateLimits {
+		wfe.accountRateLimits(ctx, logEvent, response, body, currAcct)
+		return
+	}
+
 	var acct *core.Registration
 	if string(body) == "" || string(body) == "{}" {
 		// An empty string means POST-as-GET (i.e. no update). A body of "{}" means
@@ -1486,6 +1497,109 @@ func (wfe *WebFrontEndImpl) Account(
 	}
 }
 
+// rateLimitStatusJSON is the JSON representation of the current state of a
+// single rate limit bucket which applies to an account.
+type rateLimitStatusJSON struct {
+	Limit string `json:"limit"`
+	// Identifier is the registered domain or IP range the bucket is for, and
+	// is omitted for buckets which aren't specific to one.
+	Identifier string    `json:"identifier,omitempty"`
+	Burst      int64     `json:"burst"`
+	Count      int64     `json:"count"`
+	Period     string    `json:"period"`
+	Remaining  int64     `json:"remaining"`
+	Reset      time.Time `json:"reset"`
+	Override   bool      `json:"override"`
+}
+
+// rateLimitsJSON is the JSON representation of an account's rate limit status
+// resource.
+type rateLimitsJSON struct {
+	Limits []rateLimitStatusJSON `json:"limits"`
+}
+
+// accountRateLimits reports the current state of the rate limit buckets which
+// the account's new-order requests are subject to, without spending any of
+// their capacity. A POST-as-GET reports only the account's own buckets. The
+// request body may instead list identifiers, in the same form as a NewOrder
+// request, in which case the buckets for those identifiers are also reported.
+//
+// Important: It is assumed the request has already been authenticated by the
+// caller.
+func (wfe *WebFrontEndImpl) accountRateLimits(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, body []byte, acct *core.Registration) {
+	var idents identifier.ACMEIdentifiers
+	if len(body) != 0 {
+		var rateLimitsRequest struct {
+			Identifiers identifier.ACMEIdentifiers `json:"identifiers"`
+		}
+		err := json.Unmarshal(body, &rateLimitsRequest)
+		if err != nil {
+			wfe.sendError(response, logEvent, probs.Malformed("Unable to unmarshal rate limits request body"), err)
+			return
+		}
+		// No order may contain more than 100 identifiers, and the rate limits
+		// package refuses to build more transactions than that.
+		if len(rateLimitsRequest.Identifiers) > 100 {
+			wfe.sendError(response, logEvent, probs.Malformed("Rate limits request included more than 100 identifiers"), nil)
+			return
+		}
+		var prob *probs.ProblemDetails
+		idents, prob = wfe.normalizeOrderIdentifiers(rateLimitsRequest.Identifiers)
+		logEvent.Identifiers = idents
+		if prob != nil {
+			wfe.sendError(response, logEvent, prob, nil)
+			return
+		}
+	}
+
+	if !wfe.txnBuilder.Ready() {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Rate limit overrides are not yet loaded"), nil)
+		return
+	}
+
+	txns, err := wfe.txnBuilder.AccountStatusTransactions(acct.ID, idents)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error building rate limit transactions"), err)
+		return
+	}
+
+	statuses, err := wfe.limiter.BatchStatus(ctx, txns)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error retrieving rate limit status"), err)
+		return
+	}
+
+	respObj := rateLimitsJSON{Limits: []rateLimitStatusJSON{}}
+	for _, status := range statuses {
+		var ident string
+		switch status.Name {
+		case ratelimits.CertificatesPerDomain:
+			// Uses bucket key 'enum:domainOrCIDR'. The domainOrCIDR may itself
+			// contain colons, if it's an IPv6 prefix.
+			ident = strings.SplitN(status.BucketKey, ":", 2)[1]
+		case ratelimits.CertificatesPerDomainPerAccount:
+			// Uses bucket key 'enum:regId:domainOrCIDR'.
+			ident = strings.SplitN(status.BucketKey, ":", 3)[2]
+		}
+		respObj.Limits = append(respObj.Limits, rateLimitStatusJSON{
+			Limit:      status.Name.String(),
+			Identifier: ident,
+			Burst:      status.Burst,
+			Count:      status.Count,
+			Period:     status.Period.String(),
+			Remaining:  status.Remaining,
+			Reset:      status.ResetAt.UTC().Truncate(time.Second),
+			Override:   status.Override,
+		})
+	}
+
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, respObj)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Failed to marshal rate limits"), err)
+		return
+	}
+}
+
 // updateAccount unmarshals an account update request from the provided
 // requestBody to update the given registration. Important: It is assumed the
 // request has already been authenticated by the caller. If the request is a
@@ -2125,6 +2239,33 @@ func (wfe *WebFrontEndImpl) orderToOrderJSON(request *http.Request, order *corep
 	return respObj
 }
 
+// spendCAAChecksLimit spends one CAAChecksPerAccount token for each DNS
+// identifier in idents, whose CAA records the order check will look up. Unlike
+// the NewOrder and NewAccount limits, an error from the rate limit system is
+// returned, since the lookups are optional and shouldn't be unthrottled.
+func (wfe *WebFrontEndImpl) spendCAAChecksLimit(ctx context.Context, regId int64, idents identifier.ACMEIdentifiers) error {
+	var dnsIdents identifier.ACMEIdentifiers
+	for _, ident := range idents {
+		if ident.Type == identifier.TypeDNS {
+			dnsIdents = append(dnsIdents, ident)
+		}
+	}
+	if len(dnsIdents) == 0 {
+		return nil
+	}
+
+	txn, err := wfe.txnBuilder.CAAChecksPerAccountTransaction(regId, dnsIdents)
+	if err != nil {
+		return fmt.Errorf("building CAA checks limit transaction: %w", err)
+	}
+
+	d, err := wfe.limiter.Spend(ctx, txn)
+	if err != nil {
+		return fmt.Errorf("spending CAA checks limit: %w", err)
+	}
+	return d.Result(wfe.clk.Now())
+}
+
 // checkNewOrderLimits checks whether sufficient limit quota exists for the
 // creation of a new order. If so, that quota is spent. If an error is
 // encountered during the check, it is logged but not returned. A refund
@@ -2345,6 +2486,50 @@ func (wfe *WebFrontEndImpl) checkIdentifiersPaused(ctx context.Context, orderIde
 	return pausedValues, nil
 }
 
+// normalizeOrderIdentifiers checks that the identifiers of a new-order request
+// are supported and well-formed, and returns them normalized. If they are not,
+// it returns a problem suitable for sending to the Subscriber.
+func (wfe *WebFrontEndImpl) normalizeOrderIdentifiers(idents identifier.ACMEIdentifiers) (identifier.ACMEIdentifiers, *probs.ProblemDetails) {
+	var totalIdentifierLen int
+	for _, ident := range idents {
+		if !ident.Type.IsValid() {
+			return nil, probs.UnsupportedIdentifier("NewOrder request included unsupported identifier: type %q, value %q",
+				ident.Type, ident.Value)
+		}
+		if ident.Value == "" {
+			return nil, probs.Malformed("NewOrder request included empty identifier")
+		}
+		totalIdentifierLen += len(ident.Value)
+		if wfe.maxCumulativeIdentifierLength != 0 && totalIdentifierLen > wfe.maxCumulativeIdentifierLength {
+			return nil, probs.Malformed("Cumulative length of all identifier values was greater than %d bytes",
+				wfe.maxCumulativeIdentifierLength)
+		}
+	}
+
+	idents = identifier.Normalize(idents)
+
+	err := policy.WellFormedIdentifiers(idents)
+	if err != nil {
+		return idents, web.ProblemDetailsForError(err, "Invalid identifiers requested")
+	}
+	return idents, nil
+}
+
+// isRenewal returns true if a certificate for exactly the given set of
+// identifiers was issued recently enough for a new order for them to be exempt
+// from the NewOrdersPerAccount and CertificatesPerDomain limits.
+func (wfe *WebFrontEndImpl) isRenewal(ctx context.Context, idents identifier.ACMEIdentifiers) (bool, error) {
+	timestamps, err := wfe.sa.FQDNSetTimestampsForWindow(ctx, &sapb.CountFQDNSetsRequest{
+		Identifiers: idents.ToProtoSlice(),
+		Window:      durationpb.New(120 * 24 * time.Hour),
+		Limit:       1,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(timestamps.Timestamps) > 0, nil
+}
+
 // NewOrder is used by clients to create a new order object and a set of
 // authorizations to fulfill for issuance.
 func (wfe *WebFrontEndImpl) NewOrder(
@@ -2387,35 +2572,10 @@ func (wfe *WebFrontEndImpl) NewOrder(
 		return
 	}
 
-	idents := newOrderRequest.Identifiers
-	var totalIdentifierLen int
-	for _, ident := range idents {
-		if !ident.Type.IsValid() {
-			wfe.sendError(response, logEvent,
-				probs.UnsupportedIdentifier("NewOrder request included unsupported identifier: type %q, value %q",
-					ident.Type, ident.Value),
-				nil)
-			return
-		}
-		if ident.Value == "" {
-			wfe.sendError(response, logEvent, probs.Malformed("NewOrder request included empty identifier"), nil)
-			return
-		}
-		totalIdentifierLen += len(ident.Value)
-		if wfe.maxCumulativeIdentifierLength != 0 && totalIdentifierLen > wfe.maxCumulativeIdentifierLength {
-			wfe.sendError(response, logEvent,
-				probs.Malformed("Cumulative length of all identifier values was greater than %d bytes",
-					wfe.maxCumulativeIdentifierLength), nil)
-			return
-		}
-	}
-
-	idents = identifier.Normalize(idents)
+	idents, prob := wfe.normalizeOrderIdentifiers(newOrderRequest.Identifiers)
 	logEvent.Identifiers = idents
-
-	err = policy.WellFormedIdentifiers(idents)
-	if err != nil {
-		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Invalid identifiers requested"), nil)
+	if prob != nil {
+		wfe.sendError(response, logEvent, prob, nil)
 		return
 	}
 
@@ -2461,16 +2621,11 @@ func (wfe *WebFrontEndImpl) NewOrder(
 		// The Subscriber does not have an ARI exemption. However, we can check
 		// if the order is a renewal, and thus exempt from the NewOrdersPerAccount
 		// and CertificatesPerDomain limits.
-		timestamps, err := wfe.sa.FQDNSetTimestampsForWindow(ctx, &sapb.CountFQDNSetsRequest{
-			Identifiers: idents.ToProtoSlice(),
-			Window:      durationpb.New(120 * 24 * time.Hour),
-			Limit:       1,
-		})
+		isRenewal, err = wfe.isRenewal(ctx, idents)
 		if err != nil {
 			wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "While checking renewal exemption status"), err)
 			return
 		}
-		isRenewal = len(timestamps.Timestamps) > 0
 	}
 
 	if !isRenewal && !isARIRenewal {
@@ -2542,6 +2697,178 @@ func (wfe *WebFrontEndImpl) NewOrder(
 	newOrderSuccessful = true
 }
 
+// identifierCheckJSON is the JSON representation of the result of checking a
+// single identifier of a hypothetical order.
+type identifierCheckJSON struct {
+	Identifier identifier.ACMEIdentifier `json:"identifier"`
+	Problems   []*probs.ProblemDetails   `json:"problems,omitempty"`
+}
+
+// checkOrderJSON is the JSON representation of the result of checking a
+// hypothetical order. Allowed is true only if there are no problems with the
+// order or any of its identifiers.
+type checkOrderJSON struct {
+	Allowed     bool                    `json:"allowed"`
+	Problems    []*probs.ProblemDetails `json:"problems,omitempty"`
+	Identifiers []identifierCheckJSON   `json:"identifiers"`
+}
+
+// CheckOrder is a Boulder-specific endpoint which accepts the same request
+// body as NewOrder, and reports whether the order would currently be
+// permitted and, if not, why. Nothing is created and no order or issuance
+// rate limits are spent. If the request includes a caaValidationMethod, CAA is
+// additionally checked for each DNS identifier as though it were validated
+// using that method; each such check is spent against the account's
+// CAAChecksPerAccount limit.
+func (wfe *WebFrontEndImpl) CheckOrder(
+	ctx context.Context,
+	logEvent *web.RequestEvent,
+	response http.ResponseWriter,
+	request *http.Request) {
+	body, _, acct, err := wfe.validPOSTForAccount(request, ctx, logEvent)
+	addRequesterHeader(response, logEvent.Requester)
+	if err != nil {
+		// validPOSTForAccount handles its own setting of logEvent.Errors
+		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Unable to validate JWS"), err)
+		return
+	}
+
+	var checkOrderRequest struct {
+		Identifiers         identifier.ACMEIdentifiers `json:"identifiers"`
+		Replaces            string
+		Profile             string
+		CAAValidationMethod string `json:"caaValidationMethod"`
+	}
+	err = json.Unmarshal(body, &checkOrderRequest)
+	if err != nil {
+		wfe.sendError(response, logEvent,
+			probs.Malformed("Unable to unmarshal CheckOrder request body"), err)
+		return
+	}
+
+	if len(checkOrderRequest.Identifiers) == 0 {
+		wfe.sendError(response, logEvent,
+			probs.Malformed("CheckOrder request did not specify any identifiers"), nil)
+		return
+	}
+
+	idents, prob := wfe.normalizeOrderIdentifiers(checkOrderRequest.Identifiers)
+	logEvent.Identifiers = idents
+	if prob != nil {
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+
+	err = wfe.validateCertificateProfileName(checkOrderRequest.Profile)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.InvalidProfile(err.Error()), err)
+		return
+	}
+
+	_, isARIRenewal, err := wfe.validateReplacementOrder(ctx, acct, idents, checkOrderRequest.Replaces)
+	if err != nil {
+		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Could not validate ARI 'replaces' field"), err)
+		return
+	}
+
+	var isRenewal bool
+	if !isARIRenewal {
+		isRenewal, err = wfe.isRenewal(ctx, idents)
+		if err != nil {
+			wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "While checking renewal exemption status"), err)
+			return
+		}
+	}
+
+	// These checks are performed by the WFE at NewOrder time, and so aren't
+	// included in the RA's report.
+	var wfeProbs []*probs.ProblemDetails
+	if wfe.accountBlocker != nil {
+		err = wfe.accountBlocker.CheckAccountID(acct.ID)
+		if err != nil {
+			wfeProbs = append(wfeProbs, web.ProblemDetailsForError(err, "Account blocked"))
+		}
+	}
+	if !isRenewal && !isARIRenewal {
+		err = looksLikeRecursiveOnDemandRequest(idents, wfe.blockedOnDemandLabels)
+		if err != nil {
+			wfeProbs = append(wfeProbs, web.ProblemDetailsForError(err, "Disallowed identifier requested"))
+		}
+	}
+
+	if checkOrderRequest.CAAValidationMethod != "" {
+		// Each DNS identifier costs the VA a CAA lookup from every
+		// perspective, so CAA checks are never performed unless they can be
+		// paid for.
+		err = wfe.spendCAAChecksLimit(ctx, acct.ID, idents)
+		if err != nil {
+			if errors.Is(err, berrors.RateLimit) {
+				wfe.sendError(response, logEvent, probs.RateLimited(err.Error()), err)
+				return
+			}
+			wfe.sendError(response, logEvent, probs.ServerInternal("Error checking CAA rate limit"), err)
+			return
+		}
+	}
+
+	report, err := wfe.ra.CheckOrder(ctx, &rapb.CheckOrderRequest{
+		RegistrationID:         acct.ID,
+		Identifiers:            idents.ToProtoSlice(),
+		CertificateProfileName: checkOrderRequest.Profile,
+		IsRenewal:              isRenewal,
+		IsARIRenewal:           isARIRenewal,
+		CaaValidationMethod:    checkOrderRequest.CAAValidationMethod,
+	})
+	if err != nil {
+		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Error checking order"), err)
+		return
+	}
+
+	respObj := checkOrderJSON{Problems: wfeProbs}
+	for _, pbProb := range report.Problems {
+		prob, err := bgrpc.PBToProblemDetails(pbProb)
+		if err != nil {
+			wfe.sendError(response, logEvent, probs.ServerInternal("Error checking order"), err)
+			return
+		}
+		respObj.Problems = append(respObj.Problems, prob)
+	}
+	for _, check := range report.Identifiers {
+		identCheck := identifierCheckJSON{Identifier: identifier.FromProto(check.Identifier)}
+		for _, pbProb := range check.Problems {
+			prob, err := bgrpc.PBToProblemDetails(pbProb)
+			if err != nil {
+				wfe.sendError(response, logEvent, probs.ServerInternal("Error checking order"), err)
+				return
+			}
+			identCheck.Problems = append(identCheck.Problems, prob)
+		}
+		respObj.Identifiers = append(respObj.Identifiers, identCheck)
+	}
+
+	// Problems are stored with just the short form of their type, but are
+	// displayed with the RFC8555 ACME Error namespace, as for orders and
+	// challenges.
+	respObj.Allowed = len(respObj.Problems) == 0
+	for _, prob := range respObj.Problems {
+		prob.Type = probs.ErrorNS + prob.Type
+	}
+	for _, identCheck := range respObj.Identifiers {
+		if len(identCheck.Problems) > 0 {
+			respObj.Allowed = false
+		}
+		for _, prob := range identCheck.Problems {
+			prob.Type = probs.ErrorNS + prob.Type
+		}
+	}
+
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, respObj)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error marshaling order check"), err)
+		return
+	}
+}
+
 // GetOrder is used to retrieve a existing order object
 func (wfe *WebFrontEndImpl) GetOrder(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
 	var requesterAccount *core.Registration
@@ -410,7 +410,7 @@ func setupWFE(t *testing.T) (WebFrontEndImpl, clock.FakeClock, requestSigner) {
 	rnc := inmemNonceService
 
 	// Setup rate limiting.
-	limiter, err := ratelimits.NewLimiter(fc, ratelimits.NewInmemSource(), stats)
+	limiter, err := ratelimits.NewLimiter(fc, ratelimits.NewInmemSource(), stats, logger)
 	test.AssertNotError(t, err, "making limiter")
 	txnBuilder, err := ratelimits.NewTransactionBuilderFromFiles("../test/config-next/ratelimit-defaults.yml", "", stats, logger)
 	test.AssertNotError(t, err, "making transaction composer")
@@ -4604,3 +4604,192 @@ func TestMaxCumulativeIdentifierLength(t *testing.T) {
 		t.Errorf("newOrder with too long identifiers: got %q, want %q", detail, expected)
 	}
 }
+
+type mockCheckOrderRA struct {
+	rapb.RegistrationAuthorityClient
+	lastRequest *rapb.CheckOrderRequest
+}
+
+func (ra *mockCheckOrderRA) CheckOrder(_ context.Context, in *rapb.CheckOrderRequest, _ ...grpc.CallOption) (*rapb.CheckOrderResponse, error) {
+	ra.lastRequest = in
+	resp := &rapb.CheckOrderResponse{}
+	for _, ident := range in.Identifiers {
+		check := &rapb.IdentifierCheck{Identifier: ident}
+		if ident.Value == "blocked.com" {
+			check.Problems = append(check.Problems, &corepb.ProblemDetails{
+				ProblemType: string(probs.RejectedIdentifierProblem),
+				Detail:      "blocked.com is blocked",
+				HttpStatus:  http.StatusBadRequest,
+			})
+		}
+		if ident.Value == "limited.com" {
+			resp.Problems = append(resp.Problems, &corepb.ProblemDetails{
+				ProblemType: string(probs.RateLimitedProblem),
+				Detail:      "too many certificates for limited.com",
+				HttpStatus:  http.StatusTooManyRequests,
+			})
+		}
+		resp.Identifiers = append(resp.Identifiers, check)
+	}
+	return resp, nil
+}
+
+func TestAccountRateLimits(t *testing.T) {
+	wfe, fc, signer := setupWFE(t)
+
+	rateLimits := func(acctID int64, body string) (int, rateLimitsJSON) {
+		t.Helper()
+		responseWriter := httptest.NewRecorder()
+		path := fmt.Sprintf("%d%s", acctID, acctRateLimitsSuffix)
+		_, _, jws := signer.byKeyID(1, nil, "http://localhost/"+path, body)
+		wfe.Account(ctx, newRequestEvent(), responseWriter, makePostRequestWithPath(path, jws))
+		var resp rateLimitsJSON
+		if responseWriter.Code == http.StatusOK {
+			err := json.Unmarshal(responseWriter.Body.Bytes(), &resp)
+			test.AssertNotError(t, err, "Failed to unmarshal rate limits response")
+		}
+		return responseWriter.Code, resp
+	}
+
+	// Nothing is reported until the overrides have been loaded.
+	code, _ := rateLimits(1, "")
+	test.AssertEquals(t, code, http.StatusInternalServerError)
+
+	loaded := make(chan struct{})
+	wfe.txnBuilder.OnHealthy(func() { close(loaded) })
+	defer wfe.txnBuilder.NewRefresher(time.Hour)()
+	<-loaded
+
+	// Spend one NewOrdersPerAccount token, as a new order would.
+	idents := identifier.NewDNSSlice([]string{"example.com"})
+	txns, err := wfe.txnBuilder.NewOrderLimitTransactions(1, idents, false)
+	test.AssertNotError(t, err, "building new order transactions")
+	_, err = wfe.limiter.BatchSpend(ctx, txns)
+	test.AssertNotError(t, err, "spending new order limits")
+
+	// A POST-as-GET reports only the account's own bucket.
+	code, resp := rateLimits(1, "")
+	test.AssertEquals(t, code, http.StatusOK)
+	test.AssertEquals(t, len(resp.Limits), 1)
+	test.AssertEquals(t, resp.Limits[0].Limit, ratelimits.NewOrdersPerAccount.String())
+	test.AssertEquals(t, resp.Limits[0].Burst, int64(1500))
+	test.AssertEquals(t, resp.Limits[0].Remaining, int64(1499))
+	test.AssertEquals(t, resp.Limits[0].Period, (3 * time.Hour).String())
+	test.Assert(t, resp.Limits[0].Reset.After(fc.Now()), "reset should be in the future")
+	test.Assert(t, !resp.Limits[0].Override, "should not be an override")
+
+	// Checking the status doesn't spend anything.
+	code, resp = rateLimits(1, "")
+	test.AssertEquals(t, code, http.StatusOK)
+	test.AssertEquals(t, resp.Limits[0].Remaining, int64(1499))
+
+	// Listing identifiers adds the buckets for those identifiers.
+	code, resp = rateLimits(1, `{"identifiers": [{"type": "dns", "value": "WWW.Example.com"}]}`)
+	test.AssertEquals(t, code, http.StatusOK)
+	test.AssertEquals(t, len(resp.Limits), 3)
+	test.AssertEquals(t, resp.Limits[1].Limit, ratelimits.CertificatesPerDomain.String())
+	test.AssertEquals(t, resp.Limits[1].Identifier, "example.com")
+	test.AssertEquals(t, resp.Limits[1].Remaining, int64(2))
+	test.AssertEquals(t, resp.Limits[1].Reset, fc.Now().UTC().Truncate(time.Second))
+	test.AssertEquals(t, resp.Limits[2].Limit, ratelimits.CertificatesPerFQDNSet.String())
+	test.AssertEquals(t, resp.Limits[2].Identifier, "")
+
+	// IPv6 identifiers are reported by their covering prefix, colons and all.
+	code, resp = rateLimits(1, `{"identifiers": [{"type": "ip", "value": "2602:80a:6000:abad:cafe::1"}]}`)
+	test.AssertEquals(t, code, http.StatusOK)
+	test.AssertEquals(t, len(resp.Limits), 3)
+	test.AssertEquals(t, resp.Limits[1].Limit, ratelimits.CertificatesPerDomain.String())
+	test.AssertEquals(t, resp.Limits[1].Identifier, "2602:80a:6000:abad::/64")
+
+	// Malformed identifiers are rejected.
+	code, _ = rateLimits(1, `{"identifiers": [{"type": "dns", "value": "not a domain"}]}`)
+	test.AssertEquals(t, code, http.StatusBadRequest)
+
+	// Another account's rate limits can't be fetched.
+	code, _ = rateLimits(2, "")
+	test.AssertEquals(t, code, http.StatusForbidden)
+}
+
+func TestCheckOrder(t *testing.T) {
+	wfe, _, signer := setupWFE(t)
+	ra := &mockCheckOrderRA{}
+	wfe.ra = ra
+
+	// Allow each account only two CAA checks.
+	txnBuilder, err := ratelimits.NewTransactionBuilder(ratelimits.LimitConfigs{
+		ratelimits.CAAChecksPerAccount.String(): &ratelimits.LimitConfig{
+			Burst:  2,
+			Count:  2,
+			Period: config.Duration{Duration: time.Hour}},
+	}, nil, metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "making transaction composer")
+	wfe.txnBuilder = txnBuilder
+	mux := wfe.Handler(metrics.NoopRegisterer)
+
+	checkOrder := func(body string) (int, checkOrderJSON) {
+		t.Helper()
+		responseWriter := httptest.NewRecorder()
+		r := signAndPost(signer, checkOrderPath, "http://localhost"+checkOrderPath, body)
+		mux.ServeHTTP(responseWriter, r)
+		var resp checkOrderJSON
+		if responseWriter.Code == http.StatusOK {
+			err := json.Unmarshal(responseWriter.Body.Bytes(), &resp)
+			test.AssertNotError(t, err, "Failed to unmarshal check-order response")
+		}
+		return responseWriter.Code, resp
+	}
+
+	// An order without any problems is allowed.
+	code, resp := checkOrder(`{
+		"identifiers": [{"type": "dns", "value": "Example.com"}],
+		"caaValidationMethod": "dns-01"
+	}`)
+	test.AssertEquals(t, code, http.StatusOK)
+	test.Assert(t, resp.Allowed, "order should be allowed")
+	test.AssertEquals(t, len(resp.Identifiers), 1)
+	test.AssertEquals(t, resp.Identifiers[0].Identifier, identifier.NewDNS("example.com"))
+	test.AssertEquals(t, ra.lastRequest.CaaValidationMethod, "dns-01")
+	test.AssertEquals(t, ra.lastRequest.RegistrationID, int64(1))
+
+	// An order with a problem identifier isn't.
+	code, resp = checkOrder(`{
+		"identifiers": [{"type": "dns", "value": "example.com"}, {"type": "dns", "value": "blocked.com"}]
+	}`)
+	test.AssertEquals(t, code, http.StatusOK)
+	test.Assert(t, !resp.Allowed, "order should not be allowed")
+	test.AssertEquals(t, len(resp.Identifiers), 2)
+	for _, check := range resp.Identifiers {
+		if check.Identifier.Value == "blocked.com" {
+			test.AssertEquals(t, len(check.Problems), 1)
+			test.AssertEquals(t, check.Problems[0].Type, probs.ErrorNS+probs.RejectedIdentifierProblem)
+		} else {
+			test.AssertEquals(t, len(check.Problems), 0)
+		}
+	}
+
+	// Problems with the order as a whole are reported too.
+	code, resp = checkOrder(`{
+		"identifiers": [{"type": "dns", "value": "limited.com"}]
+	}`)
+	test.AssertEquals(t, code, http.StatusOK)
+	test.Assert(t, !resp.Allowed, "order should not be allowed")
+	test.AssertEquals(t, len(resp.Problems), 1)
+	test.AssertEquals(t, resp.Problems[0].Type, probs.ErrorNS+probs.RateLimitedProblem)
+
+	// CAA checks which would exceed the account's limit aren't performed.
+	ra.lastRequest = nil
+	code, _ = checkOrder(`{
+		"identifiers": [{"type": "dns", "value": "example.com"}, {"type": "dns", "value": "example.net"}],
+		"caaValidationMethod": "dns-01"
+	}`)
+	test.AssertEquals(t, code, http.StatusTooManyRequests)
+	test.Assert(t, ra.lastRequest == nil, "RA should not have been called")
+
+	// Malformed requests are rejected outright.
+	code, _ = checkOrder(`{"identifiers": []}`)
+	test.AssertEquals(t, code, http.StatusBadRequest)
+	code, _ = checkOrder(`{"identifiers": [{"type": "dns", "value": "not a domain"}]}`)
+	test.AssertEquals(t, code, http.StatusBadRequest)
+	code, _ = checkOrder(`{"identifiers": [{"type": "dns", "value": "example.com"}], "profile": "bad-profile"}`)
+	test.AssertEquals(t, code, http.StatusBadRequest)
+}